@@ -7,16 +7,94 @@ import (
 	"os"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/botService"
 	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
-// -- Authorization header containing Bearer access token. Injects `UserId` into `input` struct
+// apiKeyOperationScopes lists operations available to bots along with the scope required by each of them, other
+// operations reject API keys
+var apiKeyOperationScopes = map[string]botService.Scope{
+	"get-chat-token":             botService.ScopeChatRead,
+	"get-chat-avatar":            botService.ScopeChatRead,
+	"list-chat-channels":         botService.ScopeChatRead,
+	"list-chat-channels-grouped": botService.ScopeChatRead,
+	"list-chat-channel-members":  botService.ScopeChatRead,
+	"list-chat-group-members":    botService.ScopeChatRead,
+	"search-chat-channels":       botService.ScopeChatRead,
+}
+
+// -- Authorization header containing Bearer access token or API key of a bot. Injects `UserId` into `input` struct,
+// `ApiKeyScopes` is set only for bots
 type AuthorizationHeaderResolver struct {
 	Authorization string `header:"authorization"`
 	UserId        string
+	ApiKeyScopes  []botService.Scope
 }
 
 func (input *AuthorizationHeaderResolver) Resolve(ctx huma.Context) (errs []error) {
+	input.UserId, input.ApiKeyScopes, errs = resolveCredentials(ctx, input.Authorization)
+	return
+}
+
+// -- Optional authorization header. Anonymous requests are allowed, `UserId` is injected when the header is present
+type OptionalAuthorizationHeaderResolver struct {
+	Authorization string `header:"authorization"`
+	UserId        string
+	ApiKeyScopes  []botService.Scope
+}
+
+func (input *OptionalAuthorizationHeaderResolver) Resolve(ctx huma.Context) (errs []error) {
+	if input.Authorization == "" {
+		return
+	}
+	input.UserId, input.ApiKeyScopes, errs = resolveCredentials(ctx, input.Authorization)
+	return
+}
+
+// resolveCredentials identifies the user (or bot) by the authorization header
+func resolveCredentials(ctx huma.Context, authorization string) (userId string, scopes []botService.Scope, errs []error) {
+	key, prefix, ok := botService.ParseApiKey(authorization)
+	if !ok {
+		userId, errs = resolveUserId(authorization)
+		return
+	}
+	// 1. Check if the operation is available to bots
+	requiredScope, ok := apiKeyOperationScopes[ctx.Operation().OperationID]
+	if !ok {
+		errs = append(errs, &huma.ErrorDetail{
+			Message:  "operation is not available to bots",
+			Location: "apikey.operation",
+			Value:    ctx.Operation().OperationID,
+		})
+		return
+	}
+	// 2. Find active API key
+	apiKey, err := botService.ResolveApiKey(ctx.Context(), boil.GetContextDB(), key, prefix)
+	if err != nil {
+		errs = append(errs, &huma.ErrorDetail{
+			Message:  err.Error(),
+			Location: "header.authorization.apikey",
+			Value:    prefix,
+		})
+		return
+	}
+	// 3. Check the scope
+	scopes = botService.ScopesFromString(apiKey.Scopes)
+	if !botService.HasScope(scopes, requiredScope) {
+		errs = append(errs, &huma.ErrorDetail{
+			Message:  fmt.Sprintf("API key lacks %q scope", requiredScope),
+			Location: "apikey.scope",
+			Value:    requiredScope,
+		})
+		return
+	}
+	userId = apiKey.BotID
+	return
+}
+
+// resolveUserId asks auth-service for the user identified by the authorization header
+func resolveUserId(authorization string) (userId string, errs []error) {
 	// 1. Prepare request
 	log.Info().Msg(os.Getenv("ENV_URL_AUTH_SERVICE"))
 	request, _ := http.NewRequest(
@@ -27,7 +105,7 @@ func (input *AuthorizationHeaderResolver) Resolve(ctx huma.Context) (errs []erro
 		),
 		http.NoBody,
 	)
-	request.Header.Add("Authorization", input.Authorization)
+	request.Header.Add("Authorization", authorization)
 	// 2. Initialize HTTP client
 	var httpClient = http.DefaultClient
 	// 3. Perform the request
@@ -62,6 +140,6 @@ func (input *AuthorizationHeaderResolver) Resolve(ctx huma.Context) (errs []erro
 		})
 		return
 	}
-	input.UserId = data.ID
+	userId = data.ID
 	return
 }
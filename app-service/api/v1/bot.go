@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/botService"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+const (
+	// MAX_BOTS_PER_USER is the limit of bots owned by a user
+	MAX_BOTS_PER_USER = 10
+	// MAX_API_KEYS_PER_BOT is the limit of active (not revoked) API keys of a bot
+	MAX_API_KEYS_PER_BOT = 5
+	// BOT_EMAIL_DOMAIN is the domain of synthetic emails of bot accounts (bots cannot log in)
+	BOT_EMAIL_DOMAIN = "bots.quible.io"
+)
+
+type Bot struct {
+	ID         string    `json:"id" doc:"user ID of the bot (UUID), used as Ably clientId"`
+	Name       string    `json:"name"`
+	Username   string    `json:"username"`
+	ChatGroups []string  `json:"chatGroups" doc:"IDs of chat groups the bot was added to"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// botFromModel expects `bot.R.User` and `bot.R.ChatBots` to be loaded
+func botFromModel(bot *models.Bot) Bot {
+	response := Bot{
+		ID:         bot.UserID,
+		Name:       bot.Name,
+		ChatGroups: []string{},
+		CreatedAt:  bot.CreatedAt,
+	}
+	if bot.R != nil {
+		if bot.R.User != nil {
+			response.Username = bot.R.User.Username
+		}
+		for _, chatBot := range bot.R.ChatBots {
+			response.ChatGroups = append(response.ChatGroups, chatBot.ChatID)
+		}
+	}
+	return response
+}
+
+type ApiKey struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Prefix     string             `json:"prefix" doc:"public part of the key, helps to identify it"`
+	Scopes     []botService.Scope `json:"scopes"`
+	Key        string             `json:"key,omitempty" doc:"the key itself, reported only once on creation"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	ExpiresAt  *time.Time         `json:"expiresAt,omitempty"`
+	LastUsedAt *time.Time         `json:"lastUsedAt,omitempty"`
+	RevokedAt  *time.Time         `json:"revokedAt,omitempty"`
+}
+
+func apiKeyFromModel(apiKey *models.APIKey) ApiKey {
+	return ApiKey{
+		ID:         apiKey.ID,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     botService.ScopesFromString(apiKey.Scopes),
+		CreatedAt:  apiKey.CreatedAt,
+		ExpiresAt:  apiKey.ExpiresAt.Ptr(),
+		LastUsedAt: apiKey.LastUsedAt.Ptr(),
+		RevokedAt:  apiKey.RevokedAt.Ptr(),
+	}
+}
+
+// ownedBot retrieves bot owned by user
+func ownedBot(ctx context.Context, db *sql.DB, botId string, userId string) (*models.Bot, error) {
+	bot, err := models.Bots(
+		models.BotWhere.UserID.EQ(botId),
+		models.BotWhere.OwnerID.EQ(userId),
+	).One(ctx, db)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(Err404_BotNotFound, err)
+	}
+	return bot, nil
+}
+
+// readOnlyScopes checks whether chat channels are read-only for the bot, i.e. its API key lacks "chat:write" scope
+func readOnlyScopes(scopes []botService.Scope) bool {
+	return scopes != nil && !botService.HasScope(scopes, botService.ScopeChatWrite)
+}
@@ -0,0 +1,412 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// chatChannelsCTE selects chat channels available to the user ($1): channels of chat groups owned by the user (or
+// chat groups the bot was added to) and channels joined by the user, direct messages included. Access granted to
+// the owner prevails over membership. Direct messages belong to the synthetic "Direct messages" group, their titles
+// list other participants. $2 makes all channels read-only (see readOnlyScopes). Columns `title`, `created_at` and
+// `active_at` are sort values of the channel, `group_*` ones are sort values of its group
+var chatChannelsCTE = `with access as (
+	select distinct on (chat_id) chat_id, read_only
+	from (
+		select c.id as chat_id, c.archived_at is not null as read_only, 0 as priority
+		from chats c
+		join chats g on g.id = c.parent_id
+		where c.deleted_at is null
+		and g.deleted_at is null
+		and (
+			g.owner_id = $1
+			or exists (select 1 from chat_bots cb where cb.chat_id = g.id and cb.bot_id = $1)
+		)
+		union all
+		select c.id, case
+			when c.dm_key is not null then exists (
+				select 1 from user_blocks ub
+				join chat_user p on p.user_id = ub.user_id and p.deleted_at is null
+				where p.chat_id = c.id
+				and ub.blocked_user_id = $1
+			)
+			else cu.is_ro or c.archived_at is not null or coalesce(cu.muted_until > now(), false)
+		end, 1
+		from chat_user cu
+		join chats c on c.id = cu.chat_id
+		left join chats g on g.id = c.parent_id and g.deleted_at is null
+		where cu.user_id = $1
+		and not cu.disabled
+		and cu.deleted_at is null
+		and c.deleted_at is null
+		and (c.dm_key is not null or g.id is not null)
+	) entries
+	order by chat_id, priority
+), channels as (
+	select
+		c.id,
+		coalesce(c.parent_id::text, '` + DIRECT_MESSAGES_GROUP_ID + `') as group_id,
+		coalesce(g.owner_id = $1, false) as is_owned,
+		c.dm_key is not null or coalesce(g.is_private, false) as is_private,
+		a.read_only or $2 as read_only,
+		lower(case when c.dm_key is null then c.title else (
+			select coalesce(string_agg(u.full_name, ', ' order by u.full_name collate "C"), '')
+			from chat_user p
+			join users u on u.id = p.user_id
+			where p.chat_id = c.id
+			and p.user_id <> $1
+			and p.deleted_at is null
+		) end) as title,
+		c.created_at,
+		greatest(c.created_at, c.last_message_at) as active_at,
+		c.last_message_at,
+		lower(coalesce(g.title, '` + strings.ToLower(directMessagesGroup.Title) + `')) as group_title,
+		coalesce(g.created_at, '` + libAPI.TimeSortKey(directMessagesGroup.CreatedAt) + `') as group_created_at
+	from access a
+	join chats c on c.id = a.chat_id
+	left join chats g on g.id = c.parent_id
+)
+`
+
+// chatSortValues are values of the chat record (channel or group) the listings are sorted by
+type chatSortValues struct {
+	ID        string    `boil:"id"`
+	Title     string    `boil:"title"`
+	CreatedAt time.Time `boil:"created_at"`
+	ActiveAt  time.Time `boil:"active_at"`
+}
+
+// sortKey returns the cursor key of the record with respect to sort option (without direction prefix)
+func (values chatSortValues) sortKey(sortBy string) libAPI.SortKey {
+	switch sortBy {
+	case "created":
+		return libAPI.SortKey{libAPI.TimeSortKey(values.CreatedAt), values.ID}
+	case "activity":
+		return libAPI.SortKey{libAPI.TimeSortKey(values.ActiveAt), values.ID}
+	default:
+		return libAPI.SortKey{values.Title, values.ID}
+	}
+}
+
+// chatChannelRow is a chat channel selected by chatChannelsCTE
+type chatChannelRow struct {
+	chatSortValues `boil:",bind"`
+	GroupID        string `boil:"group_id"`
+	ReadOnly       bool   `boil:"read_only"`
+}
+
+// chatKeyset returns the condition selecting chat records (columns of chatChannelsCTE) following the cursor and
+// the matching order, values of the cursor are appended to `args`
+func chatKeyset(params ChatSortParams, args []any) (string, string, []any, error) {
+	sortBy := strings.TrimPrefix(params.Sort, "-")
+	column := `title collate "C"`
+	switch sortBy {
+	case "created":
+		column = "created_at"
+	case "activity":
+		column = "active_at"
+	}
+	direction, comparison := "asc", ">"
+	if libAPI.IsDescending(params.Sort) {
+		direction, comparison = "desc", "<"
+	}
+	orderBy := fmt.Sprintf(`%[1]s %[2]s, id::text collate "C" %[2]s`, column, direction)
+	if params.After == "" {
+		return "true", orderBy, args, nil
+	}
+	key, err := libAPI.DecodeCursor(params.After, params.Sort)
+	if err == nil && len(key) != 2 {
+		err = libAPI.ErrInvalidCursor
+	}
+	if err != nil {
+		return "", "", nil, ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
+	}
+	var value any = key[0]
+	if sortBy != "title" {
+		if value, err = libAPI.ParseTimeSortKey(key[0]); err != nil {
+			return "", "", nil, ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
+		}
+	}
+	args = append(args, value, key[1])
+	keyset := fmt.Sprintf(`(%s, id::text collate "C") %s ($%d, $%d)`, column, comparison, len(args)-1, len(args))
+	return keyset, orderBy, args, nil
+}
+
+// condition returns SQL condition (over columns of chatChannelsCTE) matching the filter params
+func (params ChatFilterParams) condition() string {
+	conditions := []string{"true"}
+	switch params.Membership {
+	case "owned":
+		conditions = append(conditions, "is_owned")
+	case "joined":
+		conditions = append(conditions, "not is_owned")
+	}
+	switch params.Visibility {
+	case "public":
+		conditions = append(conditions, "not is_private")
+	case "private":
+		conditions = append(conditions, "is_private")
+	}
+	switch params.ReadOnly {
+	case "true":
+		conditions = append(conditions, "read_only")
+	case "false":
+		conditions = append(conditions, "not read_only")
+	}
+	return strings.Join(conditions, " and ")
+}
+
+// chatChannelsForUser returns the page of user's chat channels matching the filters along with the cursor of
+// the next page. Only the page is loaded, sorting and pagination are done by the DB
+func chatChannelsForUser(ctx context.Context, db *sql.DB, userId string, readOnly bool, filter ChatFilterParams, params ChatSortParams) ([]ChatChannel, string, error) {
+	keyset, orderBy, args, err := chatKeyset(params, []any{userId, readOnly})
+	if err != nil {
+		return nil, "", err
+	}
+	limit := libAPI.PageLimit(params.PageParams)
+	args = append(args, limit+1)
+	rows := []chatChannelRow{}
+	if err := queries.Raw(
+		chatChannelsCTE+`select id, group_id, read_only, title, created_at, active_at
+		from channels
+		where `+filter.condition()+`
+		and `+keyset+`
+		order by `+orderBy+`
+		limit $`+fmt.Sprint(len(args)),
+		args...,
+	).Bind(ctx, db, &rows); err != nil {
+		return nil, "", ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat channels of user %q", userId),
+			err,
+		)
+	}
+	nextCursor := ""
+	if len(rows) > limit {
+		rows = rows[:limit]
+		nextCursor = libAPI.EncodeCursor(params.Sort, rows[limit-1].sortKey(strings.TrimPrefix(params.Sort, "-")))
+	}
+	chatChannels, err := loadChatChannels(ctx, db, userId, rows)
+	if err != nil {
+		return nil, "", err
+	}
+	return chatChannels, nextCursor, nil
+}
+
+// chatChannelsGroupsForUser returns the page of groups of user's chat channels matching the filters along with
+// the cursor of the next page. Activity of a group is the latest activity of its (matching) channels
+func chatChannelsGroupsForUser(ctx context.Context, db *sql.DB, userId string, readOnly bool, filter ChatFilterParams, params ChatSortParams) ([]ChatChannelsGroup, string, error) {
+	// 1. Select the page of groups
+	keyset, orderBy, args, err := chatKeyset(params, []any{userId, readOnly})
+	if err != nil {
+		return nil, "", err
+	}
+	limit := libAPI.PageLimit(params.PageParams)
+	args = append(args, limit+1)
+	groupRows := []chatSortValues{}
+	if err := queries.Raw(
+		chatChannelsCTE+`, groups as (
+			select
+				group_id as id,
+				min(group_title) as title,
+				min(group_created_at) as created_at,
+				greatest(min(group_created_at), max(last_message_at)) as active_at
+			from channels
+			where `+filter.condition()+`
+			group by group_id
+		)
+		select id, title, created_at, active_at
+		from groups
+		where `+keyset+`
+		order by `+orderBy+`
+		limit $`+fmt.Sprint(len(args)),
+		args...,
+	).Bind(ctx, db, &groupRows); err != nil {
+		return nil, "", ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat channel groups of user %q", userId),
+			err,
+		)
+	}
+	nextCursor := ""
+	if len(groupRows) > limit {
+		groupRows = groupRows[:limit]
+		nextCursor = libAPI.EncodeCursor(params.Sort, groupRows[limit-1].sortKey(strings.TrimPrefix(params.Sort, "-")))
+	}
+	if len(groupRows) == 0 {
+		return []ChatChannelsGroup{}, nextCursor, nil
+	}
+	// 2. Select matching channels of the groups (ordered by title)
+	args = []any{userId, readOnly}
+	placeholders := make([]string, len(groupRows))
+	for idx, groupRow := range groupRows {
+		args = append(args, groupRow.ID)
+		placeholders[idx] = fmt.Sprintf("$%d", len(args))
+	}
+	rows := []chatChannelRow{}
+	if err := queries.Raw(
+		chatChannelsCTE+`select id, group_id, read_only, title, created_at, active_at
+		from channels
+		where `+filter.condition()+`
+		and group_id in (`+strings.Join(placeholders, ", ")+`)
+		order by title collate "C", id::text collate "C"`,
+		args...,
+	).Bind(ctx, db, &rows); err != nil {
+		return nil, "", ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat channels of user %q", userId),
+			err,
+		)
+	}
+	chatChannels, err := loadChatChannels(ctx, db, userId, rows)
+	if err != nil {
+		return nil, "", err
+	}
+	// 3. Group chat channels based on `Parent` field in each record (in the order of the page)
+	chatChannelsGroups := make([]ChatChannelsGroup, 0, len(groupRows))
+	groupIdx := map[string]int{}
+	for _, groupRow := range groupRows {
+		groupIdx[groupRow.ID] = len(chatChannelsGroups)
+		chatChannelsGroups = append(chatChannelsGroups, ChatChannelsGroup{
+			ID:           groupRow.ID,
+			ChatChannels: []ChatChannel{},
+		})
+	}
+	for _, chatChannel := range chatChannels {
+		chatGroup := chatChannel.Parent
+		idx, ok := groupIdx[chatGroup.ID]
+		if !ok {
+			continue
+		}
+		chatChannelsGroup := &chatChannelsGroups[idx]
+		chatChannelsGroup.Title = chatGroup.Title
+		chatChannelsGroup.Summary = chatGroup.Summary.Ptr()
+		chatChannelsGroup.CreatedAt = chatGroup.CreatedAt
+		chatChannelsGroup.AvatarUpdatedAt = chatGroup.AvatarUpdatedAt.Ptr()
+		chatChannelsGroup.ChatChannels = append(chatChannelsGroup.ChatChannels, chatChannel)
+	}
+	return chatChannelsGroups, nextCursor, nil
+}
+
+// isChatChannelOfUser checks whether the chat channel is available to the user
+func isChatChannelOfUser(ctx context.Context, db *sql.DB, userId string, chatChannelId string) (bool, error) {
+	var found bool
+	if err := queries.Raw(
+		chatChannelsCTE+`select exists (select 1 from channels where id = $3)`,
+		userId,
+		false,
+		chatChannelId,
+	).QueryRowContext(ctx, db).Scan(&found); err != nil {
+		return false, ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat channels of user %q", userId),
+			err,
+		)
+	}
+	return found, nil
+}
+
+// isChatOfUser checks whether the chat record is available to the user: the chat channel is available to the user,
+// or the chat group is owned by the user or holds a chat channel available to the user
+func isChatOfUser(ctx context.Context, db *sql.DB, userId string, chatId string) (bool, error) {
+	var found bool
+	if err := queries.Raw(
+		chatChannelsCTE+`select exists (select 1 from channels where id::text = $3 or group_id = $3)
+		or exists (select 1 from chats where id::text = $3 and owner_id = $1 and deleted_at is null)`,
+		userId,
+		false,
+		chatId,
+	).QueryRowContext(ctx, db).Scan(&found); err != nil {
+		return false, ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat records of user %q", userId),
+			err,
+		)
+	}
+	return found, nil
+}
+
+// loadChatChannels loads chat channels selected by chatChannelsCTE (keeping their order), pinned items included
+func loadChatChannels(ctx context.Context, db *sql.DB, userId string, rows []chatChannelRow) ([]ChatChannel, error) {
+	chatIds := make([]string, len(rows))
+	for idx, row := range rows {
+		chatIds[idx] = row.ID
+	}
+	if len(chatIds) == 0 {
+		return []ChatChannel{}, nil
+	}
+	chats, err := models.Chats(
+		models.ChatWhere.ID.IN(chatIds),
+		qm.Load(models.ChatRels.Parent),
+	).All(ctx, db)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve chat channels of user %q", userId),
+			err,
+		)
+	}
+	chatChannelByChatId := map[string]ChatChannel{}
+	directMessages := []*models.Chat{}
+	for _, chat := range chats {
+		if chat.DMKey.Valid {
+			directMessages = append(directMessages, chat)
+			continue
+		}
+		if chat.R.Parent == nil {
+			// the chat group was deleted in the meantime
+			continue
+		}
+		chatChannelByChatId[chat.ID] = ChatChannel{
+			ID:              chat.ID,
+			Title:           chat.Title,
+			Resource:        chat.R.Parent.Resource + ":" + chat.Resource,
+			Parent:          chat.R.Parent,
+			Topic:           chat.Topic.Ptr(),
+			AvatarUpdatedAt: chat.AvatarUpdatedAt.Ptr(),
+			UpdatedAt:       chat.UpdatedAt,
+			CreatedAt:       chat.CreatedAt,
+			LastMessageAt:   chat.LastMessageAt.Ptr(),
+		}
+	}
+	if len(directMessages) > 0 {
+		directMessageChannels, err := directMessageChannelsForUser(ctx, db, userId, directMessages)
+		if err != nil {
+			return nil, ErrorMap.GetErrorResponse(
+				Err500_UnknownError,
+				err,
+			)
+		}
+		for _, chatChannel := range directMessageChannels {
+			chatChannelByChatId[chatChannel.ID] = chatChannel
+		}
+	}
+	pinnedItems, err := pinnedItemsByChatId(ctx, db, chatIds)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			fmt.Errorf("unable to retrieve pinned items of chat channels of user %q", userId),
+			err,
+		)
+	}
+	chatChannels := make([]ChatChannel, 0, len(rows))
+	for _, row := range rows {
+		chatChannel, ok := chatChannelByChatId[row.ID]
+		if !ok {
+			continue
+		}
+		readOnly := row.ReadOnly
+		chatChannel.ReadOnly = &readOnly
+		chatChannel.Pinned = pinnedItems[chatChannel.ID]
+		chatChannels = append(chatChannels, chatChannel)
+	}
+	return chatChannels, nil
+}
@@ -3,20 +3,78 @@ package v1
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
+	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 const (
 	GROUP_PREFIX string = "chat:"
+	DM_PREFIX    string = "dm:"
 )
 
+const (
+	// LEGACY_RESOURCE_MIGRATION is the version of the migration moving chat groups to ID-based namespaces
+	LEGACY_RESOURCE_MIGRATION int64 = 20240302101204
+	// LEGACY_RESOURCE_WINDOW is the default length of the compatibility window, counted from the migration
+	LEGACY_RESOURCE_WINDOW = 30 * 24 * time.Hour
+)
+
+// LegacyResourceCutoff returns the end of the compatibility window during which Ably tokens also grant access to
+// name-based (pre-migration) channel namespaces of chat groups, i.e. `chat:<name>:<channel>`.
+// The cutoff is read from ENV_LEGACY_RESOURCE_CUTOFF (RFC 3339), by default the window lasts LEGACY_RESOURCE_WINDOW
+// since the migration was applied. The window is closed (zero time) when the migration is not recorded, e.g. for DB
+// bootstrapped from a dump. It is meant to be resolved once at startup (see WithLegacyResourceCutoff).
+func LegacyResourceCutoff(ctx context.Context, exec boil.ContextExecutor) (time.Time, error) {
+	if value := os.Getenv("ENV_LEGACY_RESOURCE_CUTOFF"); value != "" {
+		cutoff, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid ENV_LEGACY_RESOURCE_CUTOFF: %w", err)
+		}
+		return cutoff, nil
+	}
+	var appliedAt time.Time
+	err := exec.QueryRowContext(
+		ctx,
+		`select tstamp from goose_db_version
+		where version_id = $1 and is_applied
+		order by id desc
+		limit 1`,
+		LEGACY_RESOURCE_MIGRATION,
+	).Scan(&appliedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to retrieve migration %d: %w", LEGACY_RESOURCE_MIGRATION, err)
+	}
+	return appliedAt.Add(LEGACY_RESOURCE_WINDOW), nil
+}
+
+// groupResource returns Ably namespace of the chat group, it is based on (immutable) ID to be unique across all owners
+func groupResource(chatGroupId string) string {
+	return GROUP_PREFIX + chatGroupId
+}
+
+// legacyResource returns pre-migration Ably channel name of the chat channel if it is still within the compatibility window
+func legacyResource(chatGroup *models.Chat, chatChannel *models.Chat, cutoff time.Time) (string, bool) {
+	if !chatGroup.LegacyResource.Valid || time.Now().After(cutoff) {
+		return "", false
+	}
+	return chatGroup.LegacyResource.String + ":" + chatChannel.Resource, true
+}
+
 var (
-	AccessReadOnly  = []string{"subscribe", "history"}
-	AccessReadWrite = []string{"subscribe", "publish", "history"}
+	AccessReadOnly  = []string{"subscribe", "history", "presence"}
+	AccessReadWrite = []string{"subscribe", "publish", "history", "presence"}
 )
 
 type ChatChannel struct {
@@ -25,78 +83,114 @@ type ChatChannel struct {
 	Resource string       `json:"resource"`
 	ReadOnly *bool        `json:"readOnly,omitempty"`
 	Parent   *models.Chat `json:"-"`
+	// read state of the channel, populated only for the lists of user's chat channels
+	UnreadCount *int       `json:"unreadCount,omitempty" doc:"number of unread messages, capped at 100"`
+	LastReadAt  *time.Time `json:"lastReadAt,omitempty"`
+	// metadata of the channel
+	Topic           *string      `json:"topic,omitempty"`
+	Pinned          []PinnedItem `json:"pinned,omitempty"`
+	AvatarUpdatedAt *time.Time   `json:"avatarUpdatedAt,omitempty" doc:"set when avatar image is available at GET /chat/{chatId}/avatar"`
+	UpdatedAt       time.Time    `json:"updatedAt"`
+	// activity of the channel
+	CreatedAt     time.Time  `json:"createdAt"`
+	LastMessageAt *time.Time `json:"lastMessageAt,omitempty"`
+	// matched fragments, populated only for search results
+	Highlight *SearchHighlight `json:"highlight,omitempty"`
 }
 
-func chatChannelsForUser(ctx context.Context, db *sql.DB, userId string) ([]ChatChannel, error) {
-	// 0. Initialize storage
-	chatChannelByChatId := map[string]ChatChannel{}
-	chatChannels := []ChatChannel{}
-	// 1. Identify chat channels associated with groups, owned by user
-	ownedChatGroups, err := models.Chats(
-		models.ChatWhere.OwnerID.EQ(null.StringFrom(userId)),
-		qm.Load(
-			qm.Rels(
-				models.ChatRels.ParentChats,
-				models.ChatRels.Parent,
-			),
-		),
-	).All(ctx, db)
-	if err != nil {
-		return nil, ErrorMap.GetErrorResponse(
-			Err500_UnknownError,
-			fmt.Errorf("unable to retrieve chat channels owned by user %q", userId),
-			err,
-		)
-	}
-	for _, chatGroup := range ownedChatGroups {
-		for _, chat := range chatGroup.R.ParentChats {
-			chatId := chat.ID
-			readOnly := false
-			if _, ok := chatChannelByChatId[chatId]; !ok {
-				chatChannelByChatId[chatId] = ChatChannel{
-					ID:       chatId,
-					Title:    chat.Title,
-					Resource: chat.R.Parent.Resource + ":" + chat.Resource,
-					ReadOnly: &readOnly,
-					Parent:   chat.R.Parent,
-				}
-			}
+// ChatSortParams are query params of paginated listings of chat records
+type ChatSortParams struct {
+	libAPI.PageParams
+	Sort string `query:"sort" enum:"title,-title,created,-created,activity,-activity" default:"title" doc:"sort order, prefix '-' stands for descending order"`
+}
+
+// ChatFilterParams are query params to filter user's chat channels
+type ChatFilterParams struct {
+	Membership string `query:"membership" enum:"all,owned,joined" default:"all" doc:"channels of chat groups owned by user or channels joined by user"`
+	Visibility string `query:"visibility" enum:"all,public,private" default:"all" doc:"channels of public or private chat groups (direct messages are private)"`
+	ReadOnly   string `query:"readOnly" enum:"all,true,false" default:"all" doc:"channels with read-only or read-write access"`
+}
+
+// chatSortKey returns sort key of chat record with respect to sort option (without direction prefix)
+func chatSortKey(sortBy string, id string, title string, createdAt time.Time, lastMessageAt *time.Time) libAPI.SortKey {
+	switch sortBy {
+	case "created":
+		return libAPI.SortKey{libAPI.TimeSortKey(createdAt), id}
+	case "activity":
+		activity := createdAt
+		if lastMessageAt != nil && lastMessageAt.After(activity) {
+			activity = *lastMessageAt
 		}
+		return libAPI.SortKey{libAPI.TimeSortKey(activity), id}
+	default:
+		return libAPI.SortKey{strings.ToLower(title), id}
 	}
-	// 2. Identify chat channels that user has joined (been invited)
-	chatUsersLoaded, err := models.ChatUsers(
-		models.ChatUserWhere.UserID.EQ(userId),
-		models.ChatUserWhere.Disabled.EQ(false),
-		qm.Load(
-			qm.Rels(
-				models.ChatUserRels.Chat,
-				models.ChatRels.Parent,
-			),
-		),
-	).All(ctx, db)
+}
+
+// paginateChats sorts and paginates chat records (groups/channels) with respect to `params` in memory, it's meant for
+// lists of bounded size (e.g. search results), see chatKeyset otherwise
+func paginateChats[T any](items []T, params ChatSortParams, sortKey func(sortBy string, item T) libAPI.SortKey) ([]T, string, error) {
+	sortBy := strings.TrimPrefix(params.Sort, "-")
+	page, nextCursor, err := libAPI.Paginate(
+		items,
+		params.PageParams,
+		params.Sort,
+		func(item T) libAPI.SortKey {
+			return sortKey(sortBy, item)
+		},
+	)
 	if err != nil {
-		return nil, ErrorMap.GetErrorResponse(
-			Err500_UnknownError,
-			fmt.Errorf("unable to chat channels joined by user %q", userId),
-			err,
-		)
+		return nil, "", ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
 	}
-	for _, chatUser := range chatUsersLoaded {
-		chat := chatUser.R.Chat
-		chatId := chat.ID
-		if _, ok := chatChannelByChatId[chatId]; !ok {
-			chatChannelByChatId[chatId] = ChatChannel{
-				ID:       chatId,
-				Title:    chat.Title,
-				Resource: chat.R.Parent.Resource + ":" + chat.Resource,
-				ReadOnly: &chatUser.IsRo,
-				Parent:   chat.R.Parent,
-			}
+	return page, nextCursor, nil
+}
+
+// chatChannelsGroupSortKey returns sort key of the group of chat channels
+func chatChannelsGroupSortKey(sortBy string, group ChatChannelsGroup) libAPI.SortKey {
+	var lastMessageAt *time.Time
+	for _, chatChannel := range group.ChatChannels {
+		if chatChannel.LastMessageAt != nil && (lastMessageAt == nil || chatChannel.LastMessageAt.After(*lastMessageAt)) {
+			lastMessageAt = chatChannel.LastMessageAt
 		}
 	}
-	// 3. Compile final result
-	for _, chatChannel := range chatChannelByChatId {
-		chatChannels = append(chatChannels, chatChannel)
+	return chatSortKey(sortBy, group.ID, group.Title, group.CreatedAt, lastMessageAt)
+}
+
+// isMuted checks whether the user is temporarily muted in the chat channel (e.g. for exceeding the publish rate limit)
+func isMuted(chatUser *models.ChatUser) bool {
+	return chatUser.MutedUntil.Valid && time.Now().Before(chatUser.MutedUntil.Time)
+}
+
+// softDeleteChats marks chat records and their chat_user associations as deleted. All records
+// share the same `deletedAt` so they can be restored together later on.
+func softDeleteChats(ctx context.Context, exec boil.ContextExecutor, chatIds []string, deletedAt time.Time) error {
+	if _, err := models.ChatUsers(
+		models.ChatUserWhere.ChatID.IN(chatIds),
+	).UpdateAll(ctx, exec, models.M{models.ChatUserColumns.DeletedAt: deletedAt}); err != nil {
+		return fmt.Errorf("unable to soft-delete chat_user records: %w", err)
+	}
+	if _, err := models.Chats(
+		models.ChatWhere.ID.IN(chatIds),
+	).UpdateAll(ctx, exec, models.M{models.ChatColumns.DeletedAt: deletedAt}); err != nil {
+		return fmt.Errorf("unable to soft-delete chat records: %w", err)
+	}
+	return nil
+}
+
+// restoreChats reverts `softDeleteChats` for chat records deleted at `deletedAt`
+func restoreChats(ctx context.Context, exec boil.ContextExecutor, chatIds []string, deletedAt time.Time) error {
+	if _, err := models.ChatUsers(
+		qm.WithDeleted(),
+		models.ChatUserWhere.ChatID.IN(chatIds),
+		models.ChatUserWhere.DeletedAt.EQ(null.TimeFrom(deletedAt)),
+	).UpdateAll(ctx, exec, models.M{models.ChatUserColumns.DeletedAt: nil}); err != nil {
+		return fmt.Errorf("unable to restore chat_user records: %w", err)
+	}
+	if _, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ID.IN(chatIds),
+	).UpdateAll(ctx, exec, models.M{models.ChatColumns.DeletedAt: nil}); err != nil {
+		return fmt.Errorf("unable to restore chat records: %w", err)
 	}
-	return chatChannels, err
+	return nil
 }
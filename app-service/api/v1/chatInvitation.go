@@ -0,0 +1,218 @@
+package v1
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quible-io/quible-api/app-service/services/emailService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/email"
+	"github.com/quible-io/quible-api/lib/jwt"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	// not stored in DB, derived from `expires_at` of pending invitation
+	InvitationStatusExpired InvitationStatus = "expired"
+)
+
+// SIGNUP_INVITATION_DURATION is the lifespan of invitation sent to email without an account
+const SIGNUP_INVITATION_DURATION = 7 * 24 * time.Hour
+
+type ChatInvitation struct {
+	ID         string           `json:"id"`
+	UserID     string           `json:"userId,omitempty" doc:"empty for invitee without an account"`
+	Email      string           `json:"email"`
+	FullName   string           `json:"fullName,omitempty"`
+	Status     InvitationStatus `json:"status" enum:"pending,accepted,revoked,expired"`
+	InvitedAt  time.Time        `json:"invitedAt"`
+	SentAt     time.Time        `json:"sentAt"`
+	ExpiresAt  time.Time        `json:"expiresAt"`
+	AcceptedAt *time.Time       `json:"acceptedAt,omitempty"`
+	RevokedAt  *time.Time       `json:"revokedAt,omitempty"`
+}
+
+// invitationStatus returns effective status of the invitation (pending invitation can be expired)
+func invitationStatus(invitation *models.ChatInvitation) InvitationStatus {
+	status := InvitationStatus(invitation.Status)
+	if status == InvitationStatusPending && time.Now().After(invitation.ExpiresAt) {
+		return InvitationStatusExpired
+	}
+	return status
+}
+
+// ownedPrivateChatChannel retrieves chat channel (along with its chat group) which belongs to a private chat group owned by user
+func ownedPrivateChatChannel(ctx context.Context, db *sql.DB, chatChannelId string, userId string) (*models.Chat, error) {
+	chatChannel, err := models.Chats(
+		models.ChatWhere.ID.EQ(chatChannelId),
+		models.ChatWhere.ParentID.IsNotNull(),
+		qm.Load(
+			models.ChatRels.Parent,
+		),
+	).One(ctx, db)
+	if err != nil || chatChannel.R.Parent == nil {
+		return nil, ErrorMap.GetErrorResponse(
+			Err404_ChatChannelNotFound,
+			err,
+		)
+	}
+	chatGroup := chatChannel.R.Parent
+	if !chatGroup.IsPrivate.Bool || chatGroup.OwnerID != null.StringFrom(userId) {
+		return nil, ErrorMap.GetErrorResponse(
+			Err404_ChatChannelNotFound,
+			errors.New("holding chat group is not private or is not owned by user"),
+		)
+	}
+	return chatChannel, nil
+}
+
+// upsertChatInvitation creates new pending invitation (based on `blank`) or resets `found` one which is no longer pending
+func upsertChatInvitation(ctx context.Context, db *sql.DB, found *models.ChatInvitation, blank *models.ChatInvitation) (*models.ChatInvitation, error) {
+	now := time.Now()
+	if found == nil {
+		blank.Status = string(InvitationStatusPending)
+		blank.InvitedAt = now
+		blank.SentAt = now
+		blank.ExpiresAt = now.Add(jwt.DEFAULT_TOKEN_DURATION)
+		if err := blank.Insert(ctx, db, boil.Infer()); err != nil {
+			return nil, ErrorMap.GetErrorResponse(
+				Err500_UnableCreateChatInvitation,
+				err,
+			)
+		}
+		return blank, nil
+	}
+	if InvitationStatus(found.Status) != InvitationStatusPending {
+		found.InvitorID = blank.InvitorID
+		found.Status = string(InvitationStatusPending)
+		found.InvitedAt = now
+		found.AcceptedAt = null.TimeFromPtr(nil)
+		found.RevokedAt = null.TimeFromPtr(nil)
+		if _, err := found.Update(ctx, db, boil.Infer()); err != nil {
+			return nil, ErrorMap.GetErrorResponse(
+				Err500_UnableUpdateChatInvitation,
+				err,
+			)
+		}
+	}
+	return found, nil
+}
+
+// renewChatInvitation assigns new token ID (making previously sent tokens obsolete) and expiration to the invitation
+func renewChatInvitation(ctx context.Context, db *sql.DB, invitation *models.ChatInvitation, lifespan time.Duration) error {
+	now := time.Now()
+	invitation.TokenID = uuid.NewString()
+	invitation.SentAt = now
+	invitation.ExpiresAt = now.Add(lifespan)
+	if _, err := invitation.Update(ctx, db, boil.Whitelist(
+		models.ChatInvitationColumns.TokenID,
+		models.ChatInvitationColumns.SentAt,
+		models.ChatInvitationColumns.ExpiresAt,
+	)); err != nil {
+		return ErrorMap.GetErrorResponse(
+			Err500_UnableUpdateChatInvitation,
+			err,
+		)
+	}
+	return nil
+}
+
+// sendInvitationEmail sends invitation email using the mailer from dependencies
+func sendInvitationEmail(ctx context.Context, deps libAPI.Deps, to string, html string) error {
+	emailSender, ok := deps.Get("mailer").(email.EmailSender)
+	if !ok {
+		return ErrorMap.GetErrorResponse(
+			Err424_UnableToSendEmail,
+			errors.New("email client unavailable"),
+		)
+	}
+	if err := emailSender.SendEmail(ctx, email.EmailPayload{
+		From:     "no-reply@quible.io",
+		To:       to,
+		Subject:  "Invitation to join private chat channel",
+		HTMLBody: html,
+	}); err != nil {
+		return ErrorMap.GetErrorResponse(
+			Err424_UnableToSendEmail,
+			err,
+		)
+	}
+	return nil
+}
+
+// sendChatInvitation renews invitation token and emails it to the invitee
+func sendChatInvitation(ctx context.Context, deps libAPI.Deps, invitation *models.ChatInvitation, chatChannel *models.Chat, invitor *models.User, invitee *models.User) error {
+	db := deps.Get("db").(*sql.DB)
+	// 1. Renew the token and the invitation expiration
+	if err := renewChatInvitation(ctx, db, invitation, jwt.DEFAULT_TOKEN_DURATION); err != nil {
+		return err
+	}
+	token, err := jwt.GenerateToken(
+		invitor,
+		jwt.TokenActionInvitationToPrivateChat,
+		jwt.ExtraClaims{
+			"inviteeId":     invitee.ID,
+			"chatChannelId": chatChannel.ID,
+			"tokenId":       invitation.TokenID,
+		},
+	)
+	if err != nil {
+		return ErrorMap.GetErrorResponse(
+			Err500_UnknownError,
+			err,
+		)
+	}
+	// 2. Send invitation email
+	var html bytes.Buffer
+	emailService.InviteToPrivateChatGroup(
+		invitee.FullName,
+		chatChannel.Title,
+		chatChannel.R.Parent.Title,
+		fmt.Sprintf(
+			"%s/forms/accept-private-chat-invitation?token=%s",
+			os.Getenv("WEB_CLIENT_URL"),
+			token.Token,
+		),
+		&html,
+	)
+	return sendInvitationEmail(ctx, deps, invitee.Email, html.String())
+}
+
+// sendChatSignupInvitation emails invitation to sign up to the invitee without an account. The membership in the
+// chat channel is attached by auth-service once the account is activated
+func sendChatSignupInvitation(ctx context.Context, deps libAPI.Deps, invitation *models.ChatInvitation, chatChannel *models.Chat, invitor *models.User) error {
+	db := deps.Get("db").(*sql.DB)
+	// 1. Renew the invitation expiration
+	if err := renewChatInvitation(ctx, db, invitation, SIGNUP_INVITATION_DURATION); err != nil {
+		return err
+	}
+	// 2. Send invitation email
+	var html bytes.Buffer
+	emailService.InviteToPrivateChatGroupSignup(
+		invitor.FullName,
+		chatChannel.Title,
+		chatChannel.R.Parent.Title,
+		fmt.Sprintf(
+			"%s/forms/register?email=%s",
+			os.Getenv("WEB_CLIENT_URL"),
+			url.QueryEscape(invitation.InviteeEmail.String),
+		),
+		&html,
+	)
+	return sendInvitationEmail(ctx, deps, invitation.InviteeEmail.String, html.String())
+}
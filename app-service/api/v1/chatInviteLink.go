@@ -0,0 +1,66 @@
+package v1
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+type ChatInviteLink struct {
+	ID        string     `json:"id"`
+	Code      string     `json:"code"`
+	URL       string     `json:"url"`
+	ReadOnly  bool       `json:"readOnly"`
+	MaxUses   *int       `json:"maxUses,omitempty"`
+	Uses      int        `json:"uses"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	Active    bool       `json:"active" doc:"link is neither revoked, expired nor exhausted"`
+}
+
+// newInviteLinkCode generates random URL-safe code for the invite link
+func newInviteLinkCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// isInviteLinkActive tells if invite link can still be redeemed
+func isInviteLinkActive(link *models.ChatInviteLink) bool {
+	if link.RevokedAt.Valid {
+		return false
+	}
+	if link.ExpiresAt.Valid && time.Now().After(link.ExpiresAt.Time) {
+		return false
+	}
+	if link.MaxUses.Valid && link.Uses >= link.MaxUses.Int {
+		return false
+	}
+	return true
+}
+
+func toChatInviteLink(link *models.ChatInviteLink) ChatInviteLink {
+	return ChatInviteLink{
+		ID:   link.ID,
+		Code: link.Code,
+		URL: fmt.Sprintf(
+			"%s/forms/join-private-chat?code=%s",
+			os.Getenv("WEB_CLIENT_URL"),
+			link.Code,
+		),
+		ReadOnly:  link.IsRo,
+		MaxUses:   link.MaxUses.Ptr(),
+		Uses:      link.Uses,
+		ExpiresAt: link.ExpiresAt.Ptr(),
+		CreatedAt: link.CreatedAt,
+		RevokedAt: link.RevokedAt.Ptr(),
+		Active:    isInviteLinkActive(link),
+	}
+}
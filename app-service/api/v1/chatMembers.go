@@ -0,0 +1,214 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PRESENCE_CONCURRENCY is the limit of concurrent Ably presence requests of a single listing of members
+const PRESENCE_CONCURRENCY = 8
+
+type ChatMemberRole string
+
+const (
+	ChatMemberRoleOwner  ChatMemberRole = "owner"
+	ChatMemberRoleMember ChatMemberRole = "member"
+	ChatMemberRoleBot    ChatMemberRole = "bot"
+)
+
+type ChatMember struct {
+	ID       string         `json:"id" doc:"user ID (UUID)"`
+	Username string         `json:"username"`
+	FullName string         `json:"fullName"`
+	HasImage bool           `json:"hasImage" doc:"profile image is available at auth-service GET /user/{userId}/image"`
+	Role     ChatMemberRole `json:"role" enum:"owner,member,bot"`
+	ReadOnly bool           `json:"readOnly"`
+	Online   *bool          `json:"online,omitempty" doc:"presence in the chat channel, omitted when presence is unavailable"`
+}
+
+func chatMemberFromUser(user *models.User, role ChatMemberRole, readOnly bool) ChatMember {
+	return ChatMember{
+		ID:       user.ID,
+		Username: user.Username,
+		FullName: user.FullName,
+		HasImage: user.Image.Valid,
+		Role:     role,
+		ReadOnly: readOnly,
+	}
+}
+
+// chatChannelMembers lists the owner of the chat group holding the channel (if any), users who joined the chat
+// channel and bots added to the chat group (`chatChannel.R.Parent` is expected to be loaded for chat channels other
+// than direct messages)
+func chatChannelMembers(ctx context.Context, db *sql.DB, chatChannel *models.Chat) ([]ChatMember, error) {
+	return chatChannelsMembers(ctx, db, chatChannel.R.Parent, []*models.Chat{chatChannel})
+}
+
+// chatChannelsMembers lists members of the chat channels of the chat group (nil for a direct message): its owner,
+// users who joined any of the channels and bots added to the chat group. A member is read-only if so in every
+// channel. The owner, members and bots are loaded once for all the channels
+func chatChannelsMembers(ctx context.Context, db *sql.DB, chatGroup *models.Chat, chatChannels []*models.Chat) ([]ChatMember, error) {
+	chatMembers := []ChatMember{}
+	idxByUserId := map[string]int{}
+	addMember := func(user *models.User, role ChatMemberRole, readOnly bool) {
+		if idx, ok := idxByUserId[user.ID]; ok {
+			chatMembers[idx].ReadOnly = chatMembers[idx].ReadOnly && readOnly
+			return
+		}
+		idxByUserId[user.ID] = len(chatMembers)
+		chatMembers = append(chatMembers, chatMemberFromUser(user, role, readOnly))
+	}
+	if chatGroup != nil && chatGroup.OwnerID.Valid {
+		owner, err := models.FindUser(ctx, db, chatGroup.OwnerID.String)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve owner of chat group %q: %w", chatGroup.ID, err)
+		}
+		addMember(owner, ChatMemberRoleOwner, false)
+	}
+	if len(chatChannels) == 0 {
+		return chatMembers, nil
+	}
+	chatChannelById := make(map[string]*models.Chat, len(chatChannels))
+	chatIds := make([]string, len(chatChannels))
+	directMessages := []*models.Chat{}
+	for idx, chatChannel := range chatChannels {
+		chatChannelById[chatChannel.ID] = chatChannel
+		chatIds[idx] = chatChannel.ID
+		if chatChannel.DMKey.Valid {
+			directMessages = append(directMessages, chatChannel)
+		}
+	}
+	chatUsers, err := models.ChatUsers(
+		models.ChatUserWhere.ChatID.IN(chatIds),
+		models.ChatUserWhere.Disabled.EQ(false),
+		qm.Load(models.ChatUserRels.User),
+	).All(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve members of chat channels %q: %w", chatIds, err)
+	}
+	// participants of direct messages are read-only when blocked by others
+	blocks := directMessageBlocks{}
+	if len(directMessages) > 0 {
+		userIds := make([]string, 0, len(chatUsers))
+		for _, chatUser := range chatUsers {
+			userIds = append(userIds, chatUser.UserID)
+		}
+		if blocks, err = blocksInDirectMessages(ctx, db, directMessages, userIds); err != nil {
+			return nil, err
+		}
+	}
+	for _, chatUser := range chatUsers {
+		if chatUser.R.User == nil {
+			continue
+		}
+		chatChannel := chatChannelById[chatUser.ChatID]
+		readOnly := chatUser.IsRo || chatChannel.ArchivedAt.Valid || isMuted(chatUser)
+		if chatChannel.DMKey.Valid {
+			readOnly = blocks.isBlocked(chatChannel.ID, chatUser.UserID)
+		}
+		addMember(chatUser.R.User, ChatMemberRoleMember, readOnly)
+	}
+	// bots added to the chat group are members of all its chat channels (read-only if all of them are archived)
+	if chatGroup != nil {
+		chatBots, err := models.ChatBots(
+			models.ChatBotWhere.ChatID.EQ(chatGroup.ID),
+			qm.Load(qm.Rels(models.ChatBotRels.Bot, models.BotRels.User)),
+		).All(ctx, db)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve bots of chat group %q: %w", chatGroup.ID, err)
+		}
+		isArchived := true
+		for _, chatChannel := range chatChannels {
+			isArchived = isArchived && chatChannel.ArchivedAt.Valid
+		}
+		for _, chatBot := range chatBots {
+			if chatBot.R.Bot == nil || chatBot.R.Bot.R.User == nil {
+				continue
+			}
+			addMember(chatBot.R.Bot.R.User, ChatMemberRoleBot, isArchived)
+		}
+	}
+	return chatMembers, nil
+}
+
+// chatChannelName is the name of Ably channel associated with the chat channel
+func chatChannelName(chatChannel *models.Chat) string {
+	if chatChannel.R == nil || chatChannel.R.Parent == nil {
+		return chatChannel.Resource
+	}
+	return chatChannel.R.Parent.Resource + ":" + chatChannel.Resource
+}
+
+// withPresence marks members present on any of the Ably channels as online, presence of the channels is retrieved
+// concurrently (at most PRESENCE_CONCURRENCY requests at once). Failures of Ably are logged and leave presence
+// of members unset
+func withPresence(ctx context.Context, chatMembers []ChatMember, channelNames []string) {
+	online := map[string]bool{}
+	isFailed := false
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, PRESENCE_CONCURRENCY)
+	for _, channelName := range channelNames {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(channelName string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			clientIds, err := ablyService.PresentClientIds(ctx, channelName)
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				log.Warn().Err(err).Msgf("unable to retrieve presence of chat channel %q", channelName)
+				isFailed = true
+				return
+			}
+			for clientId := range clientIds {
+				online[clientId] = true
+			}
+		}(channelName)
+	}
+	wg.Wait()
+	if isFailed {
+		return
+	}
+	for idx := range chatMembers {
+		isOnline := online[chatMembers[idx].ID]
+		chatMembers[idx].Online = &isOnline
+	}
+}
+
+// hasChatMember checks if the user is among the members
+func hasChatMember(chatMembers []ChatMember, userId string) bool {
+	for _, chatMember := range chatMembers {
+		if chatMember.ID == userId {
+			return true
+		}
+	}
+	return false
+}
+
+// paginateChatMembers sorts members (the owner goes first, others by full name) and paginates them
+func paginateChatMembers(chatMembers []ChatMember, params libAPI.PageParams) ([]ChatMember, string, error) {
+	page, nextCursor, err := libAPI.Paginate(chatMembers, params, "name", func(chatMember ChatMember) libAPI.SortKey {
+		rank := "1"
+		if chatMember.Role == ChatMemberRoleOwner {
+			rank = "0"
+		}
+		return libAPI.SortKey{rank, strings.ToLower(chatMember.FullName), chatMember.ID}
+	})
+	if err != nil {
+		return nil, "", ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
+	}
+	return page, nextCursor, nil
+}
@@ -0,0 +1,142 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// MAX_PINNED_ITEMS is the limit of pinned items per chat channel
+const MAX_PINNED_ITEMS = 20
+
+type PinnedItemKind string
+
+const (
+	PinnedItemKindLink    PinnedItemKind = "link"
+	PinnedItemKindMessage PinnedItemKind = "message"
+)
+
+type PinnedItem struct {
+	Kind      PinnedItemKind `json:"kind" enum:"link,message"`
+	URL       *string        `json:"url,omitempty" format:"uri" doc:"required for pinned link"`
+	MessageID *string        `json:"messageId,omitempty" doc:"Ably message ID, required for pinned message"`
+	Title     *string        `json:"title,omitempty" maxLength:"200"`
+	PinnedBy  string         `json:"pinnedBy,omitempty" readOnly:"true"`
+	PinnedAt  *time.Time     `json:"pinnedAt,omitempty" readOnly:"true"`
+}
+
+// ChatRecord is the chat record (group/channel) along with its pinned items
+type ChatRecord struct {
+	models.Chat
+	Pinned []PinnedItem `json:"pinned"`
+}
+
+// ownedChat retrieves chat record (group/channel) which belongs to the chat group owned by user
+func ownedChat(ctx context.Context, db *sql.DB, chatId string, userId string) (*models.Chat, error) {
+	chat, err := models.Chats(
+		models.ChatWhere.ID.EQ(chatId),
+		qm.Load(
+			models.ChatRels.Parent,
+		),
+	).One(ctx, db)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound, err)
+	}
+	chatGroup := chat
+	if chat.ParentID.Valid {
+		chatGroup = chat.R.Parent
+	}
+	if chatGroup == nil || chatGroup.OwnerID != null.StringFrom(userId) {
+		return nil, ErrorMap.GetErrorResponse(
+			Err404_ChatRecordNotFound,
+			errors.New("chat record does not belong to chat group owned by user"),
+		)
+	}
+	return chat, nil
+}
+
+func pinnedItemFromModel(chatPin *models.ChatPin) PinnedItem {
+	return PinnedItem{
+		Kind:      PinnedItemKind(chatPin.Kind),
+		URL:       chatPin.URL.Ptr(),
+		MessageID: chatPin.MessageID.Ptr(),
+		Title:     chatPin.Title.Ptr(),
+		PinnedBy:  chatPin.PinnedBy,
+		PinnedAt:  &chatPin.PinnedAt,
+	}
+}
+
+// pinnedItemsByChatId retrieves pinned items of the chat channels (in order)
+func pinnedItemsByChatId(ctx context.Context, exec boil.ContextExecutor, chatIds []string) (map[string][]PinnedItem, error) {
+	chatPins, err := models.ChatPins(
+		models.ChatPinWhere.ChatID.IN(chatIds),
+		qm.OrderBy(models.ChatPinColumns.Position),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+	pinnedItems := map[string][]PinnedItem{}
+	for _, chatPin := range chatPins {
+		pinnedItems[chatPin.ChatID] = append(pinnedItems[chatPin.ChatID], pinnedItemFromModel(chatPin))
+	}
+	return pinnedItems, nil
+}
+
+// replacePinnedItems replaces pinned items of the chat channel, items pinned earlier keep their author and time
+func replacePinnedItems(ctx context.Context, tx *sql.Tx, chatId string, userId string, items []PinnedItem) error {
+	existing, err := models.ChatPins(
+		models.ChatPinWhere.ChatID.EQ(chatId),
+	).All(ctx, tx)
+	if err != nil {
+		return err
+	}
+	identity := func(kind string, url null.String, messageId null.String) string {
+		return kind + "\x00" + url.String + "\x00" + messageId.String
+	}
+	existingByIdentity := map[string]*models.ChatPin{}
+	for _, chatPin := range existing {
+		existingByIdentity[identity(chatPin.Kind, chatPin.URL, chatPin.MessageID)] = chatPin
+	}
+	if _, err := existing.DeleteAll(ctx, tx); err != nil {
+		return err
+	}
+	now := time.Now()
+	for idx, item := range items {
+		chatPin := models.ChatPin{
+			ChatID:    chatId,
+			Kind:      string(item.Kind),
+			URL:       null.StringFromPtr(item.URL),
+			MessageID: null.StringFromPtr(item.MessageID),
+			Title:     null.StringFromPtr(item.Title),
+			Position:  idx,
+			PinnedBy:  userId,
+			PinnedAt:  now,
+		}
+		if found, ok := existingByIdentity[identity(chatPin.Kind, chatPin.URL, chatPin.MessageID)]; ok {
+			chatPin.PinnedBy = found.PinnedBy
+			chatPin.PinnedAt = found.PinnedAt
+		}
+		if err := chatPin.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePinnedItems makes sure each pinned item refers either to a link or to a message
+func validatePinnedItems(items []PinnedItem) error {
+	for _, item := range items {
+		switch {
+		case item.Kind == PinnedItemKindLink && (item.URL == nil || *item.URL == ""),
+			item.Kind == PinnedItemKindMessage && (item.MessageID == nil || *item.MessageID == ""):
+			return ErrorMap.GetErrorResponse(Err400_InvalidPinnedItem)
+		}
+	}
+	return nil
+}
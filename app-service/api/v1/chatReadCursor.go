@@ -0,0 +1,126 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// UNREAD_COUNT_LIMIT caps the number of unread messages counted per chat channel
+	UNREAD_COUNT_LIMIT = 100
+	// UNREAD_COUNT_CONCURRENCY limits number of simultaneous requests to Ably history API
+	UNREAD_COUNT_CONCURRENCY = 8
+	// UNREAD_COUNT_TTL is the time unread counts are cached for, so repeated listings do not hit Ably history API
+	UNREAD_COUNT_TTL = 30 * time.Second
+)
+
+// unreadCounts caches unread counts by chat channel, user and read cursor, a moved cursor gets counted anew
+var unreadCounts = unreadCountCache{entries: map[string]unreadCountEntry{}}
+
+// withUnreadCounts populates read state of chat channels based on user's read cursors and Ably channel history.
+// Failures of Ably are logged and leave the unread count of the affected channel unset
+func withUnreadCounts(ctx context.Context, db *sql.DB, userId string, chatChannels []ChatChannel) error {
+	if len(chatChannels) == 0 {
+		return nil
+	}
+	chatIds := make([]string, len(chatChannels))
+	for idx := range chatChannels {
+		chatIds[idx] = chatChannels[idx].ID
+	}
+	cursors, err := models.ChatReadCursors(
+		models.ChatReadCursorWhere.UserID.EQ(userId),
+		models.ChatReadCursorWhere.ChatID.IN(chatIds),
+	).All(ctx, db)
+	if err != nil {
+		return ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+	}
+	cursorByChatId := make(map[string]*models.ChatReadCursor, len(cursors))
+	for _, cursor := range cursors {
+		cursorByChatId[cursor.ChatID] = cursor
+	}
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, UNREAD_COUNT_CONCURRENCY)
+	for idx := range chatChannels {
+		chatChannel := &chatChannels[idx]
+		if cursor, ok := cursorByChatId[chatChannel.ID]; ok {
+			lastReadAt := cursor.LastReadAt
+			chatChannel.LastReadAt = &lastReadAt
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() {
+				<-semaphore
+			}()
+			var since time.Time
+			if chatChannel.LastReadAt != nil {
+				since = *chatChannel.LastReadAt
+			}
+			key := unreadCountKey(chatChannel.Resource, userId, since)
+			if count, ok := unreadCounts.get(key); ok {
+				chatChannel.UnreadCount = &count
+				return
+			}
+			count, err := ablyService.CountMessagesSince(ctx, chatChannel.Resource, since, userId, UNREAD_COUNT_LIMIT)
+			if err != nil {
+				log.Error().Err(err).Msgf("unable to count unread messages in %q", chatChannel.Resource)
+				return
+			}
+			unreadCounts.set(key, count, UNREAD_COUNT_TTL)
+			chatChannel.UnreadCount = &count
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+// -- unread count cache
+
+func unreadCountKey(resource string, userId string, since time.Time) string {
+	return fmt.Sprintf("%s|%s|%d", resource, userId, since.UnixNano())
+}
+
+type unreadCountEntry struct {
+	count     int
+	expiresAt time.Time
+}
+
+type unreadCountCache struct {
+	sync.Mutex
+	entries map[string]unreadCountEntry
+}
+
+func (c *unreadCountCache) get(key string) (int, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return 0, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return 0, false
+	}
+	return entry.count, true
+}
+
+func (c *unreadCountCache) set(key string, count int, ttl time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	now := time.Now()
+	// expired entries are evicted on writes to keep the cache bounded by recently listed channels
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = unreadCountEntry{count: count, expiresAt: now.Add(ttl)}
+}
@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUnreadCountCache(t *testing.T) {
+	cache := unreadCountCache{entries: map[string]unreadCountEntry{}}
+	readAt := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	key := unreadCountKey("chat:a:b", "user", readAt)
+	if _, ok := cache.get(key); ok {
+		t.Fatalf("empty cache should miss")
+	}
+	cache.set(key, 5, time.Minute)
+	if count, ok := cache.get(key); !ok || count != 5 {
+		t.Errorf("cached count is %d (%v), expected 5", count, ok)
+	}
+	// a moved cursor (or another user) is counted anew
+	if _, ok := cache.get(unreadCountKey("chat:a:b", "user", readAt.Add(time.Second))); ok {
+		t.Errorf("moved cursor should miss")
+	}
+	if _, ok := cache.get(unreadCountKey("chat:a:b", "other", readAt)); ok {
+		t.Errorf("other user should miss")
+	}
+	// expired entries are neither returned nor kept
+	cache.set(key, 7, -time.Second)
+	if _, ok := cache.get(key); ok {
+		t.Errorf("expired entry should miss")
+	}
+	cache.set(unreadCountKey("chat:a:c", "user", time.Time{}), 1, -time.Second)
+	cache.set(unreadCountKey("chat:a:d", "user", time.Time{}), 2, time.Minute)
+	if len(cache.entries) != 1 {
+		t.Errorf("expired entries should be evicted on write, %d entries kept", len(cache.entries))
+	}
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+)
+
+// MAX_WEBHOOKS_PER_GROUP is the limit of webhooks registered for a chat group
+const MAX_WEBHOOKS_PER_GROUP = 10
+
+type ChatWebhook struct {
+	ID        string                     `json:"id"`
+	URL       string                     `json:"url"`
+	Events    []chatService.WebhookEvent `json:"events"`
+	Secret    string                     `json:"secret,omitempty" doc:"used to verify signatures of webhook requests, reported only once on creation"`
+	CreatedAt time.Time                  `json:"createdAt"`
+}
+
+func chatWebhookFromModel(webhook *models.ChatWebhook) ChatWebhook {
+	return ChatWebhook{
+		ID:        webhook.ID,
+		URL:       webhook.URL,
+		Events:    chatService.WebhookEventsFromString(webhook.Events),
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+// ChatWebhookData is the `data` of webhook payload
+type ChatWebhookData struct {
+	ChatChannelID string     `json:"chatChannelId"`
+	ChatChannel   string     `json:"chatChannel,omitempty" doc:"title of the chat channel"`
+	Topic         *string    `json:"topic,omitempty"`
+	UserID        string     `json:"userId,omitempty"`
+	MessageID     string     `json:"messageId,omitempty" doc:"ID of Ably message"`
+	Text          string     `json:"text,omitempty"`
+	PublishedAt   *time.Time `json:"publishedAt,omitempty"`
+}
+
+// ownedChatGroup retrieves chat group owned by user
+func ownedChatGroup(ctx context.Context, db *sql.DB, chatGroupId string, userId string) (*models.Chat, error) {
+	chatGroup, err := models.Chats(
+		models.ChatWhere.ID.EQ(chatGroupId),
+		models.ChatWhere.ParentID.IsNull(),
+		models.ChatWhere.OwnerID.EQ(null.StringFrom(userId)),
+	).One(ctx, db)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(Err404_ChatGroupNotFound, err)
+	}
+	return chatGroup, nil
+}
+
+// ownedChatWebhook retrieves webhook of the chat group owned by user
+func ownedChatWebhook(ctx context.Context, db *sql.DB, chatGroupId string, webhookId string, userId string) (*models.ChatWebhook, error) {
+	chatGroup, err := ownedChatGroup(ctx, db, chatGroupId, userId)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := models.ChatWebhooks(
+		models.ChatWebhookWhere.ID.EQ(webhookId),
+		models.ChatWebhookWhere.ChatID.EQ(chatGroup.ID),
+	).One(ctx, db)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(Err404_WebhookNotFound, err)
+	}
+	return webhook, nil
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// MAX_DM_PARTICIPANTS is the upper limit of participants (including the creator) of a direct message
+	MAX_DM_PARTICIPANTS = 10
+	// DIRECT_MESSAGES_GROUP_ID is the ID of the synthetic chat group holding all direct messages of the user
+	DIRECT_MESSAGES_GROUP_ID = "direct-messages"
+)
+
+// directMessagesGroup is the synthetic (not stored) parent of direct messages used in grouped listings
+var directMessagesGroup = &models.Chat{
+	ID:        DIRECT_MESSAGES_GROUP_ID,
+	Title:     "Direct messages",
+	IsPrivate: null.BoolFrom(true),
+}
+
+// directMessageKey returns the key identifying direct message by its (sorted, deduplicated) set of participants
+func directMessageKey(userIds []string) string {
+	participants := slices.Clone(userIds)
+	slices.Sort(participants)
+	return strings.Join(slices.Compact(participants), ",")
+}
+
+// directMessageParticipants returns participant IDs encoded in the direct message key
+func directMessageParticipants(dmKey string) []string {
+	return strings.Split(dmKey, ",")
+}
+
+// directMessageBlocks reports users blocked in direct messages: chat ID -> user ID -> blocked
+type directMessageBlocks map[string]map[string]bool
+
+func (blocks directMessageBlocks) isBlocked(chatId string, userId string) bool {
+	return blocks[chatId][userId]
+}
+
+// blocksInDirectMessages checks (in a single query) which of the users are blocked by any other participant of
+// the direct messages
+func blocksInDirectMessages(ctx context.Context, db *sql.DB, chats []*models.Chat, userIds []string) (directMessageBlocks, error) {
+	blocks := directMessageBlocks{}
+	if len(chats) == 0 || len(userIds) == 0 {
+		return blocks, nil
+	}
+	chatIds := make([]interface{}, len(chats))
+	for idx, chat := range chats {
+		chatIds[idx] = chat.ID
+	}
+	rows := []struct {
+		ChatID        string `boil:"chat_id"`
+		BlockedUserID string `boil:"blocked_user_id"`
+	}{}
+	if err := models.UserBlocks(
+		qm.Select(
+			"p."+models.ChatUserColumns.ChatID,
+			models.UserBlockTableColumns.BlockedUserID,
+		),
+		qm.InnerJoin(fmt.Sprintf(
+			"%[1]s AS p ON p.%[2]s = %[3]s AND p.%[4]s IS NULL",
+			models.TableNames.ChatUser,
+			models.ChatUserColumns.UserID,
+			models.UserBlockTableColumns.UserID,
+			models.ChatUserColumns.DeletedAt,
+		)),
+		qm.WhereIn("p."+models.ChatUserColumns.ChatID+" IN ?", chatIds...),
+		models.UserBlockWhere.BlockedUserID.IN(userIds),
+	).Bind(ctx, db, &rows); err != nil {
+		return nil, fmt.Errorf("unable to retrieve blocks in direct messages: %w", err)
+	}
+	for _, row := range rows {
+		if blocks[row.ChatID] == nil {
+			blocks[row.ChatID] = map[string]bool{}
+		}
+		blocks[row.ChatID][row.BlockedUserID] = true
+	}
+	return blocks, nil
+}
+
+// isBlockedInDirectMessage checks whether any other participant of the direct message has blocked the user
+func isBlockedInDirectMessage(ctx context.Context, db *sql.DB, chat *models.Chat, userId string) (bool, error) {
+	blocks, err := blocksInDirectMessages(ctx, db, []*models.Chat{chat}, []string{userId})
+	if err != nil {
+		return false, err
+	}
+	return blocks.isBlocked(chat.ID, userId), nil
+}
+
+// directMessageChannelsForUser presents direct messages as chat channels of the synthetic "Direct messages" group,
+// the title of each one lists other participants. Direct messages where the user is blocked are read-only
+func directMessageChannelsForUser(ctx context.Context, db *sql.DB, userId string, chats []*models.Chat) ([]ChatChannel, error) {
+	chatIds := make([]string, len(chats))
+	for idx, chat := range chats {
+		chatIds[idx] = chat.ID
+	}
+	chatUsers, err := models.ChatUsers(
+		models.ChatUserWhere.ChatID.IN(chatIds),
+		models.ChatUserWhere.UserID.NEQ(userId),
+		qm.Load(models.ChatUserRels.User),
+	).All(ctx, db)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve participants of direct messages: %w", err)
+	}
+	namesByChatId := map[string][]string{}
+	for _, chatUser := range chatUsers {
+		if chatUser.R.User != nil {
+			namesByChatId[chatUser.ChatID] = append(namesByChatId[chatUser.ChatID], chatUser.R.User.FullName)
+		}
+	}
+	blocks, err := blocksInDirectMessages(ctx, db, chats, []string{userId})
+	if err != nil {
+		return nil, err
+	}
+	chatChannels := make([]ChatChannel, 0, len(chats))
+	for _, chat := range chats {
+		readOnly := blocks.isBlocked(chat.ID, userId)
+		names := namesByChatId[chat.ID]
+		slices.Sort(names)
+		chatChannels = append(chatChannels, ChatChannel{
+			ID:            chat.ID,
+			Title:         strings.Join(names, ", "),
+			Resource:      chat.Resource,
+			ReadOnly:      &readOnly,
+			Parent:        directMessagesGroup,
+			UpdatedAt:     chat.UpdatedAt,
+			CreatedAt:     chat.CreatedAt,
+			LastMessageAt: chat.LastMessageAt.Ptr(),
+		})
+	}
+	return chatChannels, nil
+}
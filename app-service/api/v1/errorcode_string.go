@@ -24,17 +24,51 @@ func _() {
 	_ = x[Err400_ChatChannelInviteeOwnsChatGroup-4002014]
 	_ = x[Err400_OnlyForChatGroups-4002015]
 	_ = x[Err400_OnlyForChatChannels-4002016]
+	_ = x[Err400_ChatGroupIsDeleted-4002017]
+	_ = x[Err400_RestoreGracePeriodExpired-4002018]
+	_ = x[Err400_ChatInvitationNotPending-4002019]
+	_ = x[Err400_ChatInviteLinkInactive-4002020]
+	_ = x[Err400_DirectMessageParticipants-4002021]
+	_ = x[Err400_DirectMessageBlocked-4002022]
+	_ = x[Err400_UnableBlockSelf-4002023]
+	_ = x[Err400_InvalidCursor-4002024]
+	_ = x[Err400_ImageDataNotPresent-4002025]
+	_ = x[Err400_FileTooLarge-4002026]
+	_ = x[Err400_InvalidPinnedItem-4002027]
+	_ = x[Err400_TooManyWebhooks-4002028]
+	_ = x[Err400_TooManyBots-4002029]
+	_ = x[Err400_TooManyApiKeys-4002030]
+	_ = x[Err400_BotNotOwned-4002031]
+	_ = x[Err400_InvalidDateRange-4002032]
 	_ = x[Err401_UnknownError-4012001]
 	_ = x[Err401_UserIdNotFound-4012002]
 	_ = x[Err401_UserNotFound-4012003]
 	_ = x[Err401_AuthServiceError-4012004]
 	_ = x[Err401_InvalidAccessToken-4012005]
+	_ = x[Err401_InvalidWebhookSecret-4012006]
+	_ = x[Err401_AuthorizationRequired-4012007]
+	_ = x[Err403_UnknownError-4032001]
+	_ = x[Err403_OperationNotAvailableToBots-4032002]
+	_ = x[Err403_InsufficientScope-4032003]
 	_ = x[Err404_UnknownError-4042001]
 	_ = x[Err404_ChatGroupNotFound-4042002]
 	_ = x[Err404_ChatChannelNotFound-4042003]
 	_ = x[Err404_ChatRecordNotFound-4042004]
+	_ = x[Err404_ChatInvitationNotFound-4042005]
+	_ = x[Err404_ChatInviteLinkNotFound-4042006]
+	_ = x[Err404_UserNotFound-4042007]
+	_ = x[Err404_ModerationReportNotFound-4042008]
+	_ = x[Err404_ChatHasNoAvatar-4042009]
+	_ = x[Err404_WebhookNotFound-4042010]
+	_ = x[Err404_BotNotFound-4042011]
+	_ = x[Err404_ApiKeyNotFound-4042012]
+	_ = x[Err404_TeamNotFound-4042013]
+	_ = x[Err404_PlayerNotFound-4042014]
+	_ = x[Err404_LeagueNotFound-4042015]
 	_ = x[Err417_UnknownError-4172001]
 	_ = x[Err417_InvalidToken-4172002]
+	_ = x[Err417_ChatInvitationRevoked-4172003]
+	_ = x[Err417_ChatInvitationObsolete-4172004]
 	_ = x[Err424_UnknownError-4242001]
 	_ = x[Err424_ScheduleSeason-4242002]
 	_ = x[Err424_DailySchedule-4242003]
@@ -52,46 +86,63 @@ func _() {
 	_ = x[Err500_UnableCreateChatUser-5002003]
 	_ = x[Err500_UnableUpdateChatUser-5002004]
 	_ = x[Err500_UnableUpdateChatRecord-5002005]
+	_ = x[Err500_UnableDeleteChatRecord-5002006]
+	_ = x[Err500_UnableRestoreChatRecord-5002007]
+	_ = x[Err500_UnableCreateChatInvitation-5002008]
+	_ = x[Err500_UnableUpdateChatInvitation-5002009]
+	_ = x[Err500_UnableCreateChatInviteLink-5002010]
+	_ = x[Err500_UnableCreateDirectMessage-5002011]
+	_ = x[Err500_UnableUpdateUserBlock-5002012]
+	_ = x[Err500_UnableUpdateModerationReport-5002013]
+	_ = x[Err500_UnableStoreChatAvatar-5002014]
+	_ = x[Err500_UnableUpdateChatPins-5002015]
+	_ = x[Err500_UnableUpdateWebhook-5002016]
+	_ = x[Err500_UnableUpdateBot-5002017]
 }
 
 const (
-	_ErrorCode_name_0 = "Err400_UnknownErrorErr400_MalformedJSONErr400_InvalidRequestErr400_MissingRequiredQueryParamErr400_ChatGroupExistsErr400_ChannelExistsErr400_ChatGroupIsPrivateErr400_ChatGroupIsPublicErr400_ChatGroupIsSelfOwnedErr400_ChatChannelAlreadyJoinedErr400_EmailNotFoundErr400_InvalidOrMalformedTokenErr400_ChatChannelInviteeNotUserErr400_ChatChannelInviteeOwnsChatGroupErr400_OnlyForChatGroupsErr400_OnlyForChatChannels"
-	_ErrorCode_name_1 = "Err401_UnknownErrorErr401_UserIdNotFoundErr401_UserNotFoundErr401_AuthServiceErrorErr401_InvalidAccessToken"
-	_ErrorCode_name_2 = "Err404_UnknownErrorErr404_ChatGroupNotFoundErr404_ChatChannelNotFoundErr404_ChatRecordNotFound"
-	_ErrorCode_name_3 = "Err417_UnknownErrorErr417_InvalidToken"
-	_ErrorCode_name_4 = "Err424_UnknownErrorErr424_ScheduleSeasonErr424_DailyScheduleErr424_TeamInfoErr424_TeamStatsErr424_PlayerInfoErr424_PlayerStatsErr424_InjuriesErr424_LiveFeedErr424_BasketAPIListGamesErr424_BasketAPIGetGameErr424_UnableToSendEmail"
-	_ErrorCode_name_5 = "Err500_UnknownErrorErr500_UnknownHumaErrorErr500_UnableCreateChatUserErr500_UnableUpdateChatUserErr500_UnableUpdateChatRecord"
+	_ErrorCode_name_0 = "Err400_UnknownErrorErr400_MalformedJSONErr400_InvalidRequestErr400_MissingRequiredQueryParamErr400_ChatGroupExistsErr400_ChannelExistsErr400_ChatGroupIsPrivateErr400_ChatGroupIsPublicErr400_ChatGroupIsSelfOwnedErr400_ChatChannelAlreadyJoinedErr400_EmailNotFoundErr400_InvalidOrMalformedTokenErr400_ChatChannelInviteeNotUserErr400_ChatChannelInviteeOwnsChatGroupErr400_OnlyForChatGroupsErr400_OnlyForChatChannelsErr400_ChatGroupIsDeletedErr400_RestoreGracePeriodExpiredErr400_ChatInvitationNotPendingErr400_ChatInviteLinkInactiveErr400_DirectMessageParticipantsErr400_DirectMessageBlockedErr400_UnableBlockSelfErr400_InvalidCursorErr400_ImageDataNotPresentErr400_FileTooLargeErr400_InvalidPinnedItemErr400_TooManyWebhooksErr400_TooManyBotsErr400_TooManyApiKeysErr400_BotNotOwnedErr400_InvalidDateRange"
+	_ErrorCode_name_1 = "Err401_UnknownErrorErr401_UserIdNotFoundErr401_UserNotFoundErr401_AuthServiceErrorErr401_InvalidAccessTokenErr401_InvalidWebhookSecretErr401_AuthorizationRequired"
+	_ErrorCode_name_2 = "Err403_UnknownErrorErr403_OperationNotAvailableToBotsErr403_InsufficientScope"
+	_ErrorCode_name_3 = "Err404_UnknownErrorErr404_ChatGroupNotFoundErr404_ChatChannelNotFoundErr404_ChatRecordNotFoundErr404_ChatInvitationNotFoundErr404_ChatInviteLinkNotFoundErr404_UserNotFoundErr404_ModerationReportNotFoundErr404_ChatHasNoAvatarErr404_WebhookNotFoundErr404_BotNotFoundErr404_ApiKeyNotFoundErr404_TeamNotFoundErr404_PlayerNotFoundErr404_LeagueNotFound"
+	_ErrorCode_name_4 = "Err417_UnknownErrorErr417_InvalidTokenErr417_ChatInvitationRevokedErr417_ChatInvitationObsolete"
+	_ErrorCode_name_5 = "Err424_UnknownErrorErr424_ScheduleSeasonErr424_DailyScheduleErr424_TeamInfoErr424_TeamStatsErr424_PlayerInfoErr424_PlayerStatsErr424_InjuriesErr424_LiveFeedErr424_BasketAPIListGamesErr424_BasketAPIGetGameErr424_UnableToSendEmail"
+	_ErrorCode_name_6 = "Err500_UnknownErrorErr500_UnknownHumaErrorErr500_UnableCreateChatUserErr500_UnableUpdateChatUserErr500_UnableUpdateChatRecordErr500_UnableDeleteChatRecordErr500_UnableRestoreChatRecordErr500_UnableCreateChatInvitationErr500_UnableUpdateChatInvitationErr500_UnableCreateChatInviteLinkErr500_UnableCreateDirectMessageErr500_UnableUpdateUserBlockErr500_UnableUpdateModerationReportErr500_UnableStoreChatAvatarErr500_UnableUpdateChatPinsErr500_UnableUpdateWebhookErr500_UnableUpdateBot"
 )
 
 var (
-	_ErrorCode_index_0 = [...]uint16{0, 19, 39, 60, 92, 114, 134, 159, 183, 210, 241, 261, 291, 323, 361, 385, 411}
-	_ErrorCode_index_1 = [...]uint8{0, 19, 40, 59, 82, 107}
-	_ErrorCode_index_2 = [...]uint8{0, 19, 43, 69, 94}
-	_ErrorCode_index_3 = [...]uint8{0, 19, 38}
-	_ErrorCode_index_4 = [...]uint8{0, 19, 40, 60, 75, 91, 108, 126, 141, 156, 181, 204, 228}
-	_ErrorCode_index_5 = [...]uint8{0, 19, 42, 69, 96, 125}
+	_ErrorCode_index_0 = [...]uint16{0, 19, 39, 60, 92, 114, 134, 159, 183, 210, 241, 261, 291, 323, 361, 385, 411, 436, 468, 499, 528, 560, 587, 609, 629, 655, 674, 698, 720, 738, 759, 777, 800}
+	_ErrorCode_index_1 = [...]uint8{0, 19, 40, 59, 82, 107, 134, 162}
+	_ErrorCode_index_2 = [...]uint8{0, 19, 53, 77}
+	_ErrorCode_index_3 = [...]uint16{0, 19, 43, 69, 94, 123, 152, 171, 202, 224, 246, 264, 285, 304, 325, 346}
+	_ErrorCode_index_4 = [...]uint8{0, 19, 38, 66, 95}
+	_ErrorCode_index_5 = [...]uint8{0, 19, 40, 60, 75, 91, 108, 126, 141, 156, 181, 204, 228}
+	_ErrorCode_index_6 = [...]uint16{0, 19, 42, 69, 96, 125, 154, 184, 217, 250, 283, 315, 343, 378, 406, 433, 459, 481}
 )
 
 func (i ErrorCode) String() string {
 	switch {
-	case 4002001 <= i && i <= 4002016:
+	case 4002001 <= i && i <= 4002032:
 		i -= 4002001
 		return _ErrorCode_name_0[_ErrorCode_index_0[i]:_ErrorCode_index_0[i+1]]
-	case 4012001 <= i && i <= 4012005:
+	case 4012001 <= i && i <= 4012007:
 		i -= 4012001
 		return _ErrorCode_name_1[_ErrorCode_index_1[i]:_ErrorCode_index_1[i+1]]
-	case 4042001 <= i && i <= 4042004:
-		i -= 4042001
+	case 4032001 <= i && i <= 4032003:
+		i -= 4032001
 		return _ErrorCode_name_2[_ErrorCode_index_2[i]:_ErrorCode_index_2[i+1]]
-	case 4172001 <= i && i <= 4172002:
-		i -= 4172001
+	case 4042001 <= i && i <= 4042015:
+		i -= 4042001
 		return _ErrorCode_name_3[_ErrorCode_index_3[i]:_ErrorCode_index_3[i+1]]
+	case 4172001 <= i && i <= 4172004:
+		i -= 4172001
+		return _ErrorCode_name_4[_ErrorCode_index_4[i]:_ErrorCode_index_4[i+1]]
 	case 4242001 <= i && i <= 4242012:
 		i -= 4242001
-		return _ErrorCode_name_4[_ErrorCode_index_4[i]:_ErrorCode_index_4[i+1]]
-	case 5002001 <= i && i <= 5002005:
-		i -= 5002001
 		return _ErrorCode_name_5[_ErrorCode_index_5[i]:_ErrorCode_index_5[i+1]]
+	case 5002001 <= i && i <= 5002017:
+		i -= 5002001
+		return _ErrorCode_name_6[_ErrorCode_index_6[i]:_ErrorCode_index_6[i+1]]
 	default:
 		return "ErrorCode(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
@@ -20,6 +20,7 @@ func (ec ErrorCode) Ptr() *int {
 const (
 	Err400_Shift = libAPI.ErrStatusGain*http.StatusBadRequest + ErrServiceId
 	Err401_Shift = libAPI.ErrStatusGain*http.StatusUnauthorized + ErrServiceId
+	Err403_Shift = libAPI.ErrStatusGain*http.StatusForbidden + ErrServiceId
 	Err404_Shift = libAPI.ErrStatusGain*http.StatusNotFound + ErrServiceId
 	Err417_Shift = libAPI.ErrStatusGain*http.StatusExpectationFailed + ErrServiceId
 	Err424_Shift = libAPI.ErrStatusGain*http.StatusFailedDependency + ErrServiceId
@@ -43,6 +44,22 @@ const (
 	Err400_ChatChannelInviteeOwnsChatGroup
 	Err400_OnlyForChatGroups
 	Err400_OnlyForChatChannels
+	Err400_ChatGroupIsDeleted
+	Err400_RestoreGracePeriodExpired
+	Err400_ChatInvitationNotPending
+	Err400_ChatInviteLinkInactive
+	Err400_DirectMessageParticipants
+	Err400_DirectMessageBlocked
+	Err400_UnableBlockSelf
+	Err400_InvalidCursor
+	Err400_ImageDataNotPresent
+	Err400_FileTooLarge
+	Err400_InvalidPinnedItem
+	Err400_TooManyWebhooks
+	Err400_TooManyBots
+	Err400_TooManyApiKeys
+	Err400_BotNotOwned
+	Err400_InvalidDateRange
 )
 const (
 	Err401_UnknownError ErrorCode = Err401_Shift + iota + 1
@@ -50,16 +67,36 @@ const (
 	Err401_UserNotFound
 	Err401_AuthServiceError
 	Err401_InvalidAccessToken
+	Err401_InvalidWebhookSecret
+	Err401_AuthorizationRequired
+)
+const (
+	Err403_UnknownError ErrorCode = Err403_Shift + iota + 1
+	Err403_OperationNotAvailableToBots
+	Err403_InsufficientScope
 )
 const (
 	Err404_UnknownError ErrorCode = Err404_Shift + iota + 1
 	Err404_ChatGroupNotFound
 	Err404_ChatChannelNotFound
 	Err404_ChatRecordNotFound
+	Err404_ChatInvitationNotFound
+	Err404_ChatInviteLinkNotFound
+	Err404_UserNotFound
+	Err404_ModerationReportNotFound
+	Err404_ChatHasNoAvatar
+	Err404_WebhookNotFound
+	Err404_BotNotFound
+	Err404_ApiKeyNotFound
+	Err404_TeamNotFound
+	Err404_PlayerNotFound
+	Err404_LeagueNotFound
 )
 const (
 	Err417_UnknownError ErrorCode = Err417_Shift + iota + 1
 	Err417_InvalidToken
+	Err417_ChatInvitationRevoked
+	Err417_ChatInvitationObsolete
 )
 const (
 	Err424_UnknownError   ErrorCode = Err424_Shift + iota + 1
@@ -81,6 +118,18 @@ const (
 	Err500_UnableCreateChatUser
 	Err500_UnableUpdateChatUser
 	Err500_UnableUpdateChatRecord
+	Err500_UnableDeleteChatRecord
+	Err500_UnableRestoreChatRecord
+	Err500_UnableCreateChatInvitation
+	Err500_UnableUpdateChatInvitation
+	Err500_UnableCreateChatInviteLink
+	Err500_UnableCreateDirectMessage
+	Err500_UnableUpdateUserBlock
+	Err500_UnableUpdateModerationReport
+	Err500_UnableStoreChatAvatar
+	Err500_UnableUpdateChatPins
+	Err500_UnableUpdateWebhook
+	Err500_UnableUpdateBot
 )
 
 var ErrorMap = libAPI.ErrorMap[ErrorCode]{
@@ -101,29 +150,76 @@ var ErrorMap = libAPI.ErrorMap[ErrorCode]{
 	Err400_ChatChannelInviteeOwnsChatGroup: "chat group owner cannot be an invitee",
 	Err400_OnlyForChatGroups:               "update allowed only for chat groups",
 	Err400_OnlyForChatChannels:             "update allowed only for chat channels",
+	Err400_ChatGroupIsDeleted:              "chat group holding the channel is deleted, restore it first",
+	Err400_RestoreGracePeriodExpired:       "grace period to restore deleted chat record has expired",
+	Err400_ChatInvitationNotPending:        "chat invitation is not pending (already accepted or revoked)",
+	Err400_ChatInviteLinkInactive:          "chat invite link is revoked, expired or exhausted",
+	Err400_DirectMessageParticipants:       "direct message requires from 2 to 10 distinct participants",
+	Err400_DirectMessageBlocked:            "some participants don't accept direct messages from the user",
+	Err400_UnableBlockSelf:                 "user cannot block themselves",
+	Err400_InvalidCursor:                   "invalid pagination cursor",
+	Err400_ImageDataNotPresent:             "image data not present in multipart request body under key `image`",
+	Err400_FileTooLarge:                    "file too large",
+	Err400_InvalidPinnedItem:               "pinned link requires url, pinned message requires messageId",
+	Err400_TooManyWebhooks:                 "too many webhooks registered for the chat group",
+	Err400_TooManyBots:                     "too many bots owned by the user",
+	Err400_TooManyApiKeys:                  "too many active API keys issued for the bot",
+	Err400_BotNotOwned:                     "bot can be added only to chat groups owned by the owner of the bot",
+	Err400_InvalidDateRange:                "either date or both from and to dates are expected, the range cannot exceed a year",
 	// 401
-	Err401_UnknownError:       "unknown error",
-	Err401_UserIdNotFound:     "userId not present",
-	Err401_UserNotFound:       "user not found",
-	Err401_InvalidAccessToken: "invalid or missing access token",
-	Err401_AuthServiceError:   "unexpected auth-service failure",
+	Err401_UnknownError:          "unknown error",
+	Err401_UserIdNotFound:        "userId not present",
+	Err401_UserNotFound:          "user not found",
+	Err401_InvalidAccessToken:    "invalid or missing access token",
+	Err401_AuthServiceError:      "unexpected auth-service failure",
+	Err401_InvalidWebhookSecret:  "invalid or missing webhook secret",
+	Err401_AuthorizationRequired: "authorization is required",
+	// 403
+	Err403_UnknownError:                "unknown error",
+	Err403_OperationNotAvailableToBots: "operation is not available to bots",
+	Err403_InsufficientScope:           "API key lacks the scope required by the operation",
 	// 404
-	Err404_UnknownError:        "unknown error",
-	Err404_ChatGroupNotFound:   "chat group not found",
-	Err404_ChatChannelNotFound: "chat channel not found",
-	Err404_ChatRecordNotFound:  "chat record (group/channel) not found",
+	Err404_UnknownError:             "unknown error",
+	Err404_ChatGroupNotFound:        "chat group not found",
+	Err404_ChatChannelNotFound:      "chat channel not found",
+	Err404_ChatRecordNotFound:       "chat record (group/channel) not found",
+	Err404_ChatInvitationNotFound:   "chat invitation not found",
+	Err404_ChatInviteLinkNotFound:   "chat invite link not found",
+	Err404_UserNotFound:             "user not found",
+	Err404_ModerationReportNotFound: "moderation report not found",
+	Err404_ChatHasNoAvatar:          "chat has no avatar",
+	Err404_WebhookNotFound:          "webhook not found",
+	Err404_BotNotFound:              "bot not found",
+	Err404_ApiKeyNotFound:           "API key not found",
+	Err404_TeamNotFound:             "team not found",
+	Err404_PlayerNotFound:           "player not found",
+	Err404_LeagueNotFound:           "league not found",
 	// 417
-	Err417_UnknownError: "unknown error",
-	Err417_InvalidToken: "invalid (possibly expired) token",
+	Err417_UnknownError:           "unknown error",
+	Err417_InvalidToken:           "invalid (possibly expired) token",
+	Err417_ChatInvitationRevoked:  "chat invitation has been revoked",
+	Err417_ChatInvitationObsolete: "chat invitation token is obsolete (already accepted or re-sent)",
 	// 424
 	Err424_UnknownError:       "unknown error",
 	Err424_BasketAPIListGames: "unexpected problem with MatchSchedules from BasketAPI",
 	Err424_BasketAPIGetGame:   "unexpected problem with (Match|MatchStatistics|MatchLineups) API from BasketAPI",
 	Err424_UnableToSendEmail:  "unable to send email",
 	// 500
-	Err500_UnknownError:           "internal server error",
-	Err500_UnknownHumaError:       "unidentified upstream Huma error",
-	Err500_UnableCreateChatUser:   "unable to create chat to user association",
-	Err500_UnableUpdateChatUser:   "unable to update chat to user association",
-	Err500_UnableUpdateChatRecord: "unable to update chat record (group/channel)",
+	Err500_UnknownError:                 "internal server error",
+	Err500_UnknownHumaError:             "unidentified upstream Huma error",
+	Err500_UnableCreateChatUser:         "unable to create chat to user association",
+	Err500_UnableUpdateChatUser:         "unable to update chat to user association",
+	Err500_UnableUpdateChatRecord:       "unable to update chat record (group/channel)",
+	Err500_UnableDeleteChatRecord:       "unable to delete chat record (group/channel)",
+	Err500_UnableRestoreChatRecord:      "unable to restore chat record (group/channel)",
+	Err500_UnableCreateChatInvitation:   "unable to create chat invitation",
+	Err500_UnableUpdateChatInvitation:   "unable to update chat invitation",
+	Err500_UnableCreateChatInviteLink:   "unable to create chat invite link",
+	Err500_UnableCreateDirectMessage:    "unable to create direct message",
+	Err500_UnableUpdateUserBlock:        "unable to update blocked users",
+	Err500_UnableUpdateModerationReport: "unable to update moderation report",
+	Err500_UnableStoreChatAvatar:        "unable to store chat avatar",
+	Err500_UnableUpdateChatPins:         "unable to update pinned items",
+	Err500_UnableUpdateWebhook:          "unable to update webhook",
+	Err500_UnableUpdateBot:              "unable to update bot",
 }
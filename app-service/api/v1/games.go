@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+const (
+	// MAX_GAMES_RANGE_DAYS is the longest date range of the schedule returned at once
+	MAX_GAMES_RANGE_DAYS = 366
+	// GAME_DURATION is the expected duration of a game, used for calendar events
+	GAME_DURATION = 150 * time.Minute
+	// CALENDAR_CONTENT_TYPE is the media type of the iCalendar export
+	CALENDAR_CONTENT_TYPE = "text/calendar; charset=utf-8"
+)
+
+// GamesFilter narrows down the schedule to a league, a date range, a team and a status
+type GamesFilter struct {
+	LeagueFilter
+	From               string `query:"from" format:"date" doc:"first date of the schedule (inclusive)"`
+	To                 string `query:"to" format:"date" doc:"last date of the schedule (inclusive)"`
+	TeamId             uint   `query:"teamId" doc:"games of the team only"`
+	Status             string `query:"status" enum:"notstarted,inprogress,finished,postponed,canceled" doc:"games of the status only"`
+	LocalTimeZoneShift int    `query:"localTimeZoneShift" exclusiveMaximum:"0"`
+}
+
+// location of the client, dates are interpreted in it (America/New_York by default)
+func (filter GamesFilter) location() *time.Location {
+	loc, _ := time.LoadLocation("America/New_York")
+	if filter.LocalTimeZoneShift < 0 {
+		loc = time.FixedZone("User timezone", filter.LocalTimeZoneShift*int(time.Hour/time.Second))
+	}
+	return loc
+}
+
+// storeFilter converts the date range into stored games filter of the league, dates are inclusive
+func (filter GamesFilter) storeFilter(league *libBasketAPI.League) (libBasketAPI.GameFilter, error) {
+	loc := filter.location()
+	from, errFrom := time.ParseInLocation(time.DateOnly, filter.From, loc)
+	to, errTo := time.ParseInLocation(time.DateOnly, filter.To, loc)
+	if errFrom != nil || errTo != nil {
+		return libBasketAPI.GameFilter{}, errors.New("both from and to dates are expected")
+	}
+	to = to.AddDate(0, 0, 1)
+	if !from.Before(to) || to.Sub(from) > MAX_GAMES_RANGE_DAYS*24*time.Hour {
+		return libBasketAPI.GameFilter{}, fmt.Errorf("invalid range of dates [%s, %s]", filter.From, filter.To)
+	}
+	return libBasketAPI.GameFilter{
+		TournamentID: league.TournamentID,
+		From:         from,
+		To:           to,
+		TeamID:       filter.TeamId,
+		Status:       libBasketAPI.StatusType(filter.Status),
+	}, nil
+}
+
+// matches reports if the event belongs to the team and is of the status requested (date range is ignored)
+func (filter GamesFilter) matches(ev libBasketAPI.Event) bool {
+	if filter.TeamId != 0 && ev.HomeTeam.ID != filter.TeamId && ev.AwayTeam.ID != filter.TeamId {
+		return false
+	}
+	return filter.Status == "" || string(ev.Status.Type) == filter.Status
+}
+
+// gameStatus describes the status of the event, the clock is reported for games in progress
+func gameStatus(ev libBasketAPI.Event) string {
+	GameStatus := ev.Status.Description
+	if ev.Status.Type == libBasketAPI.StatusType_Inprogress && ev.Time.Played != nil {
+		totalSeconds := *ev.Time.Played - *ev.Time.PeriodLength**ev.Time.TotalPeriodCount
+		if totalSeconds <= 0 {
+			totalSeconds = *ev.Time.Played % *ev.Time.PeriodLength
+		}
+		minutes := totalSeconds / 60
+		seconds := totalSeconds % 60
+		GameStatus = fmt.Sprintf("%s (%d:%02d)", GameStatus, minutes, seconds)
+	}
+	return GameStatus
+}
+
+func gamesFromEvents(events []libBasketAPI.Event, teamEnhancer func(libBasketAPI.TeamId) BasketAPI.TeamInfo) []Game {
+	games := make([]Game, 0, len(events))
+	for _, ev := range events {
+		games = append(games, Game{
+			ID:         ev.ID,
+			GameStatus: gameStatus(ev),
+			AwayScore:  ev.AwayScore.Current,
+			HomeScore:  ev.HomeScore.Current,
+			Date:       time.Unix(ev.StartTimestamp, 0).Format(time.RFC3339),
+			HomeTeam:   teamEnhancer(ev.HomeTeam),
+			AwayTeam:   teamEnhancer(ev.AwayTeam),
+		})
+	}
+	return games
+}
+
+// -- iCalendar (RFC 5545) export
+
+var calendarTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+
+// writeCalendarLine writes content line folded at 75 octets (not splitting UTF-8 sequences)
+func writeCalendarLine(buf *bytes.Buffer, name string, value string) {
+	line := name + ":" + value
+	limit := 75
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		// continuation lines start with a space
+		limit = 74
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+func teamTitle(team BasketAPI.TeamInfo) string {
+	if team.Name != "" {
+		return team.Name
+	}
+	return fmt.Sprintf("Team %d", team.ID)
+}
+
+// gamesCalendar renders games as iCalendar, every game is an event titled "Away @ Home"
+func gamesCalendar(games []Game, name string) []byte {
+	var buf bytes.Buffer
+	dtStamp := time.Now().UTC().Format("20060102T150405Z")
+	writeCalendarLine(&buf, "BEGIN", "VCALENDAR")
+	writeCalendarLine(&buf, "VERSION", "2.0")
+	writeCalendarLine(&buf, "PRODID", "-//Quible//Games//EN")
+	writeCalendarLine(&buf, "CALSCALE", "GREGORIAN")
+	writeCalendarLine(&buf, "METHOD", "PUBLISH")
+	writeCalendarLine(&buf, "X-WR-CALNAME", calendarTextEscaper.Replace(name))
+	for _, game := range games {
+		startAt, err := time.Parse(time.RFC3339, game.Date)
+		if err != nil {
+			continue
+		}
+		summary := fmt.Sprintf("%s @ %s", teamTitle(game.AwayTeam), teamTitle(game.HomeTeam))
+		if game.AwayScore != nil && game.HomeScore != nil {
+			summary = fmt.Sprintf("%s (%d:%d)", summary, *game.AwayScore, *game.HomeScore)
+		}
+		writeCalendarLine(&buf, "BEGIN", "VEVENT")
+		writeCalendarLine(&buf, "UID", fmt.Sprintf("game-%d@quible.io", game.ID))
+		writeCalendarLine(&buf, "DTSTAMP", dtStamp)
+		writeCalendarLine(&buf, "DTSTART", startAt.UTC().Format("20060102T150405Z"))
+		writeCalendarLine(&buf, "DTEND", startAt.Add(GAME_DURATION).UTC().Format("20060102T150405Z"))
+		writeCalendarLine(&buf, "SUMMARY", calendarTextEscaper.Replace(summary))
+		if game.HomeTeam.ArenaName != "" {
+			writeCalendarLine(&buf, "LOCATION", calendarTextEscaper.Replace(game.HomeTeam.ArenaName))
+		}
+		writeCalendarLine(&buf, "DESCRIPTION", calendarTextEscaper.Replace(game.GameStatus))
+		writeCalendarLine(&buf, "END", "VEVENT")
+	}
+	writeCalendarLine(&buf, "END", "VCALENDAR")
+	return buf.Bytes()
+}
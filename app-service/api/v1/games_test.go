@@ -0,0 +1,157 @@
+package v1
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+func TestGamesFilterStoreFilter(t *testing.T) {
+	league := &libBasketAPI.League{ID: "nba", TournamentID: 132}
+	filter := GamesFilter{
+		From:               "2024-03-01",
+		To:                 "2024-03-31",
+		TeamId:             3409,
+		Status:             "finished",
+		LocalTimeZoneShift: -5,
+	}
+	storeFilter, err := filter.storeFilter(league)
+	if err != nil {
+		t.Fatalf("valid range is rejected: %s", err)
+	}
+	// dates are inclusive and interpreted in the client's location
+	loc := time.FixedZone("", -5*60*60)
+	if from := time.Date(2024, time.March, 1, 0, 0, 0, 0, loc); !storeFilter.From.Equal(from) {
+		t.Errorf("range starts at %s, expected %s", storeFilter.From, from)
+	}
+	if to := time.Date(2024, time.April, 1, 0, 0, 0, 0, loc); !storeFilter.To.Equal(to) {
+		t.Errorf("range ends at %s, expected %s", storeFilter.To, to)
+	}
+	if storeFilter.TournamentID != 132 || storeFilter.TeamID != 3409 || storeFilter.Status != libBasketAPI.StatusType_Finished {
+		t.Errorf("league, team and status are not passed: %+v", storeFilter)
+	}
+	// a single day and the longest range are accepted
+	for _, to := range []string{"2024-03-01", "2025-03-01"} {
+		if _, err := (GamesFilter{From: "2024-03-01", To: to}).storeFilter(league); err != nil {
+			t.Errorf("range [2024-03-01, %s] is rejected: %s", to, err)
+		}
+	}
+	for name, invalid := range map[string]GamesFilter{
+		"missing from": {To: "2024-03-31"},
+		"missing to":   {From: "2024-03-01"},
+		"reversed":     {From: "2024-03-31", To: "2024-03-01"},
+		"too long":     {From: "2024-03-01", To: "2025-03-02"},
+	} {
+		if _, err := invalid.storeFilter(league); err == nil {
+			t.Errorf("%s range is accepted", name)
+		}
+	}
+}
+
+func TestGamesFilterMatches(t *testing.T) {
+	game := libBasketAPI.Event{
+		HomeTeam: libBasketAPI.TeamId{ID: 1},
+		AwayTeam: libBasketAPI.TeamId{ID: 2},
+		Status:   libBasketAPI.Status{Type: libBasketAPI.StatusType_Notstarted},
+	}
+	for filter, expected := range map[GamesFilter]bool{
+		{}:                                     true,
+		{TeamId: 1}:                            true,
+		{TeamId: 2}:                            true,
+		{TeamId: 3}:                            false,
+		{Status: "notstarted"}:                 true,
+		{Status: "finished"}:                   false,
+		{TeamId: 2, Status: "notstarted"}:      true,
+		{TeamId: 3, Status: "notstarted"}:      false,
+		{From: "2000-01-01", To: "2000-01-01"}: true,
+	} {
+		if matches := filter.matches(game); matches != expected {
+			t.Errorf("filter %+v matches the game: %v, expected %v", filter, matches, expected)
+		}
+	}
+}
+
+func TestWriteCalendarLine(t *testing.T) {
+	var buf bytes.Buffer
+	writeCalendarLine(&buf, "SUMMARY", "short")
+	if buf.String() != "SUMMARY:short\r\n" {
+		t.Errorf("short line is %q", buf.String())
+	}
+	// long lines are folded at 75 octets, continuation lines start with a space
+	buf.Reset()
+	value := strings.Repeat("a", 200)
+	writeCalendarLine(&buf, "DESCRIPTION", value)
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	if len(lines) != 3 || len(lines[0]) != 75 || len(lines[1]) != 75 || lines[1][0] != ' ' || lines[2][0] != ' ' {
+		t.Errorf("long line is folded as %q", lines)
+	}
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n ", "")
+	if unfolded != "DESCRIPTION:"+value {
+		t.Errorf("unfolded line is %q", unfolded)
+	}
+	// UTF-8 sequences are not split
+	buf.Reset()
+	writeCalendarLine(&buf, "LOCATION", strings.Repeat("é", 60))
+	for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+		if len(line) > 75 || !strings.HasPrefix(line, "LOCATION:") && !strings.HasPrefix(line, " é") {
+			t.Errorf("line %q exceeds the limit or splits a character", line)
+		}
+	}
+}
+
+func TestGamesCalendar(t *testing.T) {
+	homeScore, awayScore := uint(101), uint(99)
+	games := []Game{
+		{
+			ID:         1,
+			GameStatus: "Ended",
+			HomeTeam:   BasketAPI.TeamInfo{ID: 1, Name: "Boston Celtics", ArenaName: "TD Garden"},
+			AwayTeam:   BasketAPI.TeamInfo{ID: 2, Name: "Lakers, LA"},
+			HomeScore:  &homeScore,
+			AwayScore:  &awayScore,
+			Date:       "2024-03-01T19:30:00-05:00",
+		},
+		{
+			ID:         2,
+			GameStatus: "Not started",
+			HomeTeam:   BasketAPI.TeamInfo{ID: 3},
+			AwayTeam:   BasketAPI.TeamInfo{ID: 1, Name: "Boston Celtics"},
+			Date:       "2024-03-03T12:00:00Z",
+		},
+		// games without valid date are skipped
+		{
+			ID:   3,
+			Date: "unknown",
+		},
+	}
+	calendar := string(gamesCalendar(games, "Celtics; games"))
+	if !strings.HasPrefix(calendar, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n") || !strings.HasSuffix(calendar, "END:VCALENDAR\r\n") {
+		t.Errorf("calendar is not wrapped in VCALENDAR:\n%s", calendar)
+	}
+	if count := strings.Count(calendar, "BEGIN:VEVENT\r\n"); count != 2 {
+		t.Errorf("calendar has %d events, expected 2", count)
+	}
+	for _, line := range []string{
+		`X-WR-CALNAME:Celtics\; games`,
+		"UID:game-1@quible.io",
+		"DTSTART:20240302T003000Z",
+		"DTEND:20240302T030000Z",
+		`SUMMARY:Lakers\, LA @ Boston Celtics (99:101)`,
+		"LOCATION:TD Garden",
+		"DESCRIPTION:Ended",
+		"UID:game-2@quible.io",
+		"SUMMARY:Boston Celtics @ Team 3",
+		"DESCRIPTION:Not started",
+	} {
+		if !strings.Contains(calendar, "\r\n"+line+"\r\n") {
+			t.Errorf("calendar has no line %q:\n%s", line, calendar)
+		}
+	}
+	if strings.Contains(calendar, "game-3@") || strings.Count(calendar, "LOCATION:") != 1 {
+		t.Errorf("game without date or arena is rendered wrong:\n%s", calendar)
+	}
+}
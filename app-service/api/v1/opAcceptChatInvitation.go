@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/jwt"
 	"github.com/quible-io/quible-api/lib/models"
@@ -70,7 +72,38 @@ func (impl *VersionedImpl) RegisterAcceptChatInvitation(api huma.API, vc libAPI.
 					errors.New("missing or invalid chatChannelId in extraClaims"),
 				)
 			}
-			// 2. Locate DB records for chat channel, its holding group and its association with invitee
+			// 2. Make sure the invitation is still pending and the token is the most recent one
+			invitation, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(chatChannelId),
+				models.ChatInvitationWhere.InviteeID.EQ(null.StringFrom(inviteeId)),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err417_InvalidToken,
+					err,
+				)
+			}
+			switch invitationStatus(invitation) {
+			case InvitationStatusRevoked:
+				return nil, ErrorMap.GetErrorResponse(
+					Err417_ChatInvitationRevoked,
+				)
+			case InvitationStatusAccepted:
+				return nil, ErrorMap.GetErrorResponse(
+					Err417_ChatInvitationObsolete,
+				)
+			case InvitationStatusExpired:
+				return nil, ErrorMap.GetErrorResponse(
+					Err417_InvalidToken,
+					errors.New("chat invitation has expired"),
+				)
+			}
+			if tokenId, ok := extraClaims["tokenId"].(string); ok && tokenId != invitation.TokenID {
+				return nil, ErrorMap.GetErrorResponse(
+					Err417_ChatInvitationObsolete,
+				)
+			}
+			// 3. Locate DB records for chat channel, its holding group and its association with invitee
 			chatChannel, err := models.Chats(
 				models.ChatWhere.ID.EQ(chatChannelId),
 				models.ChatWhere.ParentID.IsNotNull(),
@@ -101,15 +134,48 @@ func (impl *VersionedImpl) RegisterAcceptChatInvitation(api huma.API, vc libAPI.
 					errors.New("qualified chat-user association not found"),
 				)
 			}
-			// 3. Update chat-user association (to clear "disabled" flag)
+			// 4. Update chat-user association (to clear "disabled" flag) and mark invitation as accepted
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
 			chatUser := chatChannel.R.ChatUsers[0]
 			chatUser.Disabled = false
-			if _, err := chatUser.Update(ctx, db, boil.Whitelist("disabled")); err != nil {
+			if _, err := chatUser.Update(ctx, tx, boil.Whitelist("disabled")); err != nil {
+				_ = tx.Rollback()
 				return nil, ErrorMap.GetErrorResponse(
 					Err500_UnableUpdateChatUser,
 					err,
 				)
 			}
+			invitation.Status = string(InvitationStatusAccepted)
+			invitation.AcceptedAt = null.TimeFrom(time.Now())
+			if _, err := invitation.Update(ctx, tx, boil.Whitelist(
+				models.ChatInvitationColumns.Status,
+				models.ChatInvitationColumns.AcceptedAt,
+			)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableUpdateChatInvitation,
+					err,
+				)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			webhookData := ChatWebhookData{
+				ChatChannelID: chatChannel.ID,
+				ChatChannel:   chatChannel.Title,
+				UserID:        chatUser.UserID,
+			}
+			chatService.EmitWebhookEventForChat(ctx, db, chatChannel, chatService.WebhookEventInvitationAccepted, webhookData)
+			chatService.EmitWebhookEventForChat(ctx, db, chatChannel, chatService.WebhookEventMemberJoined, webhookData)
 			return nil, nil
 		},
 	)
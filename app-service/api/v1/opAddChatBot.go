@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type AddChatBotInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	BotId       string `path:"botId" format:"uuid"`
+}
+
+type AddChatBotOutput struct {
+	Body Bot
+}
+
+func (impl *VersionedImpl) RegisterAddChatBot(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "put-add-chat-bot",
+				Summary:       "Add bot to chat group",
+				Description:   "Add bot to the chat group, the bot gets access to all chat channels of the group (logged in user must own both the chat group and the bot)",
+				Method:        http.MethodPut,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "bot", "protected"},
+				Path: "/chat/groups/{chatGroupId}/bots/{botId}",
+			},
+		),
+		func(ctx context.Context, input *AddChatBotInput) (*AddChatBotOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opAddChatBot")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate ownership of the chat group and the bot
+			chatGroup, err := ownedChatGroup(ctx, db, input.ChatGroupId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := ownedBot(ctx, db, input.BotId, input.UserId); err != nil {
+				if exists, _ := models.Bots(models.BotWhere.UserID.EQ(input.BotId)).Exists(ctx, db); exists {
+					return nil, ErrorMap.GetErrorResponse(Err400_BotNotOwned)
+				}
+				return nil, err
+			}
+			// 2. Add the bot (adding it again is no-op)
+			exists, err := models.ChatBots(
+				models.ChatBotWhere.ChatID.EQ(chatGroup.ID),
+				models.ChatBotWhere.BotID.EQ(input.BotId),
+			).Exists(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if !exists {
+				chatBot := models.ChatBot{
+					ChatID:  chatGroup.ID,
+					BotID:   input.BotId,
+					AddedBy: input.UserId,
+				}
+				if err := chatBot.Insert(ctx, db, boil.Infer()); err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+				}
+			}
+			// 3. Prepare and return the response
+			bot, err := models.Bots(
+				models.BotWhere.UserID.EQ(input.BotId),
+				qm.Load(models.BotRels.User),
+				qm.Load(models.BotRels.ChatBots),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			return &AddChatBotOutput{
+				Body: botFromModel(bot),
+			}, nil
+		},
+	)
+}
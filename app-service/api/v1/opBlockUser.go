@@ -0,0 +1,74 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type BlockUserInput struct {
+	AuthorizationHeaderResolver
+	BlockedUserId string `path:"userId" format:"uuid"`
+}
+
+type BlockUserOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterBlockUser(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "put-block-user",
+				Summary:       "Block user",
+				Description:   "Prevent user from sending direct messages to the logged in user",
+				Method:        http.MethodPut,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/blocks/{userId}",
+			},
+		),
+		func(ctx context.Context, input *BlockUserInput) (*BlockUserOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opBlockUser")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate the request
+			if input.BlockedUserId == input.UserId {
+				return nil, ErrorMap.GetErrorResponse(Err400_UnableBlockSelf)
+			}
+			userFound, err := models.UserExists(ctx, db, input.BlockedUserId)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if !userFound {
+				return nil, ErrorMap.GetErrorResponse(Err404_UserNotFound)
+			}
+			// 2. Store the block (repeated requests are no-op)
+			userBlock := models.UserBlock{
+				UserID:        input.UserId,
+				BlockedUserID: input.BlockedUserId,
+			}
+			if err := userBlock.Upsert(
+				ctx,
+				db,
+				false,
+				[]string{models.UserBlockColumns.UserID, models.UserBlockColumns.BlockedUserID},
+				boil.None(),
+				boil.Infer(),
+			); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateUserBlock, err)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/botService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type CreateApiKeyInput struct {
+	AuthorizationHeaderResolver
+	BotId string `path:"botId" format:"uuid"`
+	Body  struct {
+		Name      string             `json:"name" minLength:"1" maxLength:"100"`
+		Scopes    []botService.Scope `json:"scopes" minItems:"1" enum:"chat:read,chat:write" doc:"'chat:write' (publish to chat channels) implies 'chat:read'"`
+		ExpiresAt *time.Time         `json:"expiresAt,omitempty" doc:"the key never expires when omitted"`
+	}
+}
+
+type CreateApiKeyOutput struct {
+	Body ApiKey
+}
+
+func (impl *VersionedImpl) RegisterCreateApiKey(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "post-create-api-key",
+				Summary:       "Create API key",
+				Description:   "Issue API key of the bot owned by the logged in user, the key is reported only once and stored hashed",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusCreated,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots/{botId}/keys",
+			},
+		),
+		func(ctx context.Context, input *CreateApiKeyInput) (*CreateApiKeyOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opCreateApiKey")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate bot ownership and the number of active API keys
+			bot, err := ownedBot(ctx, db, input.BotId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			if input.Body.ExpiresAt != nil && input.Body.ExpiresAt.Before(time.Now()) {
+				return nil, ErrorMap.GetErrorResponse(Err400_InvalidRequest)
+			}
+			count, err := models.APIKeys(
+				models.APIKeyWhere.BotID.EQ(bot.UserID),
+				models.APIKeyWhere.RevokedAt.IsNull(),
+			).Count(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if count >= MAX_API_KEYS_PER_BOT {
+				return nil, ErrorMap.GetErrorResponse(Err400_TooManyApiKeys)
+			}
+			// 2. Generate the key and store its hash
+			key, prefix, hashedKey, err := botService.GenerateApiKey()
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			apiKey := models.APIKey{
+				BotID:     bot.UserID,
+				Name:      input.Body.Name,
+				Prefix:    prefix,
+				HashedKey: hashedKey,
+				Scopes:    botService.ScopesString(input.Body.Scopes),
+				ExpiresAt: null.TimeFromPtr(input.Body.ExpiresAt),
+			}
+			if err := apiKey.Insert(ctx, db, boil.Infer()); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			// 3. Prepare and return the response (the only time the key is reported)
+			response := apiKeyFromModel(&apiKey)
+			response.Key = key
+			return &CreateApiKeyOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type CreateBotInput struct {
+	AuthorizationHeaderResolver
+	Body struct {
+		Name string `json:"name" minLength:"1" maxLength:"100"`
+	}
+}
+
+type CreateBotOutput struct {
+	Body Bot
+}
+
+func (impl *VersionedImpl) RegisterCreateBot(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "post-create-bot",
+				Summary:       "Create bot",
+				Description:   "Create service account (bot) owned by the logged in user, the bot authenticates with API keys",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusCreated,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots",
+			},
+		),
+		func(ctx context.Context, input *CreateBotInput) (*CreateBotOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opCreateBot")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate the number of owned bots
+			count, err := models.Bots(
+				models.BotWhere.OwnerID.EQ(input.UserId),
+			).Count(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if count >= MAX_BOTS_PER_USER {
+				return nil, ErrorMap.GetErrorResponse(Err400_TooManyBots)
+			}
+			// 2. Create user record of the bot (it has no password and is never activated, so it cannot log in)
+			botId := uuid.NewString()
+			user := models.User{
+				ID:       botId,
+				Username: "bot-" + strings.ReplaceAll(botId, "-", "")[:12],
+				Email:    botId + "@" + BOT_EMAIL_DOMAIN,
+				FullName: input.Body.Name,
+			}
+			bot := models.Bot{
+				UserID:  botId,
+				OwnerID: input.UserId,
+				Name:    input.Body.Name,
+			}
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if err := user.Insert(ctx, tx, boil.Infer()); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if err := bot.Insert(ctx, tx, boil.Infer()); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			// 3. Prepare and return the response
+			response := botFromModel(&bot)
+			response.Username = user.Username
+			return &CreateBotOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
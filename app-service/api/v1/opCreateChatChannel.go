@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
@@ -82,6 +83,11 @@ func (impl *VersionedImpl) RegisterCreateChatChannel(api huma.API, vc libAPI.Ver
 					err,
 				)
 			}
+			chatService.EmitWebhookEventForChat(ctx, db, &chatChannel, chatService.WebhookEventChannelCreated, ChatWebhookData{
+				ChatChannelID: chatChannel.ID,
+				ChatChannel:   chatChannel.Title,
+				UserID:        input.UserId,
+			})
 			return &CreateChatChannelOutput{
 				Body: chatChannel,
 			}, nil
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
@@ -16,7 +17,7 @@ import (
 type CreateChatGroupInput struct {
 	AuthorizationHeaderResolver
 	Body struct {
-		Name      string  `json:"name" pattern:"\\w+" doc:"unique across all chat groups owned by the same user"`
+		Name      string  `json:"name" pattern:"\\w+" doc:"unique across all chat groups owned by the same user (Ably namespace is based on chat group ID)"`
 		Title     string  `json:"title" doc:"human-readable 'title' of the chat group"`
 		Summary   *string `json:"summary,omitempty"`
 		IsPrivate bool    `json:"isPrivate"`
@@ -49,12 +50,11 @@ func (impl *VersionedImpl) RegisterCreateChatGroup(api huma.API, vc libAPI.Versi
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opCreateChatGroup")
 			db := deps.Get("db").(*sql.DB)
-			resource := GROUP_PREFIX + input.Body.Name
 			chatGroupFound, err := models.Chats(
 				models.ChatWhere.OwnerID.EQ(null.StringFrom(input.UserId)),
 				models.ChatWhere.ParentID.IsNull(),
 				qm.Expr(
-					qm.Or2(models.ChatWhere.Resource.EQ(resource)),
+					qm.Or2(models.ChatWhere.Name.EQ(null.StringFrom(input.Body.Name))),
 					qm.Or2(models.ChatWhere.Title.EQ(input.Body.Title)),
 				),
 			).Exists(ctx, db)
@@ -69,8 +69,11 @@ func (impl *VersionedImpl) RegisterCreateChatGroup(api huma.API, vc libAPI.Versi
 					Err400_ChatGroupExists,
 				)
 			}
+			chatGroupId := uuid.NewString()
 			chatGroup := models.Chat{
-				Resource:  resource,
+				ID:        chatGroupId,
+				Resource:  groupResource(chatGroupId),
+				Name:      null.StringFrom(input.Body.Name),
 				ParentID:  null.StringFromPtr(nil),
 				IsPrivate: null.BoolFrom(input.Body.IsPrivate),
 				OwnerID:   null.StringFrom(input.UserId),
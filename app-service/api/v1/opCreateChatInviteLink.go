@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type CreateChatInviteLinkInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	Body          struct {
+		ExpiresIn *int  `json:"expiresIn,omitempty" minimum:"1" doc:"optional lifespan of the link in seconds"`
+		MaxUses   *int  `json:"maxUses,omitempty" minimum:"1" doc:"optional max number of times the link can be redeemed"`
+		ReadOnly  *bool `json:"readOnly,omitempty" doc:"members joined via the link get read-only access"`
+	}
+}
+
+type CreateChatInviteLinkOutput struct {
+	Body ChatInviteLink
+}
+
+func (impl *VersionedImpl) RegisterCreateChatInviteLink(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "create-chat-invite-link",
+				Summary:       "Create chat invite link",
+				Description:   "Create shareable link to join private chat channel (logged in user must be the owner of the holding chat group)",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusCreated,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/links",
+			},
+		),
+		func(ctx context.Context, input *CreateChatInviteLinkInput) (*CreateChatInviteLinkOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opCreateChatInviteLink")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			if _, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId); err != nil {
+				return nil, err
+			}
+			// 2. Create the link
+			code, err := newInviteLinkCode()
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			link := models.ChatInviteLink{
+				ChatID:    input.ChatChannelId,
+				CreatorID: input.UserId,
+				Code:      code,
+				IsRo:      input.Body.ReadOnly != nil && *input.Body.ReadOnly,
+				MaxUses:   null.IntFromPtr(input.Body.MaxUses),
+				CreatedAt: time.Now(),
+			}
+			if input.Body.ExpiresIn != nil {
+				link.ExpiresAt = null.TimeFrom(link.CreatedAt.Add(time.Duration(*input.Body.ExpiresIn) * time.Second))
+			}
+			if err := link.Insert(ctx, db, boil.Infer()); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableCreateChatInviteLink,
+					err,
+				)
+			}
+			return &CreateChatInviteLinkOutput{
+				Body: toChatInviteLink(&link),
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,88 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type CreateChatWebhookInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	Body        struct {
+		URL    string                     `json:"url" format:"uri" pattern:"^https://" maxLength:"2000" doc:"HTTPS endpoint receiving POST requests"`
+		Events []chatService.WebhookEvent `json:"events" minItems:"1" enum:"member.joined,member.left,channel.created,channel.updated,invitation.accepted,message.posted"`
+	}
+}
+
+type CreateChatWebhookOutput struct {
+	Body ChatWebhook
+}
+
+func (impl *VersionedImpl) RegisterCreateChatWebhook(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "post-create-chat-webhook",
+				Summary:       "Create chat webhook",
+				Description:   "Register outbound webhook receiving events of the chat group (logged in user must be the owner)",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusCreated,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/webhooks",
+			},
+		),
+		func(ctx context.Context, input *CreateChatWebhookInput) (*CreateChatWebhookOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opCreateChatWebhook")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat group ownership and the number of registered webhooks
+			chatGroup, err := ownedChatGroup(ctx, db, input.ChatGroupId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			count, err := models.ChatWebhooks(
+				models.ChatWebhookWhere.ChatID.EQ(chatGroup.ID),
+			).Count(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if count >= MAX_WEBHOOKS_PER_GROUP {
+				return nil, ErrorMap.GetErrorResponse(Err400_TooManyWebhooks)
+			}
+			// 2. Create the webhook with a newly generated secret
+			secret, err := chatService.GenerateWebhookSecret()
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			webhook := models.ChatWebhook{
+				ChatID:    chatGroup.ID,
+				URL:       input.Body.URL,
+				Secret:    secret,
+				Events:    chatService.WebhookEventsString(input.Body.Events),
+				CreatedBy: input.UserId,
+			}
+			if err := webhook.Insert(ctx, db, boil.Infer()); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			// 3. Prepare and return the response (the only time the secret is reported)
+			response := chatWebhookFromModel(&webhook)
+			response.Secret = secret
+			return &CreateChatWebhookOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
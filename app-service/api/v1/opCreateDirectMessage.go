@@ -0,0 +1,151 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type CreateDirectMessageInput struct {
+	AuthorizationHeaderResolver
+	Body struct {
+		UserIds []string `json:"userIds" minItems:"1" maxItems:"9" doc:"IDs of other participants (the logged in user is added implicitly)"`
+	}
+}
+
+type CreateDirectMessageOutput struct {
+	Body ChatChannel
+}
+
+func (impl *VersionedImpl) RegisterCreateDirectMessage(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "create-direct-message",
+				Summary:       "Create direct message",
+				Description:   "Create (or return existing) direct message between the logged in user and other users",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/dm",
+			},
+		),
+		func(ctx context.Context, input *CreateDirectMessageInput) (*CreateDirectMessageOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opCreateDirectMessage")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate the set of participants
+			participantIds := []string{input.UserId}
+			otherIds := []string{}
+			for _, userId := range input.Body.UserIds {
+				if _, err := uuid.Parse(userId); err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err404_UserNotFound, err)
+				}
+				if userId != input.UserId {
+					participantIds = append(participantIds, userId)
+					otherIds = append(otherIds, userId)
+				}
+			}
+			dmKey := directMessageKey(participantIds)
+			participantIds = directMessageParticipants(dmKey)
+			if len(participantIds) < 2 || len(participantIds) > MAX_DM_PARTICIPANTS {
+				return nil, ErrorMap.GetErrorResponse(Err400_DirectMessageParticipants)
+			}
+			usersCount, err := models.Users(
+				models.UserWhere.ID.IN(participantIds),
+			).Count(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if int(usersCount) != len(participantIds) {
+				return nil, ErrorMap.GetErrorResponse(Err404_UserNotFound)
+			}
+			isBlocked, err := models.UserBlocks(
+				models.UserBlockWhere.BlockedUserID.EQ(input.UserId),
+				models.UserBlockWhere.UserID.IN(otherIds),
+			).Exists(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if isBlocked {
+				return nil, ErrorMap.GetErrorResponse(Err400_DirectMessageBlocked)
+			}
+			// 2. Return existing direct message for the same set of participants or create a new one
+			chat, err := models.Chats(
+				models.ChatWhere.DMKey.EQ(null.StringFrom(dmKey)),
+			).One(ctx, db)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if chat == nil {
+				chat, err = createDirectMessage(ctx, db, dmKey, participantIds)
+				if err != nil {
+					// concurrent request might have created the same direct message
+					chatFound, errFind := models.Chats(
+						models.ChatWhere.DMKey.EQ(null.StringFrom(dmKey)),
+					).One(ctx, db)
+					if errFind != nil {
+						return nil, ErrorMap.GetErrorResponse(Err500_UnableCreateDirectMessage, err, errFind)
+					}
+					chat = chatFound
+				}
+			}
+			// 3. Prepare and return the response
+			chatChannels, err := directMessageChannelsForUser(ctx, db, input.UserId, []*models.Chat{chat})
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			return &CreateDirectMessageOutput{
+				Body: chatChannels[0],
+			}, nil
+		},
+	)
+}
+
+// createDirectMessage stores direct message along with its participants
+func createDirectMessage(ctx context.Context, db *sql.DB, dmKey string, participantIds []string) (*models.Chat, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	chatId := uuid.NewString()
+	chat := &models.Chat{
+		ID:        chatId,
+		Resource:  DM_PREFIX + chatId,
+		Title:     "Direct message",
+		IsPrivate: null.BoolFrom(true),
+		DMKey:     null.StringFrom(dmKey),
+	}
+	if err := chat.Insert(ctx, tx, boil.Infer()); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	for _, userId := range participantIds {
+		chatUser := models.ChatUser{
+			ChatID: chatId,
+			UserID: userId,
+		}
+		if err := chatUser.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return chat, nil
+}
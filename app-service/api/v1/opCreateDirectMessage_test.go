@@ -0,0 +1,200 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestCreateDirectMessage(t *testing.T) {
+	// 1. Import users and blocks from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opCreateDirectMessage")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "user_blocks", UserBlocksCSV); err != nil {
+		t.Fatalf("unable to import user blocks data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessIdempotentCreation": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success creating direct message once per set of participants",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							// User B
+							"userIds": []string{"42d29b4b-935d-4f35-b26c-70080107f6d6"},
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						var chatChannel v1.ChatChannel
+						if err := json.NewDecoder(res.Result().Body).Decode(&chatChannel); err != nil {
+							return false
+						}
+						// the same participants (listed by the other one, the caller included) get the same direct message
+						// User B
+						mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+						res = tc.TestAPI.Post(
+							"/api/chat/dm",
+							"Authorization: valid",
+							map[string]any{
+								"userIds": []string{
+									"9bef41ed-fb10-4791-b02e-96b372c09466",
+									"42d29b4b-935d-4f35-b26c-70080107f6d6",
+								},
+							},
+						)
+						if res.Code != http.StatusOK {
+							return false
+						}
+						var chatChannelFound v1.ChatChannel
+						if err := json.NewDecoder(res.Result().Body).Decode(&chatChannelFound); err != nil {
+							return false
+						}
+						if chatChannelFound.ID != chatChannel.ID {
+							return false
+						}
+						chatsCount, err := models.Chats(
+							models.ChatWhere.DMKey.IsNotNull(),
+						).Count(context.Background(), db)
+						if err != nil || chatsCount != 1 {
+							return false
+						}
+						chatUsersCount, err := models.ChatUsers(
+							models.ChatUserWhere.ChatID.EQ(chatChannel.ID),
+						).Count(context.Background(), db)
+						return err == nil && chatUsersCount == 2
+					},
+				},
+			}
+		},
+		"FailureOnSelfOnly": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on direct message without other participants",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							// User A
+							"userIds": []string{"9bef41ed-fb10-4791-b02e-96b372c09466"},
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_DirectMessageParticipants.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnUnknownUser": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on unknown participant",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"userIds": []string{"5b0e0cf4-0d0c-4c3e-8f8a-2f1d0c9e7a61"},
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_UserNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnBlockedByParticipant": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on participant who blocked the logged in user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							// User C
+							"userIds": []string{"c6174e8a-e12f-4d64-a4fe-a3b0c081bd31"},
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_DirectMessageBlocked.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodPost, "/chat/dm"))
+	}
+}
@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+)
+
+type DeleteBotInput struct {
+	AuthorizationHeaderResolver
+	BotId string `path:"botId" format:"uuid"`
+}
+
+type DeleteBotOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterDeleteBot(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-bot",
+				Summary:       "Delete bot",
+				Description:   "Delete bot owned by the logged in user: its API keys are revoked and it is removed from all chat groups",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots/{botId}",
+			},
+		),
+		func(ctx context.Context, input *DeleteBotInput) (*DeleteBotOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opDeleteBot")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the bot
+			bot, err := ownedBot(ctx, db, input.BotId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			// 2. Revoke API keys, remove the bot from chat groups and (softly) delete it, the user record of the bot
+			// is kept since it may be referenced by the history of chat channels
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if _, err := models.APIKeys(
+				models.APIKeyWhere.BotID.EQ(bot.UserID),
+				models.APIKeyWhere.RevokedAt.IsNull(),
+			).UpdateAll(ctx, tx, models.M{
+				models.APIKeyColumns.RevokedAt: null.TimeFrom(time.Now()),
+			}); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if _, err := models.ChatBots(
+				models.ChatBotWhere.BotID.EQ(bot.UserID),
+			).DeleteAll(ctx, tx); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if _, err := bot.Delete(ctx, tx, false); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			return nil, nil
+		},
+	)
+}
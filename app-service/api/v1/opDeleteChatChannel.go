@@ -0,0 +1,82 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type DeleteChatChannelInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId   string `path:"chatGroupId" format:"uuid"`
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+}
+
+type DeleteChatChannelOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterDeleteChatChannel(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-chat-channel",
+				Summary:       "Delete chat channel",
+				Description:   "Delete chat channel of the chat group for all its members (logged in user must own the chat group). Deleted chat channel can be restored within the grace period",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/channels/{chatChannelId}",
+			},
+		),
+		func(ctx context.Context, input *DeleteChatChannelInput) (*DeleteChatChannelOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opDeleteChatChannel")
+			db := deps.Get("db").(*sql.DB)
+			chatChannel, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatChannelId),
+				models.ChatWhere.ParentID.EQ(null.StringFrom(input.ChatGroupId)),
+				qm.InnerJoin(models.TableNames.Chats+" g on g.id = "+models.TableNames.Chats+".parent_id"),
+				qm.Where("g.owner_id = ? and g.deleted_at is null", input.UserId),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatChannelNotFound,
+					err,
+				)
+			}
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableDeleteChatRecord,
+					err,
+				)
+			}
+			if err := softDeleteChats(ctx, tx, []string{chatChannel.ID}, time.Now().Truncate(time.Microsecond)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableDeleteChatRecord,
+					err,
+				)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableDeleteChatRecord,
+					err,
+				)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -0,0 +1,174 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (tc *SerialTestCases) TestDeleteChatChannel(t *testing.T) {
+	// 1. Import users, chats and memberships from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	for _, op := range []string{"opDeleteChatChannel", "opLeaveChatChannel"} {
+		deps := tc.ServiceAPI.SetContext(op)
+		deps.Set("db", db)
+	}
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	isDeleted := func(chatId string) bool {
+		found, err := models.ChatExists(context.Background(), db, chatId)
+		return err == nil && !found
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessSoftDelete": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success marking chat channel and its memberships as deleted, other channels are kept",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId":   "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+						"chatChannelId": "d0d784df-092f-465f-a479-9523a61ddb53",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusNoContent,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						if !isDeleted("d0d784df-092f-465f-a479-9523a61ddb53") ||
+							isDeleted("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3") ||
+							isDeleted("8482ba32-840b-4ccd-8d0f-ab5f6628bbcf") {
+							return false
+						}
+						chatUser, err := models.ChatUsers(
+							qm.WithDeleted(),
+							models.ChatUserWhere.ChatID.EQ("d0d784df-092f-465f-a479-9523a61ddb53"),
+						).One(context.Background(), db)
+						return err == nil && chatUser.DeletedAt.Valid
+					},
+				},
+			}
+		},
+		"FailureOnChannelOfOtherChatGroup": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel which does not belong to the chat group",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId":   "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+						"chatChannelId": "0ea83a0c-02a8-4415-939b-2fe1a99bbcb5",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return !isDeleted("0ea83a0c-02a8-4415-939b-2fe1a99bbcb5")
+					},
+				},
+			}
+		},
+		"FailureOnChatGroupOfOtherOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel of the group owned by another user (even a member of the channel)",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId":   "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+						"chatChannelId": "d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User B
+					mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return !isDeleted("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// the owner (not a member) cannot leave the channel, leaving never deletes it
+						// User A
+						mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+						res := tc.TestAPI.Delete(
+							"/api/chat/channels/d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3",
+							"Authorization: valid",
+						)
+						return res.Code == http.StatusNotFound && !isDeleted("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3")
+					},
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodDelete, "/chat/groups/%s/channels/%s", "chatGroupId", "chatChannelId"))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	libAPI "github.com/quible-io/quible-api/lib/api"
@@ -26,7 +27,7 @@ func (impl *VersionedImpl) RegisterDeleteChatGroup(api huma.API, vc libAPI.Versi
 			huma.Operation{
 				OperationID:   "delete-chat-group",
 				Summary:       "Delete chat group",
-				Description:   "Delete chat group (logged in user must be the owner)",
+				Description:   "Delete chat group along with its channels (logged in user must be the owner). Deleted chat group can be restored within the grace period",
 				Method:        http.MethodDelete,
 				DefaultStatus: http.StatusNoContent,
 				Errors: []int{
@@ -52,17 +53,35 @@ func (impl *VersionedImpl) RegisterDeleteChatGroup(api huma.API, vc libAPI.Versi
 					err,
 				)
 			}
-			_, err = chatGroup.ParentChats().DeleteAll(ctx, db)
+			chatChannels, err := chatGroup.ParentChats().All(ctx, db)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err500_UnknownError,
 					err,
 				)
 			}
-			_, err = chatGroup.Delete(ctx, db)
+			chatIds := []string{chatGroup.ID}
+			for _, chatChannel := range chatChannels {
+				chatIds = append(chatIds, chatChannel.ID)
+			}
+			// 1. Mark chat group, its channels and memberships as deleted (all at once)
+			tx, err := db.BeginTx(ctx, nil)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
-					Err500_UnknownError,
+					Err500_UnableDeleteChatRecord,
+					err,
+				)
+			}
+			if err := softDeleteChats(ctx, tx, chatIds, time.Now().Truncate(time.Microsecond)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableDeleteChatRecord,
+					err,
+				)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableDeleteChatRecord,
 					err,
 				)
 			}
@@ -0,0 +1,142 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (tc *SerialTestCases) TestDeleteChatGroup(t *testing.T) {
+	// 1. Import users and chats from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opDeleteChatGroup")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessSoftDelete": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success marking chat group, its channels and memberships as deleted at once",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusNoContent,
+				},
+				PreHook: func(t *testing.T) any {
+					gock.New(authServiceHost).
+						Get("/api/v1/user").
+						MatchHeader("Authorization", "valid").
+						Reply(http.StatusOK).
+						JSON(map[string]string{
+							// User A
+							"id": "9bef41ed-fb10-4791-b02e-96b372c09466",
+						})
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// deleted records are hidden unless requested explicitly
+						found, err := models.ChatExists(context.Background(), db, "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf")
+						if err != nil || found {
+							return false
+						}
+						chats, err := models.Chats(
+							qm.WithDeleted(),
+							qm.Expr(
+								qm.Or2(models.ChatWhere.ID.EQ("8482ba32-840b-4ccd-8d0f-ab5f6628bbcf")),
+								qm.Or2(models.ChatWhere.ParentID.EQ(null.StringFrom("8482ba32-840b-4ccd-8d0f-ab5f6628bbcf"))),
+							),
+						).All(context.Background(), db)
+						if err != nil || len(chats) != 3 {
+							return false
+						}
+						for _, chat := range chats {
+							if !chat.DeletedAt.Valid || !chat.DeletedAt.Time.Equal(chats[0].DeletedAt.Time) {
+								return false
+							}
+						}
+						chatUser, err := models.ChatUsers(
+							qm.WithDeleted(),
+							models.ChatUserWhere.ChatID.EQ("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3"),
+						).One(context.Background(), db)
+						return err == nil && chatUser.DeletedAt.Valid && chatUser.DeletedAt.Time.Equal(chats[0].DeletedAt.Time)
+					},
+				},
+			}
+		},
+		"FailureOnChatGroupOfOtherOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat group owned by another user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "cd54bef3-5793-4b3f-809d-8b73fad05f4c",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatGroupNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					gock.New(authServiceHost).
+						Get("/api/v1/user").
+						MatchHeader("Authorization", "valid").
+						Reply(http.StatusOK).
+						JSON(map[string]string{
+							// User A
+							"id": "9bef41ed-fb10-4791-b02e-96b372c09466",
+						})
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						found, err := models.ChatExists(context.Background(), db, "cd54bef3-5793-4b3f-809d-8b73fad05f4c")
+						return err == nil && found
+					},
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodDelete, "/chat/groups/%s", "chatGroupId"))
+	}
+}
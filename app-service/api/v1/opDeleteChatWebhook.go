@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+type DeleteChatWebhookInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	WebhookId   string `path:"webhookId" format:"uuid"`
+}
+
+type DeleteChatWebhookOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterDeleteChatWebhook(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-chat-webhook",
+				Summary:       "Delete chat webhook",
+				Description:   "Delete webhook of the chat group along with its delivery log (logged in user must be the owner)",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/webhooks/{webhookId}",
+			},
+		),
+		func(ctx context.Context, input *DeleteChatWebhookInput) (*DeleteChatWebhookOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opDeleteChatWebhook")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the webhook
+			webhook, err := ownedChatWebhook(ctx, db, input.ChatGroupId, input.WebhookId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			// 2. Delete the webhook along with its deliveries
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			if _, err := models.ChatWebhookDeliveries(
+				models.ChatWebhookDeliveryWhere.WebhookID.EQ(webhook.ID),
+			).DeleteAll(ctx, tx); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			if _, err := webhook.Delete(ctx, tx); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateWebhook, err)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type GetChatAvatarInput struct {
+	OptionalAuthorizationHeaderResolver
+	ChatId string `path:"chatId"`
+}
+
+type GetChatAvatarOutput struct {
+	ContentType           string `header:"content-type"`
+	ContentTypeOptions    string `header:"x-content-type-options"`
+	ContentSecurityPolicy string `header:"content-security-policy"`
+	Body                  []byte `doc:"binary content of the chat avatar image"`
+}
+
+func (impl *VersionedImpl) RegisterGetChatAvatar(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "get-chat-avatar",
+				Summary:     "Get chat avatar",
+				Description: "Return avatar image (binary data) of the requested chat record (group/channel), avatars of private chat groups are available to their owners and members only",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "public"},
+				Path: "/chat/{chatId}/avatar",
+			},
+		),
+		func(ctx context.Context, input *GetChatAvatarInput) (*GetChatAvatarOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opGetChatAvatar")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Avatars are public for chat records of public groups, others are available to the owner and
+			// members (deleted chats have none)
+			chat, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatId),
+				qm.Load(models.ChatRels.Parent),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound, err)
+			}
+			isPublic := !chat.IsPrivate.Bool && !chat.DMKey.Valid
+			if chat.ParentID.Valid {
+				isPublic = isPublic && chat.R.Parent != nil && !chat.R.Parent.IsPrivate.Bool
+			}
+			if !isPublic {
+				isAvailable := false
+				if input.UserId != "" {
+					if isAvailable, err = isChatOfUser(ctx, db, input.UserId, chat.ID); err != nil {
+						return nil, err
+					}
+				}
+				if !isAvailable {
+					return nil, ErrorMap.GetErrorResponse(
+						Err404_ChatRecordNotFound,
+						errors.New("chat record is private"),
+					)
+				}
+			}
+			// 2. Retrieve the avatar of the chat record
+			chatAvatar, err := models.FindChatAvatar(ctx, db, chat.ID)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatHasNoAvatar, err)
+			}
+			// 3. Decode image data
+			var imageData libAPI.ImageData
+			if err := json.Unmarshal(chatAvatar.Image, &imageData); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 4. Return response with binary image data and appropriate content type header
+			response := &GetChatAvatarOutput{
+				ContentType:           imageData.ContentType,
+				ContentTypeOptions:    "nosniff",
+				ContentSecurityPolicy: libAPI.IMAGE_CONTENT_SECURITY_POLICY,
+				Body:                  imageData.BinaryContent,
+			}
+			return response, nil
+		},
+	)
+}
@@ -0,0 +1,225 @@
+package v1_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (tc *SerialTestCases) TestGetChatAvatar(t *testing.T) {
+	// 1. Import users, chats and memberships from CSV files, set avatars of chat records
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opGetChatAvatar")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	avatarContent := []byte("GIF89a")
+	image, err := json.Marshal(libAPI.ImageData{
+		ContentType:   "image/gif",
+		BinaryContent: avatarContent,
+	})
+	if err != nil {
+		t.Fatalf("unable to encode avatar: %s", err)
+	}
+	for _, chatId := range []string{
+		// PubGr1
+		"8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+		// PrGr2 and its channels
+		"5fc26811-33e6-4559-91c3-9b95cf61d3ab",
+		"bf9bd519-4f5b-446b-b1e9-b45b5c6d2403",
+		"8a2bc140-6622-4a26-b047-b3bb735bf34a",
+	} {
+		chatAvatar := models.ChatAvatar{
+			ChatID: chatId,
+			Image:  image,
+		}
+		if err := chatAvatar.Insert(context.Background(), db, boil.Infer()); err != nil {
+			t.Fatalf("unable to insert chat avatar: %s", err)
+		}
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	avatarMatch := func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+		return res.Header().Get("Content-Type") == "image/gif" && bytes.Equal(res.Body.Bytes(), avatarContent)
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessPublicAnonymous": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success on avatar of public chat group requested without authorization",
+				Request: libAPI.TCRequest{
+					Params: map[string]any{
+						"chatId": "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					avatarMatch,
+				},
+			}
+		},
+		"SuccessPrivateGroupMember": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success on avatar of private chat group requested by member of its chat channel",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "5fc26811-33e6-4559-91c3-9b95cf61d3ab",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					avatarMatch,
+				},
+			}
+		},
+		"SuccessPrivateChannelMember": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success on avatar of private chat channel requested by its member",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "bf9bd519-4f5b-446b-b1e9-b45b5c6d2403",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					avatarMatch,
+				},
+			}
+		},
+		"SuccessPrivateGroupOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success on avatar of private chat channel requested by owner of the chat group",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User C
+					mockUser("c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					avatarMatch,
+				},
+			}
+		},
+		"FailurePrivateAnonymous": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on avatar of private chat group requested without authorization",
+				Request: libAPI.TCRequest{
+					Params: map[string]any{
+						"chatId": "5fc26811-33e6-4559-91c3-9b95cf61d3ab",
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatRecordNotFound.Ptr(),
+				},
+			}
+		},
+		"FailurePrivateChannelNonMember": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on avatar of private chat channel requested by member of another channel of the group",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatRecordNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/%s/avatar", "chatId"))
+	}
+}
@@ -5,13 +5,16 @@ import (
 	"database/sql"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/ably/ably-go/ably"
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	"github.com/quible-io/quible-api/app-service/services/botService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type GetChatTokenInput struct {
@@ -43,12 +46,15 @@ func (impl *VersionedImpl) RegisterGetChatToken(api huma.API, vc libAPI.VersionC
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opGetChatToken")
 			db := deps.Get("db").(*sql.DB)
+			// zero time (the window is closed) unless resolved at startup
+			legacyCutoff, _ := deps.Get("legacyResourceCutoff").(time.Time)
 			// 1. Compute map of capabilities
 			capabilities := map[string][]string{}
 			// 1a. Process implied capabilities from self-owned chat groups
 			chatGroups, err := models.Chats(
 				models.ChatWhere.ParentID.IsNull(),
 				models.ChatWhere.OwnerID.EQ(null.StringFrom(input.UserId)),
+				qm.Load(models.ChatRels.ParentChats),
 			).All(ctx, db)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
@@ -59,6 +65,13 @@ func (impl *VersionedImpl) RegisterGetChatToken(api huma.API, vc libAPI.VersionC
 			for _, chatGroup := range chatGroups {
 				resource := chatGroup.Resource + ":*"
 				capabilities[resource] = AccessReadWrite
+				// compatibility window: name-based namespaces are granted per channel (not as a wildcard)
+				// since they may be shared by chat groups of different owners
+				for _, chatChannel := range chatGroup.R.ParentChats {
+					if resource, ok := legacyResource(chatGroup, chatChannel, legacyCutoff); ok {
+						capabilities[resource] = AccessReadWrite
+					}
+				}
 			}
 			// 1b. Process joined channels
 			chatUsers, err := models.ChatUsers(
@@ -74,7 +87,7 @@ func (impl *VersionedImpl) RegisterGetChatToken(api huma.API, vc libAPI.VersionC
 			for _, item := range chatUsers {
 				chatId := item.ChatID
 				access := AccessReadWrite
-				if item.IsRo {
+				if item.IsRo || isMuted(item) {
 					access = AccessReadOnly
 				}
 				chat, err := models.FindChat(ctx, db, chatId)
@@ -84,6 +97,21 @@ func (impl *VersionedImpl) RegisterGetChatToken(api huma.API, vc libAPI.VersionC
 						err,
 					)
 				}
+				// direct messages have their own namespace, they become read-only for participants blocked by others
+				if chat.DMKey.Valid {
+					isBlocked, err := isBlockedInDirectMessage(ctx, db, chat, input.UserId)
+					if err != nil {
+						return nil, ErrorMap.GetErrorResponse(
+							Err500_UnknownError,
+							err,
+						)
+					}
+					if isBlocked {
+						access = AccessReadOnly
+					}
+					capabilities[chat.Resource] = access
+					continue
+				}
 				parentChatGroup, err := chat.Parent().One(ctx, db)
 				if err != nil {
 					return nil, ErrorMap.GetErrorResponse(
@@ -91,11 +119,48 @@ func (impl *VersionedImpl) RegisterGetChatToken(api huma.API, vc libAPI.VersionC
 						err,
 					)
 				}
-				resource := parentChatGroup.Resource + ":" + chat.Resource
-				if accessFound, ok := capabilities[resource]; ok && len(accessFound) > len(access) {
-					access = accessFound
+				// archived channels (e.g. rooms of finished games) are read-only for everyone
+				if chat.ArchivedAt.Valid {
+					access = AccessReadOnly
+				}
+				resources := []string{parentChatGroup.Resource + ":" + chat.Resource}
+				if resource, ok := legacyResource(parentChatGroup, chat, legacyCutoff); ok {
+					resources = append(resources, resource)
+				}
+				for _, resource := range resources {
+					if accessFound, ok := capabilities[resource]; ok && len(accessFound) > len(access) && !chat.ArchivedAt.Valid {
+						capabilities[resource] = accessFound
+						continue
+					}
+					capabilities[resource] = access
+				}
+			}
+			// 1c. Process chat groups the bot was added to
+			botChatGroups, err := models.Chats(
+				qm.InnerJoin(models.TableNames.ChatBots+" cb on cb.chat_id = "+models.TableNames.Chats+".id"),
+				qm.Where("cb.bot_id = ?", input.UserId),
+				qm.Load(models.ChatRels.ParentChats),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			for _, chatGroup := range botChatGroups {
+				for _, chatChannel := range chatGroup.R.ParentChats {
+					access := AccessReadWrite
+					if chatChannel.ArchivedAt.Valid {
+						access = AccessReadOnly
+					}
+					capabilities[chatGroup.Resource+":"+chatChannel.Resource] = access
+				}
+			}
+			// 1d. Bots publish only with API keys having "chat:write" scope
+			if input.ApiKeyScopes != nil && !botService.HasScope(input.ApiKeyScopes, botService.ScopeChatWrite) {
+				for resource := range capabilities {
+					capabilities[resource] = AccessReadOnly
 				}
-				capabilities[resource] = access
 			}
 			// 2. Prepare and return `TokenRequest` in response
 			marshalledCapabilities, _ := json.Marshal(&capabilities)
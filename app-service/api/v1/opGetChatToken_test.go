@@ -0,0 +1,161 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ably/ably-go/ably"
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestGetChatToken(t *testing.T) {
+	// 1. Import users, chat groups sharing the same legacy namespace and memberships from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opGetChatToken")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", LegacyChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", LegacyChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	// token requests are signed locally, the key is never sent to Ably
+	t.Setenv("ENV_ABLY_KEY", "appId.keyId:secret")
+	if err := ablyService.Setup(); err != nil {
+		t.Fatalf("unable to setup Ably client: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	// the cutoff is resolved once at startup, scenarios inject it as the dependency
+	withCutoff := func(cutoff time.Time) func(t *testing.T) any {
+		return func(t *testing.T) any {
+			deps.Set("legacyResourceCutoff", cutoff)
+			gock.New(authServiceHost).
+				Get("/api/v1/user").
+				MatchHeader("Authorization", "valid").
+				Reply(http.StatusOK).
+				JSON(map[string]string{
+					// User A
+					"id": "9bef41ed-fb10-4791-b02e-96b372c09466",
+				})
+			return nil
+		}
+	}
+	capabilitiesMatch := func(expected map[string][]string) libAPI.TCExtraTest {
+		return func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+			var token ably.TokenRequest
+			if err := json.NewDecoder(res.Result().Body).Decode(&token); err != nil {
+				return false
+			}
+			capabilities := map[string][]string{}
+			if err := json.Unmarshal([]byte(token.Capability), &capabilities); err != nil {
+				return false
+			}
+			return reflect.DeepEqual(expected, capabilities)
+		}
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessWithinCompatibilityWindow": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success granting legacy namespaces per channel (the namespace is shared by another owner)",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: withCutoff(time.Now().Add(time.Hour)),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					capabilitiesMatch(map[string][]string{
+						"chat:a0cff868-3ed1-45a7-a8bd-5f74ea146e28:*":     v1.AccessReadWrite,
+						"chat:betting:general":                            v1.AccessReadWrite,
+						"chat:5e7a7aec-0f20-43fb-86eb-84e9cf840065:lobby": v1.AccessReadOnly,
+						"chat:betting:lobby":                              v1.AccessReadOnly,
+					}),
+				},
+			}
+		},
+		"SuccessAfterCompatibilityWindow": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success granting ID-based namespaces only once the window is over",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: withCutoff(time.Now().Add(-time.Hour)),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					capabilitiesMatch(map[string][]string{
+						"chat:a0cff868-3ed1-45a7-a8bd-5f74ea146e28:*":     v1.AccessReadWrite,
+						"chat:5e7a7aec-0f20-43fb-86eb-84e9cf840065:lobby": v1.AccessReadOnly,
+					}),
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/token"))
+	}
+}
+
+func (tc *SerialTestCases) TestLegacyResourceCutoff(t *testing.T) {
+	db := tc.DBStore.RetrieveDB(t.Name())
+	ctx := context.Background()
+	// by default the window is counted from the migration (applied when the test DB was set up)
+	cutoff, err := v1.LegacyResourceCutoff(ctx, db)
+	if err != nil {
+		t.Fatalf("unable to resolve the cutoff: %s", err)
+	}
+	if until := time.Until(cutoff); until <= v1.LEGACY_RESOURCE_WINDOW-time.Hour || until > v1.LEGACY_RESOURCE_WINDOW {
+		t.Errorf("cutoff %s should be LEGACY_RESOURCE_WINDOW since the migration", cutoff)
+	}
+	// the variable takes precedence
+	t.Setenv("ENV_LEGACY_RESOURCE_CUTOFF", "2024-04-01T00:00:00Z")
+	cutoff, err = v1.LegacyResourceCutoff(ctx, db)
+	if err != nil || !cutoff.Equal(time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("cutoff %s (%v) should match ENV_LEGACY_RESOURCE_CUTOFF", cutoff, err)
+	}
+	t.Setenv("ENV_LEGACY_RESOURCE_CUTOFF", "next month")
+	if _, err := v1.LegacyResourceCutoff(ctx, db); err == nil {
+		t.Errorf("invalid ENV_LEGACY_RESOURCE_CUTOFF should be reported")
+	}
+	// the window is closed when the migration is not recorded (e.g. DB bootstrapped without goose)
+	t.Setenv("ENV_LEGACY_RESOURCE_CUTOFF", "")
+	if _, err := db.ExecContext(ctx, "delete from goose_db_version where version_id = $1", v1.LEGACY_RESOURCE_MIGRATION); err != nil {
+		t.Fatalf("unable to delete the migration record: %s", err)
+	}
+	cutoff, err = v1.LegacyResourceCutoff(ctx, db)
+	if err != nil || !cutoff.IsZero() {
+		t.Errorf("cutoff %s (%v) should close the window", cutoff, err)
+	}
+}
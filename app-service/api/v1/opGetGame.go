@@ -2,16 +2,17 @@ package v1
 
 import (
 	"context"
+	"database/sql"
 	"errors"
-	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	libAPI "github.com/quible-io/quible-api/lib/api"
-	"github.com/quible-io/quible-api/lib/misc"
+	"github.com/rs/zerolog/log"
 )
 
 type GetGameInput struct {
@@ -23,211 +24,52 @@ type GetGameOutput struct {
 	Body GameDetails
 }
 
-func ApplyMapper[F any, T any](s []F, m func(F) T) []T {
-	result := make([]T, len(s))
-	for idx := range s {
-		result[idx] = m(s[idx])
-	}
-	return result
-}
-
-func getTeamStats(gameId uint) (*GameTeamsStats, error) {
-	url := fmt.Sprintf(
-		"https://%s/api/basketball/match/%d/statistics",
-		BasketAPI.Host,
-		gameId,
-	)
-	response, err := misc.GetOne[MStat_Data]{
-		Client: *http.DefaultClient,
-		URL:    url,
-		UpdateRequest: func(req *http.Request) {
-			req.Header.Set("X-RapidAPI-Key", os.Getenv("ENV_RAPIDAPI_KEY"))
-			req.Header.Set("X-RapidAPI-Host", BasketAPI.Host)
-		},
-		ExpectedStatus: http.StatusOK,
-	}.Do()
+func getTeamStats(ctx context.Context, gameId uint) (*libBasketAPI.GameTeamsStats, error) {
+	response, err := BasketAPI.GetClient().Statistics(ctx, gameId)
 	if err != nil {
 		return nil, err
 	}
-	var statGroups []MStat_Group
-	for idx := range response.Statistics {
-		if response.Statistics[idx].Period == "ALL" {
-			statGroups = response.Statistics[idx].Groups
-			break
-		}
-	}
-	if len(statGroups) == 0 {
-		return nil, errors.New("statistics groups not found")
-	}
-	var statItems []MStat_GroupItem
-	var result GameTeamsStats
-	for idx := range statGroups {
-		isGroupOthers := statGroups[idx].GroupName == MStat_GroupName_Other
-		isGroupScoring := statGroups[idx].GroupName == MStat_GroupName_Scoring
-		if isGroupOthers || isGroupScoring {
-			statItems = statGroups[idx].StatisticsItems
-			if len(statItems) == 0 {
-				return nil, errors.New("statistics group items not found")
-			}
-			for _, item := range statItems {
-				switch item.Name {
-				case MStat_GroupItemName_ScoringFieldGoals:
-					{
-						if item.HomeTotal != nil {
-							result.HomeTeam.FieldGoalAttempts = *item.HomeTotal
-						}
-						if item.AwayTotal != nil {
-							result.AwayTeam.FieldGoalAttempts = *item.AwayTotal
-						}
-						result.HomeTeam.FieldGoalsMade = item.HomeValue
-						result.AwayTeam.FieldGoalsMade = item.AwayValue
-					}
-				case MStat_GroupItemName_ScoringFreeThrows:
-					{
-						if item.HomeTotal != nil {
-							result.HomeTeam.FreeThrowAttempts = *item.HomeTotal
-						}
-						if item.AwayTotal != nil {
-							result.AwayTeam.FreeThrowAttempts = *item.AwayTotal
-						}
-						result.HomeTeam.FreeThrowsMade = item.HomeValue
-						result.AwayTeam.FreeThrowsMade = item.AwayValue
-					}
-				case MStat_GroupItemName_ScoringThreePoints:
-					{
-						if item.HomeTotal != nil {
-							result.HomeTeam.ThreePointAttempts = *item.HomeTotal
-						}
-						if item.AwayTotal != nil {
-							result.AwayTeam.ThreePointAttempts = *item.AwayTotal
-						}
-						result.HomeTeam.ThreePointsMade = item.HomeValue
-						result.AwayTeam.ThreePointsMade = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherAssists:
-					{
-						result.HomeTeam.Assists = item.HomeValue
-						result.AwayTeam.Assists = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherBlocks:
-					{
-						result.HomeTeam.Blocks = item.HomeValue
-						result.AwayTeam.Blocks = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherFouls:
-					{
-						result.HomeTeam.Fouls = item.HomeValue
-						result.AwayTeam.Fouls = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherRebounds:
-					{
-						result.HomeTeam.Rebounds = item.HomeValue
-						result.AwayTeam.Rebounds = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherSteals:
-					{
-						result.HomeTeam.Steals = item.HomeValue
-						result.AwayTeam.Steals = item.AwayValue
-					}
-				case MStat_GroupItemName_OtherTurnovers:
-					{
-						result.HomeTeam.Turnovers = item.HomeValue
-						result.AwayTeam.Turnovers = item.AwayValue
-					}
-				}
-			}
-		}
-	}
-	return &result, nil
+	return libBasketAPI.ParseTeamStats(response)
 }
 
-func getPlayersStats(gameId uint) (*GamePlayers, error) {
-	url := fmt.Sprintf(
-		"https://%s/api/basketball/match/%d/lineups",
-		BasketAPI.Host,
-		gameId,
-	)
-	response, err := misc.GetOne[ML_Data]{
-		Client: *http.DefaultClient,
-		URL:    url,
-		UpdateRequest: func(req *http.Request) {
-			req.Header.Set("X-RapidAPI-Key", os.Getenv("ENV_RAPIDAPI_KEY"))
-			req.Header.Set("X-RapidAPI-Host", BasketAPI.Host)
-		},
-		ExpectedStatus: http.StatusOK,
-	}.Do()
+func getPlayersStats(ctx context.Context, gameId uint) (*libBasketAPI.GamePlayers, error) {
+	response, err := BasketAPI.GetClient().Lineups(ctx, gameId)
 	if err != nil {
 		return nil, err
 	}
-	mapper := func(playerElement ML_PlayerElement) PlayerEntity {
-		return PlayerEntity{
-			ID:   playerElement.Player.ID,
-			Name: playerElement.Player.Name,
-			Stats: PlayerStats{
-				MinutesPlayed:      float64(playerElement.Statistics.SecondsPlayed) / 60.0,
-				SecondsPlayed:      playerElement.Statistics.SecondsPlayed,
-				FieldGoalsMade:     playerElement.Statistics.FieldGoalsMade,
-				FieldGoalAttempts:  playerElement.Statistics.FieldGoalAttempts,
-				ThreePointsMade:    playerElement.Statistics.ThreePointsMade,
-				ThreePointAttempts: playerElement.Statistics.FreeThrowAttempts,
-				FreeThrowsMade:     playerElement.Statistics.FreeThrowsMade,
-				FreeThrowAttempts:  playerElement.Statistics.FreeThrowAttempts,
-				OffensiveRebounds:  playerElement.Statistics.OffensiveRebounds,
-				DefensiveRebounds:  playerElement.Statistics.DefensiveRebounds,
-				Rebounds:           playerElement.Statistics.Rebounds,
-				Assists:            playerElement.Statistics.Assists,
-				Steals:             playerElement.Statistics.Steals,
-				Blocks:             playerElement.Statistics.Blocks,
-				Turnovers:          playerElement.Statistics.Turnovers,
-				PersonalFouls:      playerElement.Statistics.PersonalFouls,
-				Points:             playerElement.Statistics.Points,
-			},
-		}
-	}
-	return &GamePlayers{
-		HomeTeam: ApplyMapper(response.Home.Players, mapper),
-		AwayTeam: ApplyMapper(response.Away.Players, mapper),
-	}, nil
+	return libBasketAPI.ParsePlayers(response), nil
 }
 
-func getMatchDetails(gameId uint) (*MatchDetails, error) {
-	url := fmt.Sprintf(
-		"https://%s/api/basketball/match/%d",
-		BasketAPI.Host,
-		gameId,
-	)
-	response, err := misc.GetOne[MD_Data]{
-		Client: *http.DefaultClient,
-		URL:    url,
-		UpdateRequest: func(req *http.Request) {
-			req.Header.Set("X-RapidAPI-Key", os.Getenv("ENV_RAPIDAPI_KEY"))
-			req.Header.Set("X-RapidAPI-Host", BasketAPI.Host)
-		},
-		ExpectedStatus: http.StatusOK,
-	}.Do()
+func getMatchDetails(ctx context.Context, gameId uint) (*MatchDetails, error) {
+	response, err := BasketAPI.GetClient().Match(ctx, gameId)
 	if err != nil {
 		return nil, err
 	}
-	// enhance response
-	ev := response.Event
-	GameStatus := ev.Status.Description
-	if ev.Status.Type == BasketAPI.StatusType_Inprogress && ev.Time.Played != nil {
-		totalSeconds := *ev.Time.Played - *ev.Time.PeriodLength**ev.Time.TotalPeriodCount
-		if totalSeconds <= 0 {
-			totalSeconds = *ev.Time.Played % *ev.Time.PeriodLength
-		}
-		minutes := totalSeconds / 60
-		seconds := totalSeconds % 60
-		GameStatus = fmt.Sprintf("%s (%d:%02d)", GameStatus, minutes, seconds)
-	}
+	return matchDetailsFromEvent(response.Event), nil
+}
+
+func matchDetailsFromEvent(ev libBasketAPI.Event) *MatchDetails {
 	return &MatchDetails{
 		ID:         ev.ID,
-		GameStatus: GameStatus,
+		GameStatus: gameStatus(ev),
 		Date:       time.Unix(ev.StartTimestamp, 0).Format(time.RFC3339),
 		AwayScore:  ev.AwayScore.Current,
 		HomeScore:  ev.HomeScore.Current,
 		Event:      &ev,
-	}, nil
+	}
+}
+
+// getStoredGame retrieves the game from DB if its final box score is stored
+func getStoredGame(ctx context.Context, db *sql.DB, gameId uint) *libBasketAPI.StoredGame {
+	storedGame, err := libBasketAPI.LoadGame(ctx, db, gameId)
+	if err != nil {
+		log.Error().Err(err).Send()
+		return nil
+	}
+	if storedGame == nil || !storedGame.IsFinal() {
+		return nil
+	}
+	return storedGame
 }
 
 func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig) {
@@ -249,6 +91,9 @@ func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig
 			},
 		),
 		func(ctx context.Context, input *GetGameInput) (*GetGameOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opGetGame")
+			db := deps.Get("db").(*sql.DB)
 			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
@@ -257,7 +102,20 @@ func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig
 					err,
 				)
 			}
-			matchDetails, err := getMatchDetails(input.GameId)
+			// finished games are served from DB once their final box score is stored
+			if storedGame := getStoredGame(ctx, db, input.GameId); storedGame != nil {
+				return &GetGameOutput{
+					Body: gameDetails(
+						ctx,
+						db,
+						teamEnhancer,
+						matchDetailsFromEvent(storedGame.Event),
+						storedGame.Players,
+						storedGame.Teams,
+					),
+				}, nil
+			}
+			matchDetails, err := getMatchDetails(ctx, input.GameId)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err424_BasketAPIGetGame,
@@ -265,7 +123,7 @@ func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig
 					err,
 				)
 			}
-			playersStats, err := getPlayersStats(input.GameId)
+			playersStats, err := getPlayersStats(ctx, input.GameId)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err424_BasketAPIGetGame,
@@ -273,7 +131,7 @@ func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig
 					err,
 				)
 			}
-			teamsStats, err := getTeamStats(input.GameId)
+			teamsStats, err := getTeamStats(ctx, input.GameId)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err424_BasketAPIGetGame,
@@ -281,24 +139,104 @@ func (impl *VersionedImpl) RegisterGetGame(api huma.API, vc libAPI.VersionConfig
 					err,
 				)
 			}
-			result := GameDetails{
-				MatchDetails: *matchDetails,
-				HomeTeam: TeamInfoExtended{
-					TeamInfo: teamEnhancer(matchDetails.Event.HomeTeam),
-					Players:  playersStats.HomeTeam,
-				},
-				AwayTeam: TeamInfoExtended{
-					TeamInfo: teamEnhancer(matchDetails.Event.AwayTeam),
-					Players:  playersStats.AwayTeam,
-				},
-			}
-			if teamsStats != nil {
-				result.HomeTeam.Stats = &teamsStats.HomeTeam
-				result.AwayTeam.Stats = &teamsStats.AwayTeam
+			if matchDetails.Event.Status.Type == libBasketAPI.StatusType_Finished {
+				// responses are cached by the client, so the game is stored without extra requests
+				if _, err := BasketAPI.GetClient().SyncGame(ctx, db, input.GameId); err != nil {
+					log.Error().Err(err).Msg("unable to store finished game")
+				}
 			}
+			result := gameDetails(ctx, db, teamEnhancer, matchDetails, playersStats, teamsStats)
 			return &GetGameOutput{
 				Body: result,
 			}, nil
 		},
 	)
 }
+
+// gameDetails combines game details with its box score, the chat room of the game is provisioned if needed
+func gameDetails(
+	ctx context.Context,
+	db *sql.DB,
+	teamEnhancer func(libBasketAPI.TeamId) BasketAPI.TeamInfo,
+	matchDetails *MatchDetails,
+	playersStats *libBasketAPI.GamePlayers,
+	teamsStats *libBasketAPI.GameTeamsStats,
+) GameDetails {
+	result := GameDetails{
+		MatchDetails: *matchDetails,
+		HomeTeam: TeamInfoExtended{
+			TeamInfo: teamEnhancer(matchDetails.Event.HomeTeam),
+			Players:  playersStats.HomeTeam,
+		},
+		AwayTeam: TeamInfoExtended{
+			TeamInfo: teamEnhancer(matchDetails.Event.AwayTeam),
+			Players:  playersStats.AwayTeam,
+		},
+	}
+	if teamsStats != nil {
+		result.HomeTeam.Stats = &teamsStats.HomeTeam
+		result.AwayTeam.Stats = &teamsStats.AwayTeam
+	}
+	// -- headshots (and position/jersey number missing in stored box scores) of players
+	withPlayerProfiles(ctx, db, result.HomeTeam.Players, result.AwayTeam.Players)
+	// -- chat room of the game of enabled leagues (provisioned on demand if not yet created by the scheduler)
+	leagues, err := libBasketAPI.EnabledLeagues(ctx, db)
+	if err != nil {
+		log.Error().Err(err).Send()
+	}
+	if league := libBasketAPI.LeagueOf(leagues, *matchDetails.Event); league != nil {
+		chatChannel, err := chatService.EnsureGameRoom(
+			ctx,
+			db,
+			league,
+			matchDetails.ID,
+			BasketAPI.GameRoomTitle(teamEnhancer, *matchDetails.Event),
+		)
+		if err != nil {
+			log.Error().Err(err).Send()
+		} else {
+			result.ChatChannelID = chatChannel.ID
+		}
+	}
+	return result
+}
+
+// withPlayerProfiles completes player entities with stored profiles of the players
+func withPlayerProfiles(ctx context.Context, db *sql.DB, teams ...[]libBasketAPI.PlayerEntity) {
+	playerIds := []uint{}
+	for _, players := range teams {
+		for _, player := range players {
+			playerIds = append(playerIds, player.ID)
+		}
+	}
+	if len(playerIds) == 0 {
+		return
+	}
+	profiles, err := libBasketAPI.FindPlayers(ctx, db, 0, playerIds...)
+	if err != nil {
+		log.Error().Err(err).Send()
+		return
+	}
+	profileById := make(map[uint]libBasketAPI.PlayerProfile, len(profiles))
+	for _, profile := range profiles {
+		profileById[profile.ID] = profile
+	}
+	for _, players := range teams {
+		for idx := range players {
+			profile, ok := profileById[players[idx].ID]
+			if !ok {
+				continue
+			}
+			players[idx].Headshot = profile.Headshot
+			if players[idx].Position == "" {
+				players[idx].Position = profile.Position
+			}
+			if players[idx].JerseyNumber == "" {
+				players[idx].JerseyNumber = profile.JerseyNumber
+			}
+			if players[idx].Slug == "" {
+				players[idx].Slug = profile.Slug
+			}
+		}
+	}
+}
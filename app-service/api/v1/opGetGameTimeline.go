@@ -0,0 +1,57 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+)
+
+type GetGameTimelineInput struct {
+	AuthorizationHeaderResolver
+	GameId uint `path:"gameId"`
+}
+
+type GetGameTimelineOutput struct {
+	Body []libBasketAPI.TimelineEvent
+}
+
+func (impl *VersionedImpl) RegisterGetGameTimeline(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "get-game-timeline",
+				Summary:     "Get game timeline",
+				Description: "Return play-by-play of the game (made shots, fouls, timeouts, period ends and substitutions) ordered by game time",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusBadRequest,
+					http.StatusFailedDependency,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/game/{gameId}/timeline",
+			},
+		),
+		func(ctx context.Context, input *GetGameTimelineInput) (*GetGameTimelineOutput, error) {
+			// 1. Send request to MatchIncidents API
+			response, err := BasketAPI.GetClient().Incidents(ctx, input.GameId)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err424_BasketAPIGetGame,
+					errors.New("unable to retrieve match incidents"),
+					err,
+				)
+			}
+			// 2. Normalize incidents into timeline events
+			return &GetGameTimelineOutput{
+				Body: libBasketAPI.ParseTimeline(response),
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+)
+
+type GetLiveStatusInput struct {
+	AuthorizationHeaderResolver
+}
+
+type GetLiveStatusOutput struct {
+	Body BasketAPI.LiveStatus
+}
+
+func (impl *VersionedImpl) RegisterGetLiveStatus(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "get-live-status",
+				Summary:       "Get live status",
+				Description:   "Report the mode of the live data poller reported by the leader along with its next wake-up time",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"live", "protected"},
+				Path: "/live/status",
+			},
+		),
+		func(ctx context.Context, input *GetLiveStatusInput) (*GetLiveStatusOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opGetLiveStatus")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve the status persisted by the leader
+			status, err := BasketAPI.GetLiveStatus(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			return &GetLiveStatusOutput{
+				Body: status,
+			}, nil
+		},
+	)
+}
@@ -7,11 +7,13 @@ import (
 
 	"github.com/ably/ably-go/ably"
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
 	"github.com/quible-io/quible-api/app-service/services/ablyService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 )
 
 type GetLiveTokenInput struct {
+	GameIDs []uint `query:"gameId" maxItems:"20" doc:"Comma-separated IDs of games to restrict the token to their channels (live:game:{id})"`
 }
 
 type GetLiveTokenOutput struct {
@@ -25,7 +27,7 @@ func (impl *VersionedImpl) RegisterGetLiveToken(api huma.API, vc libAPI.VersionC
 			huma.Operation{
 				OperationID:   "get-live-token",
 				Summary:       "Get live token",
-				Description:   "Generate and return Ably `TokenRequest` bound to `live:main` channel",
+				Description:   "Generate and return Ably `TokenRequest` bound to `live:main` and `live:game:*` channels (or to channels of requested games only)",
 				Method:        http.MethodGet,
 				DefaultStatus: http.StatusOK,
 				Errors:        []int{},
@@ -34,9 +36,17 @@ func (impl *VersionedImpl) RegisterGetLiveToken(api huma.API, vc libAPI.VersionC
 			},
 		),
 		func(ctx context.Context, input *GetLiveTokenInput) (*GetLiveTokenOutput, error) {
-			capabilities, _ := json.Marshal(&map[string][]string{
-				"live:main": {"subscribe", "history"},
-			})
+			channels := map[string][]string{
+				BasketAPI.LIVE_MAIN_CHANNEL: {"subscribe", "history"},
+				"live:game:*":               {"subscribe", "history"},
+			}
+			if len(input.GameIDs) > 0 {
+				channels = map[string][]string{}
+				for _, gameId := range input.GameIDs {
+					channels[BasketAPI.LiveGameChannel(gameId)] = []string{"subscribe", "history"}
+				}
+			}
+			capabilities, _ := json.Marshal(&channels)
 			token, err := ablyService.CreateTokenRequest(&ably.TokenParams{
 				Capability: string(capabilities),
 				ClientID:   "nobody",
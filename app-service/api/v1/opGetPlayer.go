@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+)
+
+// PLAYER_GAMES_LIMIT is the number of recent games reported in the player profile
+const PLAYER_GAMES_LIMIT = 10
+
+type GetPlayerInput struct {
+	AuthorizationHeaderResolver
+	PlayerId uint `path:"playerId"`
+}
+
+type GetPlayerOutput struct {
+	Body PlayerDetails
+}
+
+func (impl *VersionedImpl) RegisterGetPlayer(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "get-player",
+				Summary:     "Get player",
+				Description: "Return player profile along with season averages and recent games",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusBadRequest,
+					http.StatusNotFound,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/players/{playerId}",
+			},
+		),
+		func(ctx context.Context, input *GetPlayerInput) (*GetPlayerOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opGetPlayer")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve the player
+			profiles, err := libBasketAPI.FindPlayers(ctx, db, 0, input.PlayerId)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if len(profiles) == 0 {
+				return nil, ErrorMap.GetErrorResponse(Err404_PlayerNotFound)
+			}
+			// 2. Aggregate season averages and retrieve recent games
+			averages, err := libBasketAPI.SeasonAverages(ctx, db, []uint{input.PlayerId})
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			playerGames, err := libBasketAPI.PlayerGames(ctx, db, input.PlayerId, PLAYER_GAMES_LIMIT)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Initialize team data enhancer (inject logo, arena, colors, etc)
+			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					errors.New("unable to initialize team entity enhancer"),
+					err,
+				)
+			}
+			result := PlayerDetails{
+				Player:      playerFromProfile(profiles[0], teamEnhancer, averages),
+				RecentGames: make([]PlayerGameLog, 0, len(playerGames)),
+			}
+			for _, playerGame := range playerGames {
+				ev := playerGame.Event
+				gameLog := PlayerGameLog{
+					GameID:        ev.ID,
+					Date:          time.Unix(ev.StartTimestamp, 0).Format(time.RFC3339),
+					IsHome:        playerGame.IsHome,
+					Opponent:      teamEnhancer(ev.HomeTeam),
+					TeamScore:     ev.AwayScore.Current,
+					OpponentScore: ev.HomeScore.Current,
+					Stats:         playerGame.Stats,
+				}
+				if playerGame.IsHome {
+					gameLog.Opponent = teamEnhancer(ev.AwayTeam)
+					gameLog.TeamScore, gameLog.OpponentScore = ev.HomeScore.Current, ev.AwayScore.Current
+				}
+				result.RecentGames = append(result.RecentGames, gameLog)
+			}
+			return &GetPlayerOutput{
+				Body: result,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,123 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+const (
+	// TEAM_GAMES_LIMIT is the number of recent and upcoming games reported on the team page
+	TEAM_GAMES_LIMIT = 5
+	// TEAM_GAMES_WINDOW limits the search of recent and upcoming games
+	TEAM_GAMES_WINDOW = 60 * 24 * time.Hour
+)
+
+type GetTeamInput struct {
+	AuthorizationHeaderResolver
+	TeamId uint `path:"teamId"`
+}
+
+type GetTeamOutput struct {
+	Body TeamDetails
+}
+
+func (impl *VersionedImpl) RegisterGetTeam(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "get-team",
+				Summary:     "Get team",
+				Description: "Return team info along with its standing, recent results and upcoming games",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusBadRequest,
+					http.StatusNotFound,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/teams/{teamId}",
+			},
+		),
+		func(ctx context.Context, input *GetTeamInput) (*GetTeamOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opGetTeam")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Make sure the team is known, the team belongs to the league it's crawled for
+			teamInfo, err := models.TeamInfos(
+				models.TeamInfoWhere.ID.EQ(int(input.TeamId)),
+				qm.Load(models.TeamInfoRels.League),
+			).One(ctx, db)
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrorMap.GetErrorResponse(Err404_TeamNotFound, err)
+			}
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			league := teamInfo.R.League
+			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					errors.New("unable to initialize team entity enhancer"),
+					err,
+				)
+			}
+			result := TeamDetails{
+				TeamInfo: teamEnhancer(libBasketAPI.TeamId{ID: input.TeamId}),
+			}
+			// 2. Standing of the team in the current season (if already stored)
+			standing, err := models.Standings(
+				models.StandingWhere.TournamentID.EQ(league.TournamentID),
+				models.StandingWhere.SeasonID.EQ(league.SeasonID),
+				models.StandingWhere.TeamID.EQ(int(input.TeamId)),
+			).One(ctx, db)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if standing != nil {
+				teamStanding := standingFromModel(standing)
+				result.Standing = &teamStanding
+			}
+			// 3. Recent results (the latest first) and upcoming games
+			now := time.Now()
+			recentEvents, err := libBasketAPI.FindGames(ctx, db, libBasketAPI.GameFilter{
+				TournamentID: uint(league.TournamentID),
+				From:         now.Add(-TEAM_GAMES_WINDOW),
+				To:           now,
+				TeamID:       input.TeamId,
+				Status:       libBasketAPI.StatusType_Finished,
+			})
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			slices.Reverse(recentEvents)
+			upcomingEvents, err := libBasketAPI.FindGames(ctx, db, libBasketAPI.GameFilter{
+				TournamentID: uint(league.TournamentID),
+				From:         now,
+				To:           now.Add(TEAM_GAMES_WINDOW),
+				TeamID:       input.TeamId,
+				Status:       libBasketAPI.StatusType_Notstarted,
+			})
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			result.RecentGames = gamesFromEvents(recentEvents[:min(len(recentEvents), TEAM_GAMES_LIMIT)], teamEnhancer)
+			result.UpcomingGames = gamesFromEvents(upcomingEvents[:min(len(upcomingEvents), TEAM_GAMES_LIMIT)], teamEnhancer)
+			return &GetTeamOutput{
+				Body: result,
+			}, nil
+		},
+	)
+}
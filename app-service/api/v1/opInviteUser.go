@@ -1,19 +1,13 @@
 package v1
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
 	"net/http"
-	"os"
 
 	"github.com/danielgtaylor/huma/v2"
-	"github.com/quible-io/quible-api/app-service/services/emailService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
-	"github.com/quible-io/quible-api/lib/email"
-	"github.com/quible-io/quible-api/lib/jwt"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -38,7 +32,7 @@ func (impl *VersionedImpl) RegisterInviteUser(api huma.API, vc libAPI.VersionCon
 			huma.Operation{
 				OperationID:   "invite-user",
 				Summary:       "Invite user",
-				Description:   "Invite a user to join private channel",
+				Description:   "Invite a user to join private channel. Invitee without an account is invited to sign up first",
 				Method:        http.MethodPost,
 				DefaultStatus: http.StatusOK,
 				Errors: []int{
@@ -54,42 +48,48 @@ func (impl *VersionedImpl) RegisterInviteUser(api huma.API, vc libAPI.VersionCon
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opInviteUser")
 			db := deps.Get("db").(*sql.DB)
-			// 1. test if request chat channel exists
-			chatChannel, err := models.Chats(
-				models.ChatWhere.ID.EQ(input.ChatChannelId),
-				models.ChatWhere.ParentID.IsNotNull(),
-				qm.Load(
-					models.ChatRels.Parent,
-				),
-			).One(ctx, db)
+			// 1. test if request chat channel exists and belongs to a private chat group which is owned by the invitor
+			chatChannel, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId)
 			if err != nil {
-				return nil, ErrorMap.GetErrorResponse(
-					Err404_ChatChannelNotFound,
-					err,
-				)
-			}
-			// 1a. ...and it belongs to a private chat group which is owned by the invitor
-			chatGroup := chatChannel.R.Parent
-			if !chatGroup.IsPrivate.Bool || chatGroup.OwnerID != null.StringFrom(input.UserId) {
-				return nil, ErrorMap.GetErrorResponse(
-					Err404_ChatChannelNotFound,
-					errors.New("holding chat group is not private or is not owned by user"),
-				)
+				return nil, err
 			}
+			invitor, _ := models.FindUser(ctx, db, input.UserId)
 			// 2. Find invitee user by provided email
 			invitee, err := models.Users(
-				models.UserWhere.Email.EQ(input.Body.Email),
+				qm.Where("lower("+models.UserColumns.Email+") = lower(?)", input.Body.Email),
 			).One(ctx, db)
-			if err != nil || invitee == nil {
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
 				return nil, ErrorMap.GetErrorResponse(
-					Err400_ChatChannelInviteeNotUser,
+					Err500_UnknownError,
 					err,
 				)
 			}
+			// 3. Invitee without an account gets invited to sign up first
+			if invitee == nil {
+				invitation, err := models.ChatInvitations(
+					models.ChatInvitationWhere.ChatID.EQ(input.ChatChannelId),
+					models.ChatInvitationWhere.InviteeID.IsNull(),
+					qm.Where("lower("+models.ChatInvitationColumns.InviteeEmail+") = lower(?)", input.Body.Email),
+				).One(ctx, db)
+				if err != nil && !errors.Is(err, sql.ErrNoRows) {
+					return nil, ErrorMap.GetErrorResponse(
+						Err500_UnknownError,
+						err,
+					)
+				}
+				invitation, err = upsertChatInvitation(ctx, db, invitation, &models.ChatInvitation{
+					ChatID:       input.ChatChannelId,
+					InviteeEmail: null.StringFrom(input.Body.Email),
+					InvitorID:    input.UserId,
+				})
+				if err != nil {
+					return nil, err
+				}
+				return nil, sendChatSignupInvitation(ctx, deps, invitation, chatChannel, invitor)
+			}
 			if invitee.ID == input.UserId {
 				return nil, ErrorMap.GetErrorResponse(
 					Err400_ChatChannelInviteeOwnsChatGroup,
-					err,
 				)
 			}
 			// 4. Test if association between user and channel already exists
@@ -121,46 +121,29 @@ func (impl *VersionedImpl) RegisterInviteUser(api huma.API, vc libAPI.VersionCon
 					)
 				}
 			}
-			// 5. Send invitation email
-			invitor, _ := models.FindUser(ctx, db, input.UserId)
-			token, _ := jwt.GenerateToken(
-				invitor,
-				jwt.TokenActionInvitationToPrivateChat,
-				jwt.ExtraClaims{
-					"inviteeId":     invitee.ID,
-					"chatChannelId": input.ChatChannelId,
-				},
-			)
-			var html bytes.Buffer
-			emailService.InviteToPrivateChatGroup(
-				invitee.FullName,
-				chatChannel.Title,
-				chatGroup.Title,
-				fmt.Sprintf(
-					"%s/forms/accept-private-chat-invitation?token=%s",
-					os.Getenv("WEB_CLIENT_URL"),
-					token.Token,
-				),
-				&html,
-			)
-			if emailSender, ok := deps.Get("mailer").(email.EmailSender); ok {
-				if err := emailSender.SendEmail(ctx, email.EmailPayload{
-					From:     "no-reply@quible.io",
-					To:       invitee.Email,
-					Subject:  "Invitation to join private chat channel",
-					HTMLBody: html.String(),
-				}); err != nil {
-					return nil, ErrorMap.GetErrorResponse(
-						Err424_UnableToSendEmail,
-						err,
-					)
-				}
-			} else {
+			// 5. Create (or reset previously accepted/revoked) invitation record
+			invitation, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(input.ChatChannelId),
+				models.ChatInvitationWhere.InviteeID.EQ(null.StringFrom(invitee.ID)),
+			).One(ctx, db)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
 				return nil, ErrorMap.GetErrorResponse(
-					Err424_UnableToSendEmail,
-					errors.New("email client unavailable"),
+					Err500_UnknownError,
+					err,
 				)
 			}
+			invitation, err = upsertChatInvitation(ctx, db, invitation, &models.ChatInvitation{
+				ChatID:    input.ChatChannelId,
+				InviteeID: null.StringFrom(invitee.ID),
+				InvitorID: input.UserId,
+			})
+			if err != nil {
+				return nil, err
+			}
+			// 6. Send invitation email
+			if err := sendChatInvitation(ctx, deps, invitation, chatChannel, invitor, invitee); err != nil {
+				return nil, err
+			}
 			return nil, nil
 		},
 	)
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -76,7 +77,15 @@ func (impl *VersionedImpl) RegisterJoinChatChannel(api huma.API, vc libAPI.Versi
 				ChatID: input.ChatChannelId,
 				UserID: input.UserId,
 			}
-			return nil, chatUser.Insert(ctx, db, boil.Infer())
+			if err := chatUser.Insert(ctx, db, boil.Infer()); err != nil {
+				return nil, err
+			}
+			chatService.EmitWebhookEventForChat(ctx, db, chatChannel, chatService.WebhookEventMemberJoined, ChatWebhookData{
+				ChatChannelID: chatChannel.ID,
+				ChatChannel:   chatChannel.Title,
+				UserID:        input.UserId,
+			})
+			return nil, nil
 		},
 	)
 }
@@ -6,8 +6,10 @@ import (
 	"net/http"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type LeaveChatChannelInput struct {
@@ -40,6 +42,18 @@ func (impl *VersionedImpl) RegisterLeaveChatChannel(api huma.API, vc libAPI.Vers
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opLeaveChatChannel")
 			db := deps.Get("db").(*sql.DB)
+			chatChannel, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatChannelId),
+				models.ChatWhere.ParentID.IsNotNull(),
+				qm.Load(models.ChatRels.Parent),
+			).One(ctx, db)
+			if err != nil || chatChannel.R.Parent == nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatChannelNotFound,
+					err,
+				)
+			}
+			// 1. Leave the channel (owners delete channels explicitly, see opDeleteChatChannel)
 			chatUser, err := models.ChatUsers(
 				models.ChatUserWhere.ChatID.EQ(input.ChatChannelId),
 				models.ChatUserWhere.UserID.EQ(input.UserId),
@@ -50,12 +64,17 @@ func (impl *VersionedImpl) RegisterLeaveChatChannel(api huma.API, vc libAPI.Vers
 					err,
 				)
 			}
-			if _, err := chatUser.Delete(ctx, db); err != nil {
+			if _, err := chatUser.Delete(ctx, db, true); err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err500_UnknownError,
 					err,
 				)
 			}
+			chatService.EmitWebhookEventForChat(ctx, db, chatChannel, chatService.WebhookEventMemberLeft, ChatWebhookData{
+				ChatChannelID: chatChannel.ID,
+				ChatChannel:   chatChannel.Title,
+				UserID:        input.UserId,
+			})
 			return nil, nil
 		},
 	)
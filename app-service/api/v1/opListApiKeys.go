@@ -0,0 +1,67 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListApiKeysInput struct {
+	AuthorizationHeaderResolver
+	BotId string `path:"botId" format:"uuid"`
+}
+
+type ListApiKeysOutput struct {
+	Body []ApiKey
+}
+
+func (impl *VersionedImpl) RegisterListApiKeys(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-api-keys",
+				Summary:       "List API keys",
+				Description:   "List API keys (including revoked ones) of the bot owned by the logged in user, newest first",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots/{botId}/keys",
+			},
+		),
+		func(ctx context.Context, input *ListApiKeysInput) (*ListApiKeysOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListApiKeys")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve API keys of the bot
+			bot, err := ownedBot(ctx, db, input.BotId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			apiKeys, err := models.APIKeys(
+				models.APIKeyWhere.BotID.EQ(bot.UserID),
+				qm.OrderBy(models.APIKeyColumns.CreatedAt+" desc"),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 2. Prepare and return the response
+			response := make([]ApiKey, len(apiKeys))
+			for idx, apiKey := range apiKeys {
+				response[idx] = apiKeyFromModel(apiKey)
+			}
+			return &ListApiKeysOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
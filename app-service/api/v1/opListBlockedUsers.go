@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListBlockedUsersInput struct {
+	AuthorizationHeaderResolver
+}
+
+type BlockedUser struct {
+	ID        string    `json:"id"`
+	FullName  string    `json:"fullName"`
+	BlockedAt time.Time `json:"blockedAt"`
+}
+
+type ListBlockedUsersOutput struct {
+	Body []BlockedUser
+}
+
+func (impl *VersionedImpl) RegisterListBlockedUsers(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-blocked-users",
+				Summary:       "List blocked users",
+				Description:   "List users blocked by the logged in user",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/blocks",
+			},
+		),
+		func(ctx context.Context, input *ListBlockedUsersInput) (*ListBlockedUsersOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListBlockedUsers")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve blocks along with blocked users
+			userBlocks, err := models.UserBlocks(
+				models.UserBlockWhere.UserID.EQ(input.UserId),
+				qm.Load(models.UserBlockRels.BlockedUser),
+				qm.OrderBy(models.UserBlockColumns.CreatedAt+" DESC"),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 2. Prepare and return the response
+			blockedUsers := make([]BlockedUser, 0, len(userBlocks))
+			for _, userBlock := range userBlocks {
+				blockedUser := BlockedUser{
+					ID:        userBlock.BlockedUserID,
+					BlockedAt: userBlock.CreatedAt,
+				}
+				if userBlock.R.BlockedUser != nil {
+					blockedUser.FullName = userBlock.R.BlockedUser.FullName
+				}
+				blockedUsers = append(blockedUsers, blockedUser)
+			}
+			return &ListBlockedUsersOutput{
+				Body: blockedUsers,
+			}, nil
+		},
+	)
+}
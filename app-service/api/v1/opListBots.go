@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListBotsInput struct {
+	AuthorizationHeaderResolver
+}
+
+type ListBotsOutput struct {
+	Body []Bot
+}
+
+func (impl *VersionedImpl) RegisterListBots(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-bots",
+				Summary:       "List bots",
+				Description:   "List bots owned by the logged in user along with chat groups they were added to",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots",
+			},
+		),
+		func(ctx context.Context, input *ListBotsInput) (*ListBotsOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListBots")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve owned bots
+			bots, err := models.Bots(
+				models.BotWhere.OwnerID.EQ(input.UserId),
+				qm.Load(models.BotRels.User),
+				qm.Load(models.BotRels.ChatBots),
+				qm.OrderBy(models.BotColumns.CreatedAt),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 2. Prepare and return the response
+			response := make([]Bot, len(bots))
+			for idx, bot := range bots {
+				response[idx] = botFromModel(bot)
+			}
+			return &ListBotsOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
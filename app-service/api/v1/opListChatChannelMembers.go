@@ -0,0 +1,84 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListChatChannelMembersInput struct {
+	AuthorizationHeaderResolver
+	libAPI.PageParams
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+}
+
+type ListChatChannelMembersOutput struct {
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatMember
+}
+
+func (impl *VersionedImpl) RegisterListChatChannelMembers(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-channel-members",
+				Summary:       "List chat channel members",
+				Description:   "List members of the chat channel (or direct message) along with their presence (logged in user must be the owner of the chat group or a member)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/members",
+			},
+		),
+		func(ctx context.Context, input *ListChatChannelMembersInput) (*ListChatChannelMembersOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatChannelMembers")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve the chat channel (or direct message)
+			chatChannel, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatChannelId),
+				qm.Expr(
+					models.ChatWhere.ParentID.IsNotNull(),
+					qm.Or2(models.ChatWhere.DMKey.IsNotNull()),
+				),
+				qm.Load(models.ChatRels.Parent),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatChannelNotFound, err)
+			}
+			// 2. Collect members and make sure the user is one of them
+			chatMembers, err := chatChannelMembers(ctx, db, chatChannel)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if !hasChatMember(chatMembers, input.UserId) {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatChannelNotFound,
+					errors.New("user is neither owner nor member of the chat channel"),
+				)
+			}
+			// 3. Prepare and return the response
+			page, nextCursor, err := paginateChatMembers(chatMembers, input.PageParams)
+			if err != nil {
+				return nil, err
+			}
+			withPresence(ctx, page, []string{chatChannelName(chatChannel)})
+			return &ListChatChannelMembersOutput{
+				NextCursor: nextCursor,
+				Body:       page,
+			}, nil
+		},
+	)
+}
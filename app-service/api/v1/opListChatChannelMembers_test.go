@@ -0,0 +1,132 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestListChatChannelMembers(t *testing.T) {
+	// 1. Import users, chats and memberships from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opListChatChannelMembers")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	// presence is unavailable with the fake key, members are listed without it
+	t.Setenv("ENV_ABLY_KEY", "appId.keyId:secret")
+	if err := ablyService.Setup(); err != nil {
+		t.Fatalf("unable to setup Ably client: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	type member struct {
+		id       string
+		role     v1.ChatMemberRole
+		readOnly bool
+	}
+	membersMatch := func(res *httptest.ResponseRecorder, expected []member) bool {
+		var chatMembers []v1.ChatMember
+		if err := json.NewDecoder(res.Result().Body).Decode(&chatMembers); err != nil || len(chatMembers) != len(expected) {
+			return false
+		}
+		for idx, chatMember := range chatMembers {
+			if chatMember.ID != expected[idx].id || chatMember.Role != expected[idx].role || chatMember.ReadOnly != expected[idx].readOnly {
+				return false
+			}
+		}
+		return true
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessMember": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing the owner of the chat group first and members of the chat channel",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "f67b76ad-a313-4a6a-be6c-f389e20809f0",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						return membersMatch(res, []member{
+							{"c6174e8a-e12f-4d64-a4fe-a3b0c081bd31", v1.ChatMemberRoleOwner, false},
+							{"9bef41ed-fb10-4791-b02e-96b372c09466", v1.ChatMemberRoleMember, true},
+						})
+					},
+				},
+			}
+		},
+		"FailureOnOtherChatChannel": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel the user is not a member of (a member of another channel of the group)",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "0ea83a0c-02a8-4415-939b-2fe1a99bbcb5",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/channels/%s/members", "chatChannelId"))
+	}
+}
@@ -11,10 +11,13 @@ import (
 
 type ListChatChannelsInput struct {
 	AuthorizationHeaderResolver
+	ChatSortParams
+	ChatFilterParams
 }
 
 type ListChatChannelsOutput struct {
-	Body any
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatChannel
 }
 
 func (impl *VersionedImpl) RegisterListChatChannels(api huma.API, vc libAPI.VersionConfig) {
@@ -28,6 +31,7 @@ func (impl *VersionedImpl) RegisterListChatChannels(api huma.API, vc libAPI.Vers
 				Method:        http.MethodGet,
 				DefaultStatus: http.StatusOK,
 				Errors: []int{
+					http.StatusBadRequest,
 					http.StatusUnauthorized,
 				},
 				Tags: []string{"chat", "protected"},
@@ -38,12 +42,25 @@ func (impl *VersionedImpl) RegisterListChatChannels(api huma.API, vc libAPI.Vers
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opListChatChannels")
 			db := deps.Get("db").(*sql.DB)
-			chatChannels, err := chatChannelsForUser(ctx, db, input.UserId)
+			// 1. Get the page of user's chat channels matching the filters
+			page, nextCursor, err := chatChannelsForUser(
+				ctx,
+				db,
+				input.UserId,
+				readOnlyScopes(input.ApiKeyScopes),
+				input.ChatFilterParams,
+				input.ChatSortParams,
+			)
 			if err != nil {
 				return nil, err
 			}
+			// 2. Add unread counts
+			if err := withUnreadCounts(ctx, db, input.UserId, page); err != nil {
+				return nil, err
+			}
 			return &ListChatChannelsOutput{
-				Body: chatChannels,
+				NextCursor: nextCursor,
+				Body:       page,
 			}, nil
 		},
 	)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	libAPI "github.com/quible-io/quible-api/lib/api"
@@ -11,10 +12,13 @@ import (
 
 type ListChatChannelsGroupedInput struct {
 	AuthorizationHeaderResolver
+	ChatSortParams
+	ChatFilterParams
 }
 
 type ListChatChannelsGroupedOutput struct {
-	Body []ChatChannelsGroup
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatChannelsGroup
 }
 
 type ChatChannelsGroup struct {
@@ -22,6 +26,12 @@ type ChatChannelsGroup struct {
 	Title        string        `json:"title"`
 	Summary      *string       `json:"summary"`
 	ChatChannels []ChatChannel `json:"chatChannels"`
+	CreatedAt    time.Time     `json:"-"`
+	// avatar image is available at GET /chat/{chatId}/avatar
+	AvatarUpdatedAt *time.Time `json:"avatarUpdatedAt,omitempty"`
+	// relevance and matched fragments, populated only for search results
+	Rank      *float64         `json:"rank,omitempty"`
+	Highlight *SearchHighlight `json:"highlight,omitempty"`
 }
 
 func (impl *VersionedImpl) RegisterListChatChannelsGrouped(api huma.API, vc libAPI.VersionConfig) {
@@ -35,6 +45,7 @@ func (impl *VersionedImpl) RegisterListChatChannelsGrouped(api huma.API, vc libA
 				Method:        http.MethodGet,
 				DefaultStatus: http.StatusOK,
 				Errors: []int{
+					http.StatusBadRequest,
 					http.StatusUnauthorized,
 				},
 				Tags: []string{"chat", "protected"},
@@ -45,36 +56,27 @@ func (impl *VersionedImpl) RegisterListChatChannelsGrouped(api huma.API, vc libA
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opListChatChannelsGrouped")
 			db := deps.Get("db").(*sql.DB)
-			// 1. Get all user's chat channels
-			chatChannels, err := chatChannelsForUser(ctx, db, input.UserId)
+			// 1. Get the page of groups of user's chat channels matching the filters
+			page, nextCursor, err := chatChannelsGroupsForUser(
+				ctx,
+				db,
+				input.UserId,
+				readOnlyScopes(input.ApiKeyScopes),
+				input.ChatFilterParams,
+				input.ChatSortParams,
+			)
 			if err != nil {
 				return nil, err
 			}
-			// 2. Group chat channels based on `Parent` field in each record
-			chatChannelsGroupMap := map[string]*ChatChannelsGroup{}
-			for _, chatChannel := range chatChannels {
-				chatGroup := chatChannel.Parent
-				if _, ok := chatChannelsGroupMap[chatGroup.ID]; !ok {
-					chatChannelsGroupMap[chatGroup.ID] = &ChatChannelsGroup{
-						ID:           chatGroup.ID,
-						Title:        chatGroup.Title,
-						Summary:      chatGroup.Summary.Ptr(),
-						ChatChannels: []ChatChannel{},
-					}
+			// 2. Add unread counts
+			for _, chatChannelsGroup := range page {
+				if err := withUnreadCounts(ctx, db, input.UserId, chatChannelsGroup.ChatChannels); err != nil {
+					return nil, err
 				}
-				chatChannelsGroup := chatChannelsGroupMap[chatGroup.ID]
-				chatChannelsGroup.ChatChannels = append(
-					chatChannelsGroup.ChatChannels,
-					chatChannel,
-				)
-			}
-			// 3. Prepare and return the response
-			chatChannelsGroupSlice := []ChatChannelsGroup{}
-			for _, chatChannelsGroup := range chatChannelsGroupMap {
-				chatChannelsGroupSlice = append(chatChannelsGroupSlice, *chatChannelsGroup)
 			}
 			return &ListChatChannelsGroupedOutput{
-				Body: chatChannelsGroupSlice,
+				NextCursor: nextCursor,
+				Body:       page,
 			}, nil
 		},
 	)
@@ -0,0 +1,86 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListChatGroupMembersInput struct {
+	AuthorizationHeaderResolver
+	libAPI.PageParams
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+}
+
+type ListChatGroupMembersOutput struct {
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatMember
+}
+
+func (impl *VersionedImpl) RegisterListChatGroupMembers(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-group-members",
+				Summary:       "List chat group members",
+				Description:   "List members of all chat channels of the chat group along with their presence (logged in user must be the owner of the chat group or a member of one of its channels)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/members",
+			},
+		),
+		func(ctx context.Context, input *ListChatGroupMembersInput) (*ListChatGroupMembersOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatGroupMembers")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve the chat group along with its chat channels
+			chatGroup, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatGroupId),
+				models.ChatWhere.ParentID.IsNull(),
+				models.ChatWhere.DMKey.IsNull(),
+				qm.Load(models.ChatRels.ParentChats),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatGroupNotFound, err)
+			}
+			// 2. Collect members of all chat channels at once, a member is read-only if so in every channel
+			chatMembers, err := chatChannelsMembers(ctx, db, chatGroup, chatGroup.R.ParentChats)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			if !hasChatMember(chatMembers, input.UserId) {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatGroupNotFound,
+					errors.New("user is neither owner nor member of the chat group"),
+				)
+			}
+			// 3. Prepare and return the response
+			page, nextCursor, err := paginateChatMembers(chatMembers, input.PageParams)
+			if err != nil {
+				return nil, err
+			}
+			channelNames := make([]string, len(chatGroup.R.ParentChats))
+			for idx, chatChannel := range chatGroup.R.ParentChats {
+				channelNames[idx] = chatChannelName(chatChannel)
+			}
+			withPresence(ctx, page, channelNames)
+			return &ListChatGroupMembersOutput{
+				NextCursor: nextCursor,
+				Body:       page,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,217 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func (tc *SerialTestCases) TestListChatGroupMembers(t *testing.T) {
+	// 1. Import users, chats and memberships from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opListChatGroupMembers")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	// presence is unavailable with the fake key, members are listed without it
+	t.Setenv("ENV_ABLY_KEY", "appId.keyId:secret")
+	if err := ablyService.Setup(); err != nil {
+		t.Fatalf("unable to setup Ably client: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	type member struct {
+		id       string
+		role     v1.ChatMemberRole
+		readOnly bool
+	}
+	membersMatch := func(res *httptest.ResponseRecorder, expected []member) bool {
+		var chatMembers []v1.ChatMember
+		if err := json.NewDecoder(res.Result().Body).Decode(&chatMembers); err != nil || len(chatMembers) != len(expected) {
+			return false
+		}
+		for idx, chatMember := range chatMembers {
+			if chatMember.ID != expected[idx].id || chatMember.Role != expected[idx].role || chatMember.ReadOnly != expected[idx].readOnly {
+				return false
+			}
+		}
+		return true
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing the owner first and members of all chat channels, disabled ones excluded",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						return membersMatch(res, []member{
+							{"9bef41ed-fb10-4791-b02e-96b372c09466", v1.ChatMemberRoleOwner, false},
+							{"42d29b4b-935d-4f35-b26c-70080107f6d6", v1.ChatMemberRoleMember, false},
+						})
+					},
+				},
+			}
+		},
+		"SuccessReadOnlyInEveryChannel": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing members of several chat channels, a member is read-only if so in every channel",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "5fc26811-33e6-4559-91c3-9b95cf61d3ab",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User B
+					mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						return membersMatch(res, []member{
+							{"c6174e8a-e12f-4d64-a4fe-a3b0c081bd31", v1.ChatMemberRoleOwner, false},
+							{"9bef41ed-fb10-4791-b02e-96b372c09466", v1.ChatMemberRoleMember, true},
+							{"42d29b4b-935d-4f35-b26c-70080107f6d6", v1.ChatMemberRoleMember, false},
+						})
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// User A joins the other channel with read-write access
+						chatUser := models.ChatUser{
+							ChatID: "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+							UserID: "9bef41ed-fb10-4791-b02e-96b372c09466",
+						}
+						if err := chatUser.Insert(context.Background(), db, boil.Infer()); err != nil {
+							return false
+						}
+						// User B
+						mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+						res := tc.TestAPI.Get("/api/chat/groups/5fc26811-33e6-4559-91c3-9b95cf61d3ab/members", "Authorization: valid")
+						return res.Code == http.StatusOK && membersMatch(res, []member{
+							{"c6174e8a-e12f-4d64-a4fe-a3b0c081bd31", v1.ChatMemberRoleOwner, false},
+							{"9bef41ed-fb10-4791-b02e-96b372c09466", v1.ChatMemberRoleMember, false},
+							{"42d29b4b-935d-4f35-b26c-70080107f6d6", v1.ChatMemberRoleMember, false},
+						})
+					},
+				},
+			}
+		},
+		"FailureOnDisabledMember": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on user whose only membership in the chat group is disabled",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatGroupNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User C
+					mockUser("c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnChatChannel": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel passed instead of chat group",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatGroupId": "d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatGroupNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User B
+					mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/groups/%s/members", "chatGroupId"))
+	}
+}
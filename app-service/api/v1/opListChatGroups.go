@@ -3,20 +3,26 @@ package v1
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
-	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
 type ListChatGroupsInput struct {
 	AuthorizationHeaderResolver
+	ChatSortParams
+	Visibility string `query:"visibility" enum:"all,public,private" default:"all"`
 }
 
 type ListChatGroupsOutput struct {
-	Body models.ChatSlice
+	NextCursor string `header:"Next-Cursor"`
+	Body       models.ChatSlice
 }
 
 func (impl *VersionedImpl) RegisterListChatGroups(api huma.API, vc libAPI.VersionConfig) {
@@ -30,6 +36,7 @@ func (impl *VersionedImpl) RegisterListChatGroups(api huma.API, vc libAPI.Versio
 				Method:        http.MethodGet,
 				DefaultStatus: http.StatusOK,
 				Errors: []int{
+					http.StatusBadRequest,
 					http.StatusUnauthorized,
 				},
 				Tags: []string{"chat", "protected"},
@@ -40,10 +47,54 @@ func (impl *VersionedImpl) RegisterListChatGroups(api huma.API, vc libAPI.Versio
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opListChatGroups")
 			db := deps.Get("db").(*sql.DB)
-			// 1. Do business
+			// 1. Select the page of chat groups
+			keyset, orderBy, args, err := chatKeyset(input.ChatSortParams, []any{input.UserId})
+			if err != nil {
+				return nil, err
+			}
+			visibility := "true"
+			switch input.Visibility {
+			case "public":
+				visibility = "is_private = false"
+			case "private":
+				visibility = "is_private = true"
+			}
+			limit := libAPI.PageLimit(input.PageParams)
+			args = append(args, limit+1)
+			rows := []chatSortValues{}
+			if err := queries.Raw(
+				`with groups as (
+					select id, lower(title) as title, created_at, greatest(created_at, last_message_at) as active_at
+					from chats
+					where parent_id is null
+					and owner_id = $1
+					and deleted_at is null
+					and `+visibility+`
+				)
+				select id, title, created_at, active_at
+				from groups
+				where `+keyset+`
+				order by `+orderBy+`
+				limit $`+fmt.Sprint(len(args)),
+				args...,
+			).Bind(ctx, db, &rows); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			nextCursor := ""
+			if len(rows) > limit {
+				rows = rows[:limit]
+				nextCursor = libAPI.EncodeCursor(input.Sort, rows[limit-1].sortKey(strings.TrimPrefix(input.Sort, "-")))
+			}
+			// 2. Load the chat groups (in the order of the page)
+			chatGroupIds := make([]string, len(rows))
+			for idx, row := range rows {
+				chatGroupIds[idx] = row.ID
+			}
 			chatGroups, err := models.Chats(
-				models.ChatWhere.ParentID.IsNull(),
-				models.ChatWhere.OwnerID.EQ(null.StringFrom(input.UserId)),
+				models.ChatWhere.ID.IN(chatGroupIds),
 			).All(ctx, db)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
@@ -51,8 +102,18 @@ func (impl *VersionedImpl) RegisterListChatGroups(api huma.API, vc libAPI.Versio
 					err,
 				)
 			}
+			page := make(models.ChatSlice, 0, len(chatGroups))
+			for _, chatGroupId := range chatGroupIds {
+				idx := slices.IndexFunc(chatGroups, func(chatGroup *models.Chat) bool {
+					return chatGroup.ID == chatGroupId
+				})
+				if idx >= 0 {
+					page = append(page, chatGroups[idx])
+				}
+			}
 			return &ListChatGroupsOutput{
-				Body: chatGroups,
+				NextCursor: nextCursor,
+				Body:       page,
 			}, nil
 		},
 	)
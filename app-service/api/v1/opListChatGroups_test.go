@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/h2non/gock"
@@ -184,4 +186,68 @@ func (tc *TestCases) TestListChatGroups(t *testing.T) {
 	for name, scenario := range testCases {
 		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/groups"))
 	}
+	// 4. Paginated listing
+	pageTestCases := libAPI.TCScenarios{
+		"SuccessPageByPage": func(t *testing.T) libAPI.TCData {
+			mockUser := func() {
+				gock.New(authServiceHost).
+					Get("/api/v1/user").
+					MatchHeader("Authorization", "valid").
+					Reply(http.StatusOK).
+					JSON(map[string]string{
+						// User A
+						"id": "9bef41ed-fb10-4791-b02e-96b372c09466",
+					})
+			}
+			return libAPI.TCData{
+				Description: "Success listing chat groups page by page, the cursor is bound to the sort option",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					mockUser()
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						var firstPage models.ChatSlice
+						if err := json.NewDecoder(res.Result().Body).Decode(&firstPage); err != nil || len(firstPage) != 1 {
+							return false
+						}
+						cursor := res.Header().Get("Next-Cursor")
+						if cursor == "" {
+							return false
+						}
+						mockUser()
+						res = tc.TestAPI.Get("/api/chat/groups?sort=-created&limit=1&after="+cursor, "Authorization: valid")
+						var secondPage models.ChatSlice
+						if err := json.NewDecoder(res.Result().Body).Decode(&secondPage); err != nil || len(secondPage) != 1 {
+							return false
+						}
+						if secondPage[0].ID == firstPage[0].ID || res.Header().Get("Next-Cursor") != "" {
+							return false
+						}
+						mockUser()
+						res = tc.TestAPI.Get("/api/chat/groups?sort=title&limit=1&after="+cursor, "Authorization: valid")
+						return res.Code == http.StatusBadRequest &&
+							strings.Contains(res.Body.String(), strconv.Itoa(int(v1.Err400_InvalidCursor)))
+					},
+				},
+			}
+		},
+	}
+	for name, scenario := range pageTestCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/groups?sort=-created&limit=1"))
+	}
 }
@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListChatInvitationsInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	Status        string `query:"status" enum:"pending,accepted,revoked,expired" doc:"optional filter by invitation status"`
+}
+
+type ListChatInvitationsOutput struct {
+	Body []ChatInvitation
+}
+
+func (impl *VersionedImpl) RegisterListChatInvitations(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-invitations",
+				Summary:       "List chat invitations",
+				Description:   "List invitations to join private chat channel (logged in user must be the owner of the holding chat group)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/invitations",
+			},
+		),
+		func(ctx context.Context, input *ListChatInvitationsInput) (*ListChatInvitationsOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatInvitations")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			if _, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId); err != nil {
+				return nil, err
+			}
+			// 2. Retrieve invitations along with invitees
+			invitations, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(input.ChatChannelId),
+				qm.Load(models.ChatInvitationRels.Invitee),
+				qm.OrderBy(models.ChatInvitationColumns.InvitedAt+" desc"),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			// 3. Prepare and return the response
+			response := &ListChatInvitationsOutput{
+				Body: []ChatInvitation{},
+			}
+			for _, invitation := range invitations {
+				status := invitationStatus(invitation)
+				if input.Status != "" && InvitationStatus(input.Status) != status {
+					continue
+				}
+				chatInvitation := ChatInvitation{
+					ID:         invitation.ID,
+					UserID:     invitation.InviteeID.String,
+					Email:      invitation.InviteeEmail.String,
+					Status:     status,
+					InvitedAt:  invitation.InvitedAt,
+					SentAt:     invitation.SentAt,
+					ExpiresAt:  invitation.ExpiresAt,
+					AcceptedAt: invitation.AcceptedAt.Ptr(),
+					RevokedAt:  invitation.RevokedAt.Ptr(),
+				}
+				if invitee := invitation.R.Invitee; invitee != nil {
+					chatInvitation.Email = invitee.Email
+					chatInvitation.FullName = invitee.FullName
+				}
+				response.Body = append(response.Body, chatInvitation)
+			}
+			return response, nil
+		},
+	)
+}
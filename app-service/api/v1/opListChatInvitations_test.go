@@ -0,0 +1,166 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestListChatInvitations(t *testing.T) {
+	// 1. Import users, chats and invitations from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opListChatInvitations")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_invitations", ChatInvitationsCSV); err != nil {
+		t.Fatalf("unable to import chat invitations data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	type invitation struct {
+		id     string
+		email  string
+		status v1.InvitationStatus
+	}
+	invitationsMatch := func(res *httptest.ResponseRecorder, expected []invitation) bool {
+		var chatInvitations []v1.ChatInvitation
+		if err := json.NewDecoder(res.Result().Body).Decode(&chatInvitations); err != nil || len(chatInvitations) != len(expected) {
+			return false
+		}
+		for idx, chatInvitation := range chatInvitations {
+			if chatInvitation.ID != expected[idx].id || chatInvitation.Email != expected[idx].email || chatInvitation.Status != expected[idx].status {
+				return false
+			}
+		}
+		return true
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessAll": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing all invitations of the chat channel, the most recent first",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"query":         "",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						return invitationsMatch(res, []invitation{
+							{"a2f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f62", "UserC@gmail.com", v1.InvitationStatusRevoked},
+							{"a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61", "userB@gmail.com", v1.InvitationStatusPending},
+							{"a3f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f63", "UserD@gmail.com", v1.InvitationStatusExpired},
+							{"a4f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f64", "newcomer@gmail.com", v1.InvitationStatusPending},
+						})
+					},
+				},
+			}
+		},
+		"SuccessFilteredByStatus": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing pending invitations only, expired ones are excluded",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"query":         "?status=pending",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						return invitationsMatch(res, []invitation{
+							{"a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61", "userB@gmail.com", v1.InvitationStatusPending},
+							{"a4f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f64", "newcomer@gmail.com", v1.InvitationStatusPending},
+						})
+					},
+				},
+			}
+		},
+		"FailureOnNotOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel of private chat group owned by another user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+						"query":         "",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/channels/%s/invitations%s", "chatChannelId", "query"))
+	}
+}
@@ -0,0 +1,69 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListChatInviteLinksInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+}
+
+type ListChatInviteLinksOutput struct {
+	Body []ChatInviteLink
+}
+
+func (impl *VersionedImpl) RegisterListChatInviteLinks(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-invite-links",
+				Summary:       "List chat invite links",
+				Description:   "List shareable links to join private chat channel (logged in user must be the owner of the holding chat group)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/links",
+			},
+		),
+		func(ctx context.Context, input *ListChatInviteLinksInput) (*ListChatInviteLinksOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatInviteLinks")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			if _, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId); err != nil {
+				return nil, err
+			}
+			// 2. Retrieve the links
+			links, err := models.ChatInviteLinks(
+				models.ChatInviteLinkWhere.ChatID.EQ(input.ChatChannelId),
+				qm.OrderBy(models.ChatInviteLinkColumns.CreatedAt+" desc"),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			response := &ListChatInviteLinksOutput{
+				Body: make([]ChatInviteLink, len(links)),
+			}
+			for idx, link := range links {
+				response.Body[idx] = toChatInviteLink(link)
+			}
+			return response, nil
+		},
+	)
+}
@@ -0,0 +1,136 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// CHAT_WEBHOOK_DELIVERIES_SORT is the (only) order of the delivery log, recent deliveries first
+const CHAT_WEBHOOK_DELIVERIES_SORT = "-created"
+
+type ChatWebhookDelivery struct {
+	ID             string     `json:"id"`
+	Event          string     `json:"event"`
+	Status         string     `json:"status" enum:"pending,succeeded,failed"`
+	Attempts       int        `json:"attempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty"`
+	LastStatusCode *int       `json:"lastStatusCode,omitempty" doc:"HTTP status of the latest attempt"`
+	LastError      *string    `json:"lastError,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	DeliveredAt    *time.Time `json:"deliveredAt,omitempty"`
+	Payload        string     `json:"payload" doc:"JSON body of the webhook request"`
+}
+
+type ListChatWebhookDeliveriesInput struct {
+	AuthorizationHeaderResolver
+	libAPI.PageParams
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	WebhookId   string `path:"webhookId" format:"uuid"`
+	Status      string `query:"status" enum:"pending,succeeded,failed,all" default:"all"`
+}
+
+type ListChatWebhookDeliveriesOutput struct {
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatWebhookDelivery
+}
+
+func (impl *VersionedImpl) RegisterListChatWebhookDeliveries(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-webhook-deliveries",
+				Summary:       "List chat webhook deliveries",
+				Description:   "List delivery log of the webhook, recent deliveries first (logged in user must be the owner of the chat group)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/webhooks/{webhookId}/deliveries",
+			},
+		),
+		func(ctx context.Context, input *ListChatWebhookDeliveriesInput) (*ListChatWebhookDeliveriesOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatWebhookDeliveries")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the webhook
+			webhook, err := ownedChatWebhook(ctx, db, input.ChatGroupId, input.WebhookId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			// 2. Retrieve the deliveries
+			mods := []qm.QueryMod{
+				models.ChatWebhookDeliveryWhere.WebhookID.EQ(webhook.ID),
+			}
+			if input.Status != "all" {
+				mods = append(mods, models.ChatWebhookDeliveryWhere.Status.EQ(input.Status))
+			}
+			if input.After != "" {
+				key, err := libAPI.DecodeCursor(input.After, CHAT_WEBHOOK_DELIVERIES_SORT)
+				if err == nil && len(key) != 2 {
+					err = libAPI.ErrInvalidCursor
+				}
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
+				}
+				createdAt, err := libAPI.ParseTimeSortKey(key[0])
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err400_InvalidCursor, err)
+				}
+				mods = append(mods, qm.Where(
+					fmt.Sprintf(`(%s, %s::text collate "C") < (?, ?)`, models.ChatWebhookDeliveryColumns.CreatedAt, models.ChatWebhookDeliveryColumns.ID),
+					createdAt,
+					key[1],
+				))
+			}
+			limit := libAPI.PageLimit(input.PageParams)
+			mods = append(
+				mods,
+				qm.OrderBy(fmt.Sprintf(`%s desc, %s::text collate "C" desc`, models.ChatWebhookDeliveryColumns.CreatedAt, models.ChatWebhookDeliveryColumns.ID)),
+				qm.Limit(limit+1),
+			)
+			deliveries, err := models.ChatWebhookDeliveries(mods...).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Prepare and return the response
+			response := make([]ChatWebhookDelivery, len(deliveries))
+			for idx, delivery := range deliveries {
+				response[idx] = ChatWebhookDelivery{
+					ID:             delivery.ID,
+					Event:          delivery.Event,
+					Status:         delivery.Status,
+					Attempts:       delivery.Attempts,
+					NextAttemptAt:  delivery.NextAttemptAt.Ptr(),
+					LastStatusCode: delivery.LastStatusCode.Ptr(),
+					LastError:      delivery.LastError.Ptr(),
+					CreatedAt:      delivery.CreatedAt,
+					DeliveredAt:    delivery.DeliveredAt.Ptr(),
+					Payload:        delivery.Payload,
+				}
+			}
+			nextCursor := ""
+			if len(response) > limit {
+				response = response[:limit]
+				last := response[limit-1]
+				nextCursor = libAPI.EncodeCursor(CHAT_WEBHOOK_DELIVERIES_SORT, libAPI.SortKey{libAPI.TimeSortKey(last.CreatedAt), last.ID})
+			}
+			return &ListChatWebhookDeliveriesOutput{
+				NextCursor: nextCursor,
+				Body:       response,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,68 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListChatWebhooksInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+}
+
+type ListChatWebhooksOutput struct {
+	Body []ChatWebhook
+}
+
+func (impl *VersionedImpl) RegisterListChatWebhooks(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-chat-webhooks",
+				Summary:       "List chat webhooks",
+				Description:   "List webhooks registered for the chat group (logged in user must be the owner)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/webhooks",
+			},
+		),
+		func(ctx context.Context, input *ListChatWebhooksInput) (*ListChatWebhooksOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListChatWebhooks")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat group ownership
+			chatGroup, err := ownedChatGroup(ctx, db, input.ChatGroupId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			// 2. Retrieve the webhooks
+			webhooks, err := models.ChatWebhooks(
+				models.ChatWebhookWhere.ChatID.EQ(chatGroup.ID),
+				qm.OrderBy(models.ChatWebhookColumns.CreatedAt),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Prepare and return the response
+			response := make([]ChatWebhook, len(webhooks))
+			for idx, webhook := range webhooks {
+				response[idx] = chatWebhookFromModel(webhook)
+			}
+			return &ListChatWebhooksOutput{
+				Body: response,
+			}, nil
+		},
+	)
+}
@@ -2,22 +2,22 @@ package v1
 
 import (
 	"context"
+	"database/sql"
 	"errors"
-	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/danielgtaylor/huma/v2"
 	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	libAPI "github.com/quible-io/quible-api/lib/api"
-	"github.com/quible-io/quible-api/lib/misc"
+	"github.com/rs/zerolog/log"
 )
 
 type ListGamesInput struct {
 	AuthorizationHeaderResolver
-	Date               string `query:"date" format:"date"`
-	LocalTimeZoneShift int    `query:"localTimeZoneShift" exclusiveMaximum:"0"`
+	Date string `query:"date" format:"date" doc:"games of the date, takes precedence over the range of dates"`
+	GamesFilter
 }
 
 type ListGamesOutput struct {
@@ -31,11 +31,12 @@ func (impl *VersionedImpl) RegisterListGames(api huma.API, vc libAPI.VersionConf
 			huma.Operation{
 				OperationID: "get-games",
 				Summary:     "Get games",
-				Description: "List games scheduled for the given date",
+				Description: "List games scheduled for the given date or the range of dates (season schedule)",
 				Method:      http.MethodGet,
 				Errors: []int{
 					http.StatusUnauthorized,
 					http.StatusBadRequest,
+					http.StatusNotFound,
 					http.StatusFailedDependency,
 				},
 				Tags: []string{"BasketAPI"},
@@ -43,36 +44,37 @@ func (impl *VersionedImpl) RegisterListGames(api huma.API, vc libAPI.VersionConf
 			},
 		),
 		func(ctx context.Context, input *ListGamesInput) (*ListGamesOutput, error) {
-			// 1. Adjust client location to narrow down list of games
-			loc, _ := time.LoadLocation("America/New_York")
-			if input.LocalTimeZoneShift < 0 {
-				loc = time.FixedZone("User timezone", input.LocalTimeZoneShift*int(time.Hour/time.Second))
-			}
-			dateParsed, _ := time.Parse(time.DateOnly, input.Date)
-			dateParsedInLocation, _ := time.ParseInLocation(time.DateOnly, input.Date, loc)
-			// 2. Send request to Matches API
-			url := fmt.Sprintf(
-				"https://%s/api/basketball/matches/%s",
-				BasketAPI.Host,
-				dateParsed.Format("2/1/2006"),
-			)
-			response, err := misc.GetOne[MS_Data]{
-				Client: *http.DefaultClient,
-				URL:    url,
-				UpdateRequest: func(req *http.Request) {
-					req.Header.Set("X-RapidAPI-Key", os.Getenv("ENV_RAPIDAPI_KEY"))
-					req.Header.Set("X-RapidAPI-Host", BasketAPI.Host)
-				},
-				ExpectedStatus: http.StatusOK,
-			}.Do()
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListGames")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve games of the league of the date or the range of dates
+			league, err := input.league(ctx, db)
 			if err != nil {
-				return nil, ErrorMap.GetErrorResponse(
-					Err424_BasketAPIListGames,
-					errors.New("unable to retrieve matches"),
-					err,
-				)
+				return nil, err
+			}
+			var events []libBasketAPI.Event
+			if input.Date != "" {
+				dateEvents, err := gamesOfDate(ctx, db, league, input.Date, input.location())
+				if err != nil {
+					return nil, err
+				}
+				for _, ev := range dateEvents {
+					if input.matches(ev) {
+						events = append(events, ev)
+					}
+				}
+			} else {
+				// the season schedule is stored by the crawler
+				filter, err := input.storeFilter(league)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err400_InvalidDateRange, err)
+				}
+				events, err = libBasketAPI.FindGames(ctx, db, filter)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
 			}
-			// 3. Initialize team data enhancer (inject logo, arena, colors, etc)
+			// 2. Initialize team data enhancer (inject logo, arena, colors, etc)
 			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(
@@ -81,40 +83,84 @@ func (impl *VersionedImpl) RegisterListGames(api huma.API, vc libAPI.VersionConf
 					err,
 				)
 			}
-			// 4. Convert "events" into "games"
-			games := make([]Game, 0, len(response.Events))
-			tsFrom := dateParsedInLocation.Unix()
-			tsTo := dateParsedInLocation.Add(24 * time.Hour).Unix()
-			for _, ev := range response.Events {
-				if ev.Tournament.Name != "NBA" || ev.StartTimestamp < tsFrom || ev.StartTimestamp > tsTo {
-					continue
-				}
-				ev := ev
-				GameStatus := ev.Status.Description
-				if ev.Status.Type == BasketAPI.StatusType_Inprogress && ev.Time.Played != nil {
-					totalSeconds := *ev.Time.Played - *ev.Time.PeriodLength**ev.Time.TotalPeriodCount
-					if totalSeconds <= 0 {
-						totalSeconds = *ev.Time.Played % *ev.Time.PeriodLength
-					}
-					minutes := totalSeconds / 60
-					seconds := totalSeconds % 60
-					GameStatus = fmt.Sprintf("%s (%d:%02d)", GameStatus, minutes, seconds)
-				}
-				game := Game{
-					ID:         ev.ID,
-					GameStatus: GameStatus,
-					AwayScore:  ev.AwayScore.Current,
-					HomeScore:  ev.HomeScore.Current,
-					Date:       time.Unix(ev.StartTimestamp, 0).Format(time.RFC3339),
-					HomeTeam:   teamEnhancer(ev.HomeTeam),
-					AwayTeam:   teamEnhancer(ev.AwayTeam),
-				}
-				games = append(games, game)
-			}
-			// 5. Send response with the list of games
+			// 3. Send response with "events" converted into "games"
 			return &ListGamesOutput{
-				Body: games,
+				Body: gamesFromEvents(events, teamEnhancer),
 			}, nil
 		},
 	)
 }
+
+// gamesOfDate retrieves games of the league of the date (in client location), games of past dates are served
+// from DB once complete schedule of the date is stored and finished, otherwise Matches API is requested
+func gamesOfDate(ctx context.Context, db *sql.DB, league *libBasketAPI.League, date string, loc *time.Location) ([]libBasketAPI.Event, error) {
+	dateParsed, _ := time.Parse(time.DateOnly, date)
+	dateParsedInLocation, _ := time.ParseInLocation(time.DateOnly, date, loc)
+	if events, ok := storedGames(ctx, db, league.TournamentID, dateParsedInLocation); ok {
+		return events, nil
+	}
+	response, err := BasketAPI.GetClient().Matches(ctx, dateParsed)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(
+			Err424_BasketAPIListGames,
+			errors.New("unable to retrieve matches"),
+			err,
+		)
+	}
+	tsFrom := dateParsedInLocation.Unix()
+	tsTo := dateParsedInLocation.Add(24 * time.Hour).Unix()
+	events := make([]libBasketAPI.Event, 0, len(response.Events))
+	stored := true
+	for _, ev := range response.Events {
+		if ev.Tournament.ID() != league.TournamentID {
+			continue
+		}
+		if _, err := libBasketAPI.StoreGame(ctx, db, ev); err != nil {
+			log.Error().Err(err).Send()
+			stored = false
+		}
+		if ev.StartTimestamp < tsFrom || ev.StartTimestamp > tsTo {
+			continue
+		}
+		events = append(events, ev)
+	}
+	// the response holds complete schedule of the date, it's served from DB next time once all games are finished
+	if stored && libBasketAPI.CompleteGameDay(response.Events, league.TournamentID, dateParsed, time.Now()) {
+		if err := libBasketAPI.StoreGameDays(ctx, db, league.TournamentID, []time.Time{dateParsed}); err != nil {
+			log.Error().Err(err).Send()
+		}
+	}
+	return events, nil
+}
+
+// storedGames retrieves games of the tournament of the day starting at `from` if the day is over and its complete
+// schedule is stored (by the crawler or by earlier requests to Matches API) and finished
+func storedGames(ctx context.Context, db *sql.DB, tournamentId uint, from time.Time) ([]libBasketAPI.Event, bool) {
+	to := from.Add(24 * time.Hour)
+	if time.Now().Before(to) {
+		return nil, false
+	}
+	stored, err := libBasketAPI.GameDaysStored(ctx, db, tournamentId, from, to)
+	if err != nil {
+		log.Error().Err(err).Send()
+		return nil, false
+	}
+	if !stored {
+		return nil, false
+	}
+	events, err := libBasketAPI.FindGames(ctx, db, libBasketAPI.GameFilter{
+		TournamentID: tournamentId,
+		From:         from,
+		To:           to.Add(time.Second),
+	})
+	if err != nil {
+		log.Error().Err(err).Send()
+		return nil, false
+	}
+	for _, ev := range events {
+		if ev.Status.Type != libBasketAPI.StatusType_Finished {
+			return nil, false
+		}
+	}
+	return events, true
+}
@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+const (
+	// the calendar covers games played within CALENDAR_DAYS_BEFORE and scheduled within CALENDAR_DAYS_AFTER by default
+	CALENDAR_DAYS_BEFORE = 90
+	CALENDAR_DAYS_AFTER  = 270
+)
+
+type ListGamesCalendarInput struct {
+	GamesFilter
+}
+
+type ListGamesCalendarOutput struct {
+	ContentType string `header:"content-type"`
+	Body        []byte `doc:"iCalendar (RFC 5545) document"`
+}
+
+func (impl *VersionedImpl) RegisterListGamesCalendar(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "get-games-calendar",
+				Summary:     "Get games calendar",
+				Description: "Export the schedule of games as iCalendar (text/calendar), e.g. to subscribe to the schedule of a team",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusNotFound,
+				},
+				Tags: []string{"BasketAPI", "public"},
+				Path: "/games/calendar",
+			},
+		),
+		func(ctx context.Context, input *ListGamesCalendarInput) (*ListGamesCalendarOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListGamesCalendar")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Calendar subscriptions are not expected to pass dates, default range is applied then
+			if input.From == "" && input.To == "" {
+				today := time.Now().In(input.location())
+				input.From = today.AddDate(0, 0, -CALENDAR_DAYS_BEFORE).Format(time.DateOnly)
+				input.To = today.AddDate(0, 0, CALENDAR_DAYS_AFTER).Format(time.DateOnly)
+			}
+			league, err := input.league(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			filter, err := input.storeFilter(league)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err400_InvalidDateRange, err)
+			}
+			if input.TeamId != 0 {
+				ok, err := models.TeamInfoExists(ctx, db, int(input.TeamId))
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
+				if !ok {
+					return nil, ErrorMap.GetErrorResponse(Err404_TeamNotFound)
+				}
+			}
+			// 2. Retrieve stored season schedule
+			events, err := libBasketAPI.FindGames(ctx, db, filter)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Initialize team data enhancer (inject names, arena, etc)
+			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					errors.New("unable to initialize team entity enhancer"),
+					err,
+				)
+			}
+			// 4. Render the calendar named after the team (if requested) or the league
+			name := league.Name + " games"
+			if input.TeamId != 0 {
+				name = teamTitle(teamEnhancer(libBasketAPI.TeamId{ID: input.TeamId})) + " games"
+			}
+			return &ListGamesCalendarOutput{
+				ContentType: CALENDAR_CONTENT_TYPE,
+				Body:        gamesCalendar(gamesFromEvents(events, teamEnhancer), name),
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,55 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+)
+
+type ListLeaguesInput struct {
+	AuthorizationHeaderResolver
+}
+
+type ListLeaguesOutput struct {
+	Body []League
+}
+
+func (impl *VersionedImpl) RegisterListLeagues(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "list-leagues",
+				Summary:     "List leagues",
+				Description: "List leagues covered by the service",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/leagues",
+			},
+		),
+		func(ctx context.Context, input *ListLeaguesInput) (*ListLeaguesOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListLeagues")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve enabled leagues
+			leagues, err := libBasketAPI.EnabledLeagues(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			result := make([]League, 0, len(leagues))
+			for _, league := range leagues {
+				result = append(result, leagueFromLib(league))
+			}
+			return &ListLeaguesOutput{
+				Body: result,
+			}, nil
+		},
+	)
+}
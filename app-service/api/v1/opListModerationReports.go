@@ -0,0 +1,120 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ModerationReport struct {
+	ID            string     `json:"id"`
+	ChatChannelID string     `json:"chatChannelId"`
+	ChatChannel   string     `json:"chatChannel" doc:"title of the chat channel"`
+	UserID        string     `json:"userId" doc:"author of the message"`
+	FullName      string     `json:"fullName,omitempty"`
+	MessageID     string     `json:"messageId" doc:"ID of Ably message"`
+	Text          string     `json:"text"`
+	Reason        string     `json:"reason" enum:"profanity,link,rate_limit"`
+	Details       *string    `json:"details,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	ResolvedAt    *time.Time `json:"resolvedAt,omitempty"`
+}
+
+type ListModerationReportsInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	Status      string `query:"status" enum:"pending,resolved,all" default:"pending"`
+}
+
+type ListModerationReportsOutput struct {
+	Body []ModerationReport
+}
+
+func (impl *VersionedImpl) RegisterListModerationReports(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "list-moderation-reports",
+				Summary:       "List moderation reports",
+				Description:   "List moderation queue of chat channels held by the chat group (logged in user must be the owner)",
+				Method:        http.MethodGet,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/groups/{chatGroupId}/moderation",
+			},
+		),
+		func(ctx context.Context, input *ListModerationReportsInput) (*ListModerationReportsOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListModerationReports")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat group ownership
+			chatGroup, err := models.Chats(
+				models.ChatWhere.ID.EQ(input.ChatGroupId),
+				models.ChatWhere.ParentID.IsNull(),
+				models.ChatWhere.OwnerID.EQ(null.StringFrom(input.UserId)),
+				qm.Load(models.ChatRels.ParentChats),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatGroupNotFound, err)
+			}
+			chatIds := make([]string, len(chatGroup.R.ParentChats))
+			for idx, chatChannel := range chatGroup.R.ParentChats {
+				chatIds[idx] = chatChannel.ID
+			}
+			// 2. Retrieve the reports
+			mods := []qm.QueryMod{
+				models.ChatModerationReportWhere.ChatID.IN(chatIds),
+				qm.Load(models.ChatModerationReportRels.Chat),
+				qm.Load(models.ChatModerationReportRels.User),
+				qm.OrderBy(models.ChatModerationReportColumns.CreatedAt + " desc"),
+			}
+			switch input.Status {
+			case "pending":
+				mods = append(mods, models.ChatModerationReportWhere.ResolvedAt.IsNull())
+			case "resolved":
+				mods = append(mods, models.ChatModerationReportWhere.ResolvedAt.IsNotNull())
+			}
+			reports, err := models.ChatModerationReports(mods...).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Prepare and return the response
+			response := &ListModerationReportsOutput{
+				Body: make([]ModerationReport, len(reports)),
+			}
+			for idx, report := range reports {
+				item := ModerationReport{
+					ID:            report.ID,
+					ChatChannelID: report.ChatID,
+					UserID:        report.UserID,
+					MessageID:     report.MessageID,
+					Text:          report.MessageText,
+					Reason:        report.Reason,
+					Details:       report.Details.Ptr(),
+					CreatedAt:     report.CreatedAt,
+					ResolvedAt:    report.ResolvedAt.Ptr(),
+				}
+				if report.R.Chat != nil {
+					item.ChatChannel = report.R.Chat.Title
+				}
+				if report.R.User != nil {
+					item.FullName = report.R.User.FullName
+				}
+				response.Body[idx] = item
+			}
+			return response, nil
+		},
+	)
+}
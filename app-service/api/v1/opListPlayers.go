@@ -0,0 +1,77 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+)
+
+type ListPlayersInput struct {
+	AuthorizationHeaderResolver
+	TeamId uint `query:"teamId" doc:"players of the team only"`
+}
+
+type ListPlayersOutput struct {
+	Body []Player
+}
+
+func (impl *VersionedImpl) RegisterListPlayers(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "list-players",
+				Summary:     "List players",
+				Description: "List players (ordered by name) along with their season averages",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusBadRequest,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/players",
+			},
+		),
+		func(ctx context.Context, input *ListPlayersInput) (*ListPlayersOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListPlayers")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve players stored from box scores
+			profiles, err := libBasketAPI.FindPlayers(ctx, db, input.TeamId)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 2. Aggregate season averages of the players
+			playerIds := make([]uint, 0, len(profiles))
+			for _, profile := range profiles {
+				playerIds = append(playerIds, profile.ID)
+			}
+			averages, err := libBasketAPI.SeasonAverages(ctx, db, playerIds)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 3. Initialize team data enhancer (inject logo, arena, colors, etc)
+			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					errors.New("unable to initialize team entity enhancer"),
+					err,
+				)
+			}
+			players := make([]Player, 0, len(profiles))
+			for _, profile := range profiles {
+				players = append(players, playerFromProfile(profile, teamEnhancer, averages))
+			}
+			return &ListPlayersOutput{
+				Body: players,
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ListStandingsInput struct {
+	AuthorizationHeaderResolver
+	LeagueFilter
+}
+
+type ListStandingsOutput struct {
+	Body []ConferenceStandings
+}
+
+func (impl *VersionedImpl) RegisterListStandings(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "list-standings",
+				Summary:     "List standings",
+				Description: "List standings of teams of the league in the current season grouped by conferences",
+				Method:      http.MethodGet,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusBadRequest,
+					http.StatusNotFound,
+				},
+				Tags: []string{"BasketAPI"},
+				Path: "/standings",
+			},
+		),
+		func(ctx context.Context, input *ListStandingsInput) (*ListStandingsOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opListStandings")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve stored standings of the league (refreshed periodically)
+			league, err := input.league(ctx, db)
+			if err != nil {
+				return nil, err
+			}
+			standings, err := models.Standings(
+				models.StandingWhere.TournamentID.EQ(int(league.TournamentID)),
+				models.StandingWhere.SeasonID.EQ(int(league.SeasonID)),
+				qm.OrderBy(models.StandingColumns.Conference+", "+models.StandingColumns.Position),
+			).All(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			// 2. Initialize team data enhancer (inject logo, arena, colors, etc)
+			teamEnhancer, err := BasketAPI.GetTeamEnhancer(ctx)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					errors.New("unable to initialize team entity enhancer"),
+					err,
+				)
+			}
+			// 3. Group standings by conferences
+			conferences := []ConferenceStandings{}
+			for _, standing := range standings {
+				if len(conferences) == 0 || conferences[len(conferences)-1].Conference != standing.Conference {
+					conferences = append(conferences, ConferenceStandings{
+						Conference: standing.Conference,
+						Teams:      []TeamStanding{},
+					})
+				}
+				conference := &conferences[len(conferences)-1]
+				conference.Teams = append(conference.Teams, TeamStanding{
+					Team:     teamEnhancer(libBasketAPI.TeamId{ID: uint(standing.TeamID)}),
+					Standing: standingFromModel(standing),
+				})
+			}
+			return &ListStandingsOutput{
+				Body: conferences,
+			}, nil
+		},
+	)
+}
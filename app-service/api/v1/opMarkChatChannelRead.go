@@ -0,0 +1,89 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+)
+
+type MarkChatChannelReadInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	Body          *struct {
+		MessageId *string    `json:"messageId,omitempty" doc:"ID of the last read Ably message"`
+		Timestamp *time.Time `json:"timestamp,omitempty" doc:"timestamp of the last read Ably message, defaults to current time"`
+	}
+}
+
+type MarkChatChannelReadOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterMarkChatChannelRead(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "put-chat-channel-read",
+				Summary:       "Mark chat channel read",
+				Description:   "Move read cursor of the logged in user in the chat channel (direct message) to the given message",
+				Method:        http.MethodPut,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/read",
+			},
+		),
+		func(ctx context.Context, input *MarkChatChannelReadInput) (*MarkChatChannelReadOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opMarkChatChannelRead")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Make sure the chat channel is available to the user
+			isFound, err := isChatChannelOfUser(ctx, db, input.UserId, input.ChatChannelId)
+			if err != nil {
+				return nil, err
+			}
+			if !isFound {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatChannelNotFound,
+					errors.New("chat channel is not associated with user"),
+				)
+			}
+			// 2. Store the cursor (it never moves backwards)
+			lastReadAt := time.Now()
+			var messageId *string
+			if input.Body != nil {
+				if input.Body.Timestamp != nil {
+					lastReadAt = *input.Body.Timestamp
+				}
+				messageId = input.Body.MessageId
+			}
+			// the cursor is moved by a single statement, so concurrent calls cannot move it backwards
+			if _, err := queries.Raw(
+				`insert into chat_read_cursors (chat_id, user_id, last_read_at, last_read_message_id, updated_at)
+				values ($1, $2, $3, $4, now())
+				on conflict (chat_id, user_id) do update set
+					last_read_at = excluded.last_read_at,
+					last_read_message_id = excluded.last_read_message_id,
+					updated_at = excluded.updated_at
+				where chat_read_cursors.last_read_at < excluded.last_read_at`,
+				input.ChatChannelId,
+				input.UserId,
+				lastReadAt,
+				null.StringFromPtr(messageId),
+			).ExecContext(ctx, db); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
+			return nil, nil
+		},
+	)
+}
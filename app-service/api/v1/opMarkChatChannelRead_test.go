@@ -0,0 +1,151 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestMarkChatChannelRead(t *testing.T) {
+	// 1. Import users, chats and memberships from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opMarkChatChannelRead")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_user", ChatUserCSV); err != nil {
+		t.Fatalf("unable to import chat users data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	readAt := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	cursorMatches := func(chatId string, userId string, lastReadAt time.Time, messageId string) bool {
+		cursor, err := models.FindChatReadCursor(context.Background(), db, chatId, userId)
+		return err == nil && cursor.LastReadAt.Equal(lastReadAt) && cursor.LastReadMessageID.String == messageId
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessCursorNeverMovesBackwards": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success moving the cursor forward, older messages leave it in place",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"messageId": "msg-2",
+							"timestamp": readAt,
+						},
+					},
+					Params: map[string]any{
+						"chatChannelId": "d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusNoContent,
+				},
+				PreHook: func(t *testing.T) any {
+					// User B
+					mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return cursorMatches("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3", "42d29b4b-935d-4f35-b26c-70080107f6d6", readAt, "msg-2")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// User B
+						mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+						res := tc.TestAPI.Put(
+							"/api/chat/channels/d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3/read",
+							"Authorization: valid",
+							map[string]any{
+								"messageId": "msg-1",
+								"timestamp": readAt.Add(-time.Minute),
+							},
+						)
+						return res.Code == http.StatusNoContent &&
+							cursorMatches("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3", "42d29b4b-935d-4f35-b26c-70080107f6d6", readAt, "msg-2")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// User B
+						mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+						res := tc.TestAPI.Put(
+							"/api/chat/channels/d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3/read",
+							"Authorization: valid",
+							map[string]any{
+								"messageId": "msg-3",
+								"timestamp": readAt.Add(time.Minute),
+							},
+						)
+						return res.Code == http.StatusNoContent &&
+							cursorMatches("d8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3", "42d29b4b-935d-4f35-b26c-70080107f6d6", readAt.Add(time.Minute), "msg-3")
+					},
+				},
+			}
+		},
+		"FailureOnChannelOfOtherUser": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel the user is not associated with",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User D
+					mockUser("00e52081-0452-49ba-adbc-34612d3f1259")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						found, err := models.ChatReadCursorExists(context.Background(), db, "8a2bc140-6622-4a26-b047-b3bb735bf34a", "00e52081-0452-49ba-adbc-34612d3f1259")
+						return err == nil && !found
+					},
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodPut, "/chat/channels/%s/read", "chatChannelId"))
+	}
+}
@@ -0,0 +1,155 @@
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/google/uuid"
+	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/rs/zerolog/log"
+)
+
+// -- Ably webhook (integration rule for `channel.message` source, enveloped and batched)
+
+type AblyWebhookMessage struct {
+	ID        string          `json:"id"`
+	ClientID  string          `json:"clientId"`
+	Timestamp int64           `json:"timestamp"`
+	Name      string          `json:"name,omitempty"`
+	Encoding  string          `json:"encoding,omitempty"`
+	Data      json.RawMessage `json:"data,omitempty"`
+}
+
+type AblyWebhookItem struct {
+	Source string `json:"source"`
+	Data   struct {
+		ChannelID string               `json:"channelId"`
+		Messages  []AblyWebhookMessage `json:"messages"`
+	} `json:"data"`
+}
+
+// Text extracts textual content of the message: either plain string data or `text` field of JSON data
+func (msg AblyWebhookMessage) Text() string {
+	var text string
+	if err := json.Unmarshal(msg.Data, &text); err != nil {
+		text = string(msg.Data)
+	} else if !strings.Contains(msg.Encoding, "json") {
+		return text
+	}
+	var payload struct {
+		Text string `json:"text"`
+	}
+	if err := json.Unmarshal([]byte(text), &payload); err != nil {
+		return text
+	}
+	return payload.Text
+}
+
+type ModerationWebhookInput struct {
+	Secret string `header:"x-webhook-secret"`
+	Body   struct {
+		Items []AblyWebhookItem `json:"items"`
+	}
+}
+
+type ModerationWebhookOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterModerationWebhook(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "post-moderation-webhook",
+				Summary:       "Moderation webhook",
+				Description:   "Receive chat messages from Ably integration rule to track activity and report moderation violations",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"chat"},
+				Path: "/chat/moderation/webhook",
+			},
+		),
+		func(ctx context.Context, input *ModerationWebhookInput) (*ModerationWebhookOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opModerationWebhook")
+			db := deps.Get("db").(*sql.DB)
+			moderator := deps.Get("moderator").(*chatService.Moderator)
+			// 1. Authenticate the caller (secret is configured as a custom header of Ably integration rule)
+			secret := os.Getenv("ENV_ABLY_WEBHOOK_SECRET")
+			if secret == "" || subtle.ConstantTimeCompare([]byte(secret), []byte(input.Secret)) != 1 {
+				return nil, ErrorMap.GetErrorResponse(
+					Err401_InvalidWebhookSecret,
+					errors.New("webhook secret mismatch"),
+				)
+			}
+			// 2. Scan messages and track activity of chat channels, failures are logged to let Ably consider the batch delivered
+			for _, item := range input.Body.Items {
+				if item.Source != "channel.message" || len(item.Data.Messages) == 0 {
+					continue
+				}
+				chat, err := chatService.FindChatByResource(ctx, db, item.Data.ChannelID)
+				if err != nil {
+					log.Error().Err(err).Msg("unable to process webhook item")
+					continue
+				}
+				var lastMessageAt time.Time
+				for _, message := range item.Data.Messages {
+					publishedAt := time.UnixMilli(message.Timestamp)
+					if publishedAt.After(lastMessageAt) {
+						lastMessageAt = publishedAt
+					}
+					// messages published by the backend (or by unknown clients) are not moderated
+					if _, err := uuid.Parse(message.ClientID); err != nil {
+						continue
+					}
+					text := message.Text()
+					chatService.EmitWebhookEventForChat(ctx, db, chat, chatService.WebhookEventMessagePosted, ChatWebhookData{
+						ChatChannelID: chat.ID,
+						ChatChannel:   chat.Title,
+						UserID:        message.ClientID,
+						MessageID:     message.ID,
+						Text:          text,
+						PublishedAt:   &publishedAt,
+					})
+					violations, err := moderator.Check(ctx, db, message.ClientID, item.Data.ChannelID, text, publishedAt)
+					if err != nil {
+						log.Error().Err(err).Msg("unable to moderate message")
+					}
+					if len(violations) == 0 {
+						continue
+					}
+					if err := chatService.ReportViolations(ctx, db, chat, message.ClientID, message.ID, text, violations); err != nil {
+						log.Error().Err(err).Msg("unable to moderate message")
+						continue
+					}
+					// tokens issued before the mute keep "publish" capability until they expire, the user has to get a new one
+					if slices.ContainsFunc(violations, func(violation chatService.Violation) bool {
+						return violation.Reason == chatService.ModerationReasonRateLimit
+					}) {
+						if err := ablyService.RevokeClientTokens(ctx, message.ClientID); err != nil {
+							log.Error().Err(err).Msg("unable to enforce mute")
+						}
+					}
+				}
+				if err := chatService.RegisterActivity(ctx, db, chat, lastMessageAt); err != nil {
+					log.Error().Err(err).Send()
+				}
+			}
+			return nil, nil
+		},
+	)
+}
@@ -0,0 +1,177 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type RedeemChatInviteLinkInput struct {
+	AuthorizationHeaderResolver
+	Body struct {
+		Code string `json:"code" minLength:"1"`
+	}
+}
+
+type RedeemChatInviteLinkOutput struct {
+	Body ChatChannel
+}
+
+func (impl *VersionedImpl) RegisterRedeemChatInviteLink(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "redeem-chat-invite-link",
+				Summary:       "Redeem chat invite link",
+				Description:   "Join private chat channel using shareable invite link",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/redeem",
+			},
+		),
+		func(ctx context.Context, input *RedeemChatInviteLinkInput) (*RedeemChatInviteLinkOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRedeemChatInviteLink")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the link along with the chat channel and its holding chat group
+			link, err := models.ChatInviteLinks(
+				models.ChatInviteLinkWhere.Code.EQ(input.Body.Code),
+				qm.Load(
+					qm.Rels(
+						models.ChatInviteLinkRels.Chat,
+						models.ChatRels.Parent,
+					),
+				),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatInviteLinkNotFound,
+					err,
+				)
+			}
+			chatChannel := link.R.Chat
+			if chatChannel == nil || chatChannel.R.Parent == nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatChannelNotFound,
+				)
+			}
+			if chatChannel.R.Parent.OwnerID.String == input.UserId {
+				return nil, ErrorMap.GetErrorResponse(
+					Err400_ChatGroupIsSelfOwned,
+				)
+			}
+			// 2. Test if user is already a member of the chat channel
+			chatUser, err := models.FindChatUser(ctx, db, chatChannel.ID, input.UserId)
+			if err != nil && chatUser != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			if chatUser != nil && !chatUser.Disabled {
+				return nil, ErrorMap.GetErrorResponse(
+					Err400_ChatChannelAlreadyJoined,
+				)
+			}
+			// 3. Consume one use of the link (guarded against concurrent redemptions) and create membership
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			result, err := queries.Raw(
+				`update chat_invite_links set uses = uses + 1
+				where id = $1
+				and revoked_at is null
+				and (expires_at is null or expires_at > now())
+				and (max_uses is null or uses < max_uses)`,
+				link.ID,
+			).ExecContext(ctx, tx)
+			if err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			if count, _ := result.RowsAffected(); count == 0 {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err400_ChatInviteLinkInactive,
+				)
+			}
+			newChatUser := models.ChatUser{
+				ChatID: chatChannel.ID,
+				UserID: input.UserId,
+				IsRo:   link.IsRo,
+			}
+			if err := newChatUser.Upsert(
+				ctx,
+				tx,
+				true,
+				[]string{models.ChatUserColumns.ChatID, models.ChatUserColumns.UserID},
+				boil.Whitelist(models.ChatUserColumns.Disabled, models.ChatUserColumns.IsRo),
+				boil.Whitelist(models.ChatUserColumns.ChatID, models.ChatUserColumns.UserID, models.ChatUserColumns.IsRo),
+			); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableCreateChatUser,
+					err,
+				)
+			}
+			// pending email invitation (if any) is fulfilled by the link
+			if _, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(chatChannel.ID),
+				models.ChatInvitationWhere.InviteeID.EQ(null.StringFrom(input.UserId)),
+				models.ChatInvitationWhere.Status.EQ(string(InvitationStatusPending)),
+			).UpdateAll(ctx, tx, models.M{
+				models.ChatInvitationColumns.Status:     string(InvitationStatusAccepted),
+				models.ChatInvitationColumns.AcceptedAt: time.Now(),
+			}); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableUpdateChatInvitation,
+					err,
+				)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			// 4. Prepare and return the response
+			return &RedeemChatInviteLinkOutput{
+				Body: ChatChannel{
+					ID:              chatChannel.ID,
+					Title:           chatChannel.Title,
+					Resource:        chatChannel.R.Parent.Resource + ":" + chatChannel.Resource,
+					ReadOnly:        &link.IsRo,
+					Topic:           chatChannel.Topic.Ptr(),
+					AvatarUpdatedAt: chatChannel.AvatarUpdatedAt.Ptr(),
+					UpdatedAt:       chatChannel.UpdatedAt,
+					CreatedAt:       chatChannel.CreatedAt,
+					LastMessageAt:   chatChannel.LastMessageAt.Ptr(),
+				},
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,235 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestRedeemChatInviteLink(t *testing.T) {
+	// 1. Import users, chats and invite links from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opRedeemChatInviteLink")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_invite_links", InviteLinksCSV); err != nil {
+		t.Fatalf("unable to import chat invite links data from CSV: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	linkUses := func(linkId string) int {
+		link, err := models.FindChatInviteLink(context.Background(), db, linkId)
+		if err != nil {
+			return -1
+		}
+		return link.Uses
+	}
+	isMember := func(chatId string, userId string) bool {
+		found, err := models.ChatUserExists(context.Background(), db, chatId, userId)
+		return err == nil && found
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessLastUse": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success redeeming the last use of the link, the link is inactive afterwards",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"code": "last-use",
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User D
+					mockUser("00e52081-0452-49ba-adbc-34612d3f1259")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						var chatChannel v1.ChatChannel
+						if err := json.NewDecoder(res.Result().Body).Decode(&chatChannel); err != nil {
+							return false
+						}
+						return chatChannel.ID == "b7b0a881-1602-4068-87e0-8648669afe1c" &&
+							chatChannel.ReadOnly != nil && *chatChannel.ReadOnly
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return linkUses("fd323eab-afae-4bf7-9f38-7b35089003b3") == 3 &&
+							isMember("b7b0a881-1602-4068-87e0-8648669afe1c", "00e52081-0452-49ba-adbc-34612d3f1259")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						// User B
+						mockUser("42d29b4b-935d-4f35-b26c-70080107f6d6")
+						res := tc.TestAPI.Post(
+							"/api/chat/channels/redeem",
+							"Authorization: valid",
+							map[string]any{
+								"code": "last-use",
+							},
+						)
+						return res.Code == http.StatusBadRequest &&
+							strings.Contains(res.Body.String(), strconv.Itoa(int(v1.Err400_ChatInviteLinkInactive))) &&
+							linkUses("fd323eab-afae-4bf7-9f38-7b35089003b3") == 3 &&
+							!isMember("b7b0a881-1602-4068-87e0-8648669afe1c", "42d29b4b-935d-4f35-b26c-70080107f6d6")
+					},
+				},
+			}
+		},
+		"FailureOnExhaustedLink": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on the link which reached the maximum number of uses",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"code": "exhausted",
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatInviteLinkInactive.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User C
+					mockUser("c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return linkUses("b4634635-6bad-4ab7-a787-9093371b384a") == 2 &&
+							!isMember("29af8af9-6e50-434c-b5d8-876067a3ca24", "c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					},
+				},
+			}
+		},
+		"FailureOnRevokedLink": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on revoked link",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"code": "revoked",
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatInviteLinkInactive.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User C
+					mockUser("c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnSelfOwnedChatGroup": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on the link to the channel of own chat group",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"code": "exhausted",
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatGroupIsSelfOwned.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnUnknownCode": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on unknown code",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+						map[string]any{
+							"code": "unknown",
+						},
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatInviteLinkNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User C
+					mockUser("c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodPost, "/chat/channels/redeem"))
+	}
+}
@@ -0,0 +1,63 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+type RemoveChatBotInput struct {
+	AuthorizationHeaderResolver
+	ChatGroupId string `path:"chatGroupId" format:"uuid"`
+	BotId       string `path:"botId" format:"uuid"`
+}
+
+type RemoveChatBotOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterRemoveChatBot(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-remove-chat-bot",
+				Summary:       "Remove bot from chat group",
+				Description:   "Remove bot from the chat group (logged in user must be the owner of the chat group)",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "bot", "protected"},
+				Path: "/chat/groups/{chatGroupId}/bots/{botId}",
+			},
+		),
+		func(ctx context.Context, input *RemoveChatBotInput) (*RemoveChatBotOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRemoveChatBot")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the bot in the chat group
+			chatGroup, err := ownedChatGroup(ctx, db, input.ChatGroupId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			chatBot, err := models.ChatBots(
+				models.ChatBotWhere.ChatID.EQ(chatGroup.ID),
+				models.ChatBotWhere.BotID.EQ(input.BotId),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_BotNotFound, err)
+			}
+			// 2. Remove the bot
+			if _, err := chatBot.Delete(ctx, db); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			return nil, nil
+		},
+	)
+}
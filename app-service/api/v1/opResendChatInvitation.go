@@ -0,0 +1,81 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ResendChatInvitationInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	InvitationId  string `path:"invitationId" format:"uuid" doc:"ID of the invitation"`
+}
+
+type ResendChatInvitationOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterResendChatInvitation(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "resend-chat-invitation",
+				Summary:       "Resend chat invitation",
+				Description:   "Resend pending (possibly expired) invitation to join private chat channel. Previously sent invitation link becomes obsolete",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusOK,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/invitations/{invitationId}/resend",
+			},
+		),
+		func(ctx context.Context, input *ResendChatInvitationInput) (*ResendChatInvitationOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opResendChatInvitation")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			chatChannel, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			// 2. Locate the invitation, only pending (or expired) one can be re-sent
+			invitation, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(input.ChatChannelId),
+				models.ChatInvitationWhere.ID.EQ(input.InvitationId),
+				qm.Load(models.ChatInvitationRels.Invitee),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatInvitationNotFound,
+					err,
+				)
+			}
+			if InvitationStatus(invitation.Status) != InvitationStatusPending {
+				return nil, ErrorMap.GetErrorResponse(
+					Err400_ChatInvitationNotPending,
+				)
+			}
+			// 3. Send invitation email with renewed token
+			invitor, _ := models.FindUser(ctx, db, input.UserId)
+			if invitation.R.Invitee == nil {
+				err = sendChatSignupInvitation(ctx, deps, invitation, chatChannel, invitor)
+			} else {
+				err = sendChatInvitation(ctx, deps, invitation, chatChannel, invitor, invitation.R.Invitee)
+			}
+			if err != nil {
+				return nil, err
+			}
+			return nil, nil
+		},
+	)
+}
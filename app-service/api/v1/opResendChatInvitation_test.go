@@ -0,0 +1,187 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/email"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestResendChatInvitation(t *testing.T) {
+	// 1. Import users, chats and invitations from CSV files, collect sent emails by recipient
+	db := tc.DBStore.RetrieveDB(t.Name())
+	sentEmails := map[string]email.EmailPayload{}
+	deps := tc.ServiceAPI.SetContext("opResendChatInvitation")
+	deps.Set("db", db)
+	deps.Set("mailer", email.EmailSenderFunc(func(_ context.Context, payload email.EmailPayload) error {
+		sentEmails[payload.To] = payload
+		return nil
+	}))
+	tc.ServiceAPI.SetContext("opAcceptChatInvitation").Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_invitations", ChatInvitationsCSV); err != nil {
+		t.Fatalf("unable to import chat invitations data from CSV: %s", err)
+	}
+	ctx := context.Background()
+	t.Setenv("ENV_JWT_SECRET", "secret")
+	invitor, err := models.FindUser(ctx, db, "9bef41ed-fb10-4791-b02e-96b372c09466")
+	if err != nil {
+		t.Fatalf("unable to retrieve invitor: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessUserInvitee": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success re-sending invitation to user, previously sent token becomes obsolete",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						payload, ok := sentEmails["userB@gmail.com"]
+						return ok && strings.Contains(payload.HTMLBody, "/forms/accept-private-chat-invitation?token=")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						invitation, err := models.FindChatInvitation(ctx, db, "a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61")
+						return err == nil && invitation.TokenID != "e1f2a3b4-c5d6-4e7f-8091-a2b3c4d5e6f1"
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						res := tc.TestAPI.Post("/api/chat/channels/accept", map[string]any{
+							"token": invitationToken(
+								t,
+								invitor,
+								"42d29b4b-935d-4f35-b26c-70080107f6d6",
+								"29af8af9-6e50-434c-b5d8-876067a3ca24",
+								"e1f2a3b4-c5d6-4e7f-8091-a2b3c4d5e6f1",
+							),
+						})
+						return res.Code == http.StatusExpectationFailed &&
+							strings.Contains(res.Body.String(), strconv.Itoa(int(v1.Err417_ChatInvitationObsolete)))
+					},
+				},
+			}
+		},
+		"SuccessSignupInvitee": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success re-sending invitation to sign up to invitee without an account",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a4f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f64",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						payload, ok := sentEmails["newcomer@gmail.com"]
+						return ok && strings.Contains(payload.HTMLBody, "/forms/register?email=newcomer%40gmail.com")
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						invitation, err := models.FindChatInvitation(ctx, db, "a4f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f64")
+						return err == nil && invitation.ExpiresAt.After(time.Now().Add(v1.SIGNUP_INVITATION_DURATION-time.Hour))
+					},
+				},
+			}
+		},
+		"FailureOnNotPending": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on invitation which has been revoked",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a2f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f62",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatInvitationNotPending.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						_, sent := sentEmails["UserC@gmail.com"]
+						return !sent
+					},
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodPost, "/chat/channels/%s/invitations/%s/resend", "chatChannelId", "invitationId"))
+	}
+}
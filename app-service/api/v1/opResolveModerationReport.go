@@ -0,0 +1,100 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ResolveModerationReportInput struct {
+	AuthorizationHeaderResolver
+	ReportId string `path:"reportId" format:"uuid"`
+	Body     *struct {
+		Unmute bool `json:"unmute,omitempty" doc:"lift the mute of the message author in the chat channel (if any)"`
+	}
+}
+
+type ResolveModerationReportOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterResolveModerationReport(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "post-resolve-moderation-report",
+				Summary:       "Resolve moderation report",
+				Description:   "Mark moderation report as resolved (logged in user must be the owner of the chat group)",
+				Method:        http.MethodPost,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/moderation/{reportId}/resolve",
+			},
+		),
+		func(ctx context.Context, input *ResolveModerationReportInput) (*ResolveModerationReportOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opResolveModerationReport")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve the report and validate ownership of the chat group
+			report, err := models.ChatModerationReports(
+				models.ChatModerationReportWhere.ID.EQ(input.ReportId),
+				qm.Load(
+					qm.Rels(
+						models.ChatModerationReportRels.Chat,
+						models.ChatRels.Parent,
+					),
+				),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ModerationReportNotFound, err)
+			}
+			chatChannel := report.R.Chat
+			if chatChannel == nil || chatChannel.R.Parent == nil || chatChannel.R.Parent.OwnerID != null.StringFrom(input.UserId) {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ModerationReportNotFound,
+					errors.New("chat group is not owned by user"),
+				)
+			}
+			// 2. Resolve the report (and unmute the author if requested)
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateModerationReport, err)
+			}
+			report.ResolvedAt = null.TimeFrom(time.Now())
+			report.ResolvedBy = null.StringFrom(input.UserId)
+			if _, err := report.Update(ctx, tx, boil.Whitelist(
+				models.ChatModerationReportColumns.ResolvedAt,
+				models.ChatModerationReportColumns.ResolvedBy,
+			)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateModerationReport, err)
+			}
+			if input.Body != nil && input.Body.Unmute {
+				if _, err := models.ChatUsers(
+					models.ChatUserWhere.ChatID.EQ(report.ChatID),
+					models.ChatUserWhere.UserID.EQ(report.UserID),
+				).UpdateAll(ctx, tx, models.M{models.ChatUserColumns.MutedUntil: nil}); err != nil {
+					_ = tx.Rollback()
+					return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateChatUser, err)
+				}
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateModerationReport, err)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type RestoreChatInput struct {
+	AuthorizationHeaderResolver
+	ChatId string `path:"chatId"`
+}
+
+type RestoreChatOutput struct {
+	Body models.Chat
+}
+
+func (impl *VersionedImpl) RegisterRestoreChat(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "post-restore-chat",
+				Summary:     "Restore chat record",
+				Description: "Restore previously deleted chat record (group/channel) within the grace period (logged in user must be the owner)",
+				Method:      http.MethodPost,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				DefaultStatus: http.StatusOK,
+				Tags:          []string{"chat", "protected"},
+				Path:          "/chat/{chatId}/restore",
+			},
+		),
+		func(ctx context.Context, input *RestoreChatInput) (*RestoreChatOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRestoreChat")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Retrieve deleted chat record and the chat group it belongs to
+			chat, err := models.Chats(
+				qm.WithDeleted(),
+				models.ChatWhere.ID.EQ(input.ChatId),
+				models.ChatWhere.DeletedAt.IsNotNull(),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound, err)
+			}
+			chatGroup := chat
+			if chat.ParentID.Valid {
+				chatGroup, err = models.Chats(
+					qm.WithDeleted(),
+					models.ChatWhere.ID.EQ(chat.ParentID.String),
+				).One(ctx, db)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound, err)
+				}
+			}
+			if chatGroup.OwnerID.String != input.UserId {
+				return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound)
+			}
+			// 2. Validate the request
+			if chat != chatGroup && chatGroup.DeletedAt.Valid {
+				return nil, ErrorMap.GetErrorResponse(Err400_ChatGroupIsDeleted)
+			}
+			if time.Since(chat.DeletedAt.Time) > chatService.GRACE_PERIOD {
+				return nil, ErrorMap.GetErrorResponse(Err400_RestoreGracePeriodExpired)
+			}
+			// 3. Make sure restored record doesn't collide with the ones created after deletion
+			if chat == chatGroup {
+				chatGroupFound, err := models.Chats(
+					models.ChatWhere.OwnerID.EQ(chat.OwnerID),
+					models.ChatWhere.ParentID.IsNull(),
+					qm.Expr(
+						qm.Or2(models.ChatWhere.Name.EQ(chat.Name)),
+						qm.Or2(models.ChatWhere.Title.EQ(chat.Title)),
+					),
+				).Exists(ctx, db)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
+				if chatGroupFound {
+					return nil, ErrorMap.GetErrorResponse(Err400_ChatGroupExists)
+				}
+			} else {
+				channelFound, err := models.Chats(
+					models.ChatWhere.Resource.EQ(chat.Resource),
+					models.ChatWhere.ParentID.EQ(chat.ParentID),
+				).Exists(ctx, db)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
+				if channelFound {
+					return nil, ErrorMap.GetErrorResponse(Err400_ChannelExists)
+				}
+			}
+			// 4. Restore the record along with everything deleted at the same time
+			chatIds := []string{chat.ID}
+			if chat == chatGroup {
+				chatChannels, err := models.Chats(
+					qm.WithDeleted(),
+					models.ChatWhere.ParentID.EQ(null.StringFrom(chat.ID)),
+					models.ChatWhere.DeletedAt.EQ(chat.DeletedAt),
+				).All(ctx, db)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
+				for _, chatChannel := range chatChannels {
+					chatIds = append(chatIds, chatChannel.ID)
+				}
+			}
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableRestoreChatRecord, err)
+			}
+			if err := restoreChats(ctx, tx, chatIds, chat.DeletedAt.Time); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableRestoreChatRecord, err)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableRestoreChatRecord, err)
+			}
+			// 5. Prepare and return the response
+			chat.DeletedAt = null.TimeFromPtr(nil)
+			response := &RestoreChatOutput{
+				Body: *chat,
+			}
+			return response, nil
+		},
+	)
+}
@@ -0,0 +1,227 @@
+package v1_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+func (tc *SerialTestCases) TestRestoreChat(t *testing.T) {
+	// 1. Import users and (partially deleted) chats from CSV files
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opRestoreChat")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", RestoreChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	// chat groups are deleted along with their channels (all at once) within the grace period,
+	// the second channel of "tips" chat group was deleted on its own before
+	deletedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+	if _, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ID.IN([]string{
+			"eb0d03ae-cd19-4a67-beba-f3982105b860",
+			"660bb19f-ea19-4edb-ae17-f06f6075b810",
+			"22d896b5-6070-4672-8410-a51246cb6ecd",
+			"8ad070d8-985d-4fa1-a510-4b61441ebb8c",
+			"00d9c4b2-4162-45a6-a8ff-ff35ad23efe6",
+		}),
+	).UpdateAll(context.Background(), db, models.M{models.ChatColumns.DeletedAt: deletedAt}); err != nil {
+		t.Fatalf("unable to mark chats as deleted: %s", err)
+	}
+	if _, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ID.EQ("77899e98-736a-4678-a415-ff1f0ae312d8"),
+	).UpdateAll(context.Background(), db, models.M{models.ChatColumns.DeletedAt: deletedAt.Add(-time.Hour)}); err != nil {
+		t.Fatalf("unable to mark chats as deleted: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) func(t *testing.T) any {
+		return func(t *testing.T) any {
+			gock.New(authServiceHost).
+				Get("/api/v1/user").
+				MatchHeader("Authorization", "valid").
+				Reply(http.StatusOK).
+				JSON(map[string]string{
+					"id": userId,
+				})
+			return nil
+		}
+	}
+	isDeleted := func(chatId string) bool {
+		chat, err := models.Chats(
+			qm.WithDeleted(),
+			models.ChatWhere.ID.EQ(chatId),
+		).One(context.Background(), db)
+		return err != nil || chat.DeletedAt.Valid
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessChatGroupWithChannels": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success restoring chat group along with channels deleted at the same time",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "22d896b5-6070-4672-8410-a51246cb6ecd",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				// User A
+				PreHook: mockUser("9bef41ed-fb10-4791-b02e-96b372c09466"),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						var chat models.Chat
+						if err := json.NewDecoder(res.Result().Body).Decode(&chat); err != nil {
+							return false
+						}
+						return chat.ID == "22d896b5-6070-4672-8410-a51246cb6ecd" && !chat.DeletedAt.Valid
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return !isDeleted("22d896b5-6070-4672-8410-a51246cb6ecd") &&
+							!isDeleted("8ad070d8-985d-4fa1-a510-4b61441ebb8c") &&
+							isDeleted("77899e98-736a-4678-a415-ff1f0ae312d8")
+					},
+				},
+			}
+		},
+		"FailureOnNewerChatGroupWithSameName": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat group of the same name created after deletion",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "eb0d03ae-cd19-4a67-beba-f3982105b860",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatGroupExists.Ptr(),
+				},
+				// User A
+				PreHook: mockUser("9bef41ed-fb10-4791-b02e-96b372c09466"),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return isDeleted("eb0d03ae-cd19-4a67-beba-f3982105b860") &&
+							isDeleted("660bb19f-ea19-4edb-ae17-f06f6075b810")
+					},
+				},
+			}
+		},
+		"FailureOnChannelOfDeletedChatGroup": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on restoring chat channel while its chat group is deleted",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "660bb19f-ea19-4edb-ae17-f06f6075b810",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatGroupIsDeleted.Ptr(),
+				},
+				// User A
+				PreHook: mockUser("9bef41ed-fb10-4791-b02e-96b372c09466"),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnExpiredGracePeriod": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat group deleted before the grace period",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "4edb2725-73db-40a4-aac0-442a4fd7f8d3",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_RestoreGracePeriodExpired.Ptr(),
+				},
+				// User A
+				PreHook: mockUser("9bef41ed-fb10-4791-b02e-96b372c09466"),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnChatGroupOfOtherOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat group owned by another user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatId": "00d9c4b2-4162-45a6-a8ff-ff35ad23efe6",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatRecordNotFound.Ptr(),
+				},
+				// User A
+				PreHook: mockUser("9bef41ed-fb10-4791-b02e-96b372c09466"),
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						return isDeleted("00d9c4b2-4162-45a6-a8ff-ff35ad23efe6")
+					},
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodPost, "/chat/%s/restore", "chatId"))
+	}
+}
@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type RevokeApiKeyInput struct {
+	AuthorizationHeaderResolver
+	BotId string `path:"botId" format:"uuid"`
+	KeyId string `path:"keyId" format:"uuid"`
+}
+
+type RevokeApiKeyOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterRevokeApiKey(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-revoke-api-key",
+				Summary:       "Revoke API key",
+				Description:   "Revoke API key of the bot owned by the logged in user, the key is rejected from now on",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"bot", "protected"},
+				Path: "/bots/{botId}/keys/{keyId}",
+			},
+		),
+		func(ctx context.Context, input *RevokeApiKeyInput) (*RevokeApiKeyOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRevokeApiKey")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Locate the API key
+			bot, err := ownedBot(ctx, db, input.BotId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			apiKey, err := models.APIKeys(
+				models.APIKeyWhere.ID.EQ(input.KeyId),
+				models.APIKeyWhere.BotID.EQ(bot.UserID),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err404_ApiKeyNotFound, err)
+			}
+			// 2. Revoke the key (revoking it again is no-op)
+			if apiKey.RevokedAt.Valid {
+				return nil, nil
+			}
+			apiKey.RevokedAt = null.TimeFrom(time.Now())
+			if _, err := apiKey.Update(ctx, db, boil.Whitelist(models.APIKeyColumns.RevokedAt)); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateBot, err)
+			}
+			return nil, nil
+		},
+	)
+}
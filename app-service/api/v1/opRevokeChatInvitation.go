@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type RevokeChatInvitationInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	InvitationId  string `path:"invitationId" format:"uuid" doc:"ID of the invitation"`
+}
+
+type RevokeChatInvitationOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterRevokeChatInvitation(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "revoke-chat-invitation",
+				Summary:       "Revoke chat invitation",
+				Description:   "Revoke pending invitation to join private chat channel (logged in user must be the owner of the holding chat group)",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/invitations/{invitationId}",
+			},
+		),
+		func(ctx context.Context, input *RevokeChatInvitationInput) (*RevokeChatInvitationOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRevokeChatInvitation")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			if _, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId); err != nil {
+				return nil, err
+			}
+			// 2. Locate the invitation, only pending (or expired) one can be revoked
+			invitation, err := models.ChatInvitations(
+				models.ChatInvitationWhere.ChatID.EQ(input.ChatChannelId),
+				models.ChatInvitationWhere.ID.EQ(input.InvitationId),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatInvitationNotFound,
+					err,
+				)
+			}
+			if InvitationStatus(invitation.Status) != InvitationStatusPending {
+				return nil, ErrorMap.GetErrorResponse(
+					Err400_ChatInvitationNotPending,
+				)
+			}
+			// 3. Mark invitation as revoked and drop inactive chat-user association
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			invitation.Status = string(InvitationStatusRevoked)
+			invitation.RevokedAt = null.TimeFrom(time.Now())
+			if _, err := invitation.Update(ctx, tx, boil.Whitelist(
+				models.ChatInvitationColumns.Status,
+				models.ChatInvitationColumns.RevokedAt,
+			)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnableUpdateChatInvitation,
+					err,
+				)
+			}
+			if invitation.InviteeID.Valid {
+				if _, err := models.ChatUsers(
+					models.ChatUserWhere.ChatID.EQ(input.ChatChannelId),
+					models.ChatUserWhere.UserID.EQ(invitation.InviteeID.String),
+					models.ChatUserWhere.Disabled.EQ(true),
+				).DeleteAll(ctx, tx, true); err != nil {
+					_ = tx.Rollback()
+					return nil, ErrorMap.GetErrorResponse(
+						Err500_UnableUpdateChatUser,
+						err,
+					)
+				}
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			return nil, nil
+		},
+	)
+}
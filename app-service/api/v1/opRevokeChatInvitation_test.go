@@ -0,0 +1,250 @@
+package v1_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/jwt"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// invitationToken generates the token of invitation email as sent to the invitee
+func invitationToken(t *testing.T, invitor *models.User, inviteeId string, chatChannelId string, tokenId string) string {
+	token, err := jwt.GenerateToken(
+		invitor,
+		jwt.TokenActionInvitationToPrivateChat,
+		jwt.ExtraClaims{
+			"inviteeId":     inviteeId,
+			"chatChannelId": chatChannelId,
+			"tokenId":       tokenId,
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to generate invitation token: %s", err)
+	}
+	return token.String()
+}
+
+func (tc *SerialTestCases) TestRevokeChatInvitation(t *testing.T) {
+	// 1. Import users, chats and invitations from CSV files, invitee of pending invitation has inactive membership
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opRevokeChatInvitation")
+	deps.Set("db", db)
+	tc.ServiceAPI.SetContext("opAcceptChatInvitation").Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chat_invitations", ChatInvitationsCSV); err != nil {
+		t.Fatalf("unable to import chat invitations data from CSV: %s", err)
+	}
+	ctx := context.Background()
+	chatUser := models.ChatUser{
+		ChatID:   "29af8af9-6e50-434c-b5d8-876067a3ca24",
+		UserID:   "42d29b4b-935d-4f35-b26c-70080107f6d6",
+		Disabled: true,
+	}
+	if err := chatUser.Insert(ctx, db, boil.Infer()); err != nil {
+		t.Fatalf("unable to insert chat user: %s", err)
+	}
+	t.Setenv("ENV_JWT_SECRET", "secret")
+	invitor, err := models.FindUser(ctx, db, "9bef41ed-fb10-4791-b02e-96b372c09466")
+	if err != nil {
+		t.Fatalf("unable to retrieve invitor: %s", err)
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessPending": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success revoking pending invitation, inactive membership is dropped and the emailed token is refused",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusNoContent,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						invitation, err := models.FindChatInvitation(ctx, db, "a1f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f61")
+						return err == nil && invitation.Status == string(v1.InvitationStatusRevoked) && invitation.RevokedAt.Valid
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						exists, err := models.ChatUserExists(ctx, db, chatUser.ChatID, chatUser.UserID)
+						return err == nil && !exists
+					},
+					func(_ libAPI.TCRequest, _ *httptest.ResponseRecorder) bool {
+						res := tc.TestAPI.Post("/api/chat/channels/accept", map[string]any{
+							"token": invitationToken(
+								t,
+								invitor,
+								"42d29b4b-935d-4f35-b26c-70080107f6d6",
+								"29af8af9-6e50-434c-b5d8-876067a3ca24",
+								"e1f2a3b4-c5d6-4e7f-8091-a2b3c4d5e6f1",
+							),
+						})
+						return res.Code == http.StatusExpectationFailed &&
+							strings.Contains(res.Body.String(), strconv.Itoa(int(v1.Err417_ChatInvitationRevoked)))
+					},
+				},
+			}
+		},
+		"FailureOnNotPending": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on invitation which has been revoked already",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a2f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f62",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusBadRequest,
+					ErrorCode: v1.Err400_ChatInvitationNotPending.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnInvitationOfOtherChannel": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on invitation to another chat channel",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "a5f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f65",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatInvitationNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnInviteeId": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on ID of invited user passed instead of ID of the invitation",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "29af8af9-6e50-434c-b5d8-876067a3ca24",
+						"invitationId":  "00e52081-0452-49ba-adbc-34612d3f1259",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatInvitationNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+		"FailureOnNotOwner": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure on chat channel of private chat group owned by another user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"chatChannelId": "8a2bc140-6622-4a26-b047-b3bb735bf34a",
+						"invitationId":  "a5f1c3d2-5b6e-4f70-8a91-0b2c3d4e5f65",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusNotFound,
+					ErrorCode: v1.Err404_ChatChannelNotFound.Ptr(),
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodDelete, "/chat/channels/%s/invitations/%s", "chatChannelId", "invitationId"))
+	}
+}
@@ -0,0 +1,75 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type RevokeChatInviteLinkInput struct {
+	AuthorizationHeaderResolver
+	ChatChannelId string `path:"chatChannelId" format:"uuid"`
+	LinkId        string `path:"linkId" format:"uuid"`
+}
+
+type RevokeChatInviteLinkOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterRevokeChatInviteLink(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "revoke-chat-invite-link",
+				Summary:       "Revoke chat invite link",
+				Description:   "Revoke shareable link to join private chat channel (logged in user must be the owner of the holding chat group)",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/channels/{chatChannelId}/links/{linkId}",
+			},
+		),
+		func(ctx context.Context, input *RevokeChatInviteLinkInput) (*RevokeChatInviteLinkOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opRevokeChatInviteLink")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Validate chat channel ownership
+			if _, err := ownedPrivateChatChannel(ctx, db, input.ChatChannelId, input.UserId); err != nil {
+				return nil, err
+			}
+			// 2. Locate and revoke the link (revoking it again is a no-op)
+			link, err := models.ChatInviteLinks(
+				models.ChatInviteLinkWhere.ID.EQ(input.LinkId),
+				models.ChatInviteLinkWhere.ChatID.EQ(input.ChatChannelId),
+			).One(ctx, db)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err404_ChatInviteLinkNotFound,
+					err,
+				)
+			}
+			if link.RevokedAt.Valid {
+				return nil, nil
+			}
+			link.RevokedAt = null.TimeFrom(time.Now())
+			if _, err := link.Update(ctx, db, boil.Whitelist(models.ChatInviteLinkColumns.RevokedAt)); err != nil {
+				return nil, ErrorMap.GetErrorResponse(
+					Err500_UnknownError,
+					err,
+				)
+			}
+			return nil, nil
+		},
+	)
+}
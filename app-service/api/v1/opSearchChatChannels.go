@@ -3,21 +3,76 @@ package v1
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/danielgtaylor/huma/v2"
 	libAPI "github.com/quible-io/quible-api/lib/api"
-	"github.com/quible-io/quible-api/lib/models"
 	"github.com/volatiletech/null/v8"
-	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries"
 )
 
+// MAX_SEARCH_HITS limits the number of chat channels examined by a single search
+const MAX_SEARCH_HITS = 500
+
+// chatSearchVector is the document of chat record (must match the expression of `chats_search_idx` index)
+func chatSearchVector(alias string) string {
+	return fmt.Sprintf(
+		"(setweight(to_tsvector('english', %[1]s.title), 'A') || setweight(to_tsvector('english', coalesce(%[1]s.summary, '')), 'B'))",
+		alias,
+	)
+}
+
+const chatSearchHeadlineOptions = "StartSel=<b>, StopSel=</b>, MinWords=15, MaxWords=35"
+
+// SearchHighlight holds fragments of the matched fields with search terms wrapped in <b></b>
+type SearchHighlight struct {
+	Title   *string `json:"title,omitempty"`
+	Summary *string `json:"summary,omitempty"`
+}
+
 type SearchChatChannelsInput struct {
-	Q string `query:"q" doc:"search term to be partially matched agains chat group title"`
+	OptionalAuthorizationHeaderResolver
+	libAPI.PageParams
+	Sort           string `query:"sort" enum:"relevance,title,-title,created,-created,activity,-activity" default:"relevance" doc:"sort order, prefix '-' stands for descending order; 'relevance' falls back to 'title' when 'q' is omitted"`
+	Q              string `query:"q" maxLength:"200" doc:"search terms matched against titles and summaries of chat groups and titles of chat channels (web search syntax: quoted phrases, 'or', '-' to exclude)"`
+	IncludePrivate bool   `query:"includePrivate" doc:"include channels of private chat groups the user owns or is member of (requires authorization)"`
 }
 
 type SearchChatChannelsOutput struct {
-	Body []ChatChannelsGroup
+	NextCursor string `header:"Next-Cursor"`
+	Body       []ChatChannelsGroup
+}
+
+// chatSearchHit is a single matching chat channel along with its chat group
+type chatSearchHit struct {
+	ChannelID              string      `boil:"channel_id"`
+	ChannelTitle           string      `boil:"channel_title"`
+	ChannelResource        string      `boil:"channel_resource"`
+	ChannelCreatedAt       null.Time   `boil:"channel_created_at"`
+	ChannelLastMessageAt   null.Time   `boil:"channel_last_message_at"`
+	ChannelTopic           null.String `boil:"channel_topic"`
+	ChannelUpdatedAt       null.Time   `boil:"channel_updated_at"`
+	ChannelAvatarUpdatedAt null.Time   `boil:"channel_avatar_updated_at"`
+	ChannelHighlight       string      `boil:"channel_highlight"`
+	GroupID                string      `boil:"group_id"`
+	GroupTitle             string      `boil:"group_title"`
+	GroupSummary           null.String `boil:"group_summary"`
+	GroupResource          string      `boil:"group_resource"`
+	GroupCreatedAt         null.Time   `boil:"group_created_at"`
+	GroupAvatarUpdatedAt   null.Time   `boil:"group_avatar_updated_at"`
+	GroupTitleHighlight    string      `boil:"group_title_highlight"`
+	GroupSummaryHighlight  string      `boil:"group_summary_highlight"`
+	Rank                   float64     `boil:"rank"`
+}
+
+// highlighted returns the fragment if it contains matched terms
+func highlighted(fragment string) *string {
+	if !strings.Contains(fragment, "<b>") {
+		return nil
+	}
+	return &fragment
 }
 
 func (impl *VersionedImpl) RegisterSearchChatChannels(api huma.API, vc libAPI.VersionConfig) {
@@ -27,10 +82,11 @@ func (impl *VersionedImpl) RegisterSearchChatChannels(api huma.API, vc libAPI.Ve
 			huma.Operation{
 				OperationID:   "search-chat-channels",
 				Summary:       "Search chat channels",
-				Description:   "Search public chat channels and report them along with the parent group",
+				Description:   "Full-text search of chat channels (by titles of chat channels, titles and summaries of chat groups) reported along with the parent group",
 				Method:        http.MethodGet,
 				DefaultStatus: http.StatusOK,
 				Errors: []int{
+					http.StatusBadRequest,
 					http.StatusUnauthorized,
 				},
 				Tags: []string{"chat", "public"},
@@ -41,45 +97,127 @@ func (impl *VersionedImpl) RegisterSearchChatChannels(api huma.API, vc libAPI.Ve
 			// 0. Dependences
 			deps := impl.Deps.GetContext("opSearchChatChannels")
 			db := deps.Get("db").(*sql.DB)
-			// 1. Find all matching chat groups
-			chatGroups, err := models.Chats(
-				models.ChatWhere.Title.ILIKE("%"+input.Q+"%"),
-				models.ChatWhere.ParentID.IsNull(),
-				models.ChatWhere.IsPrivate.EQ(null.BoolFrom(false)),
-				qm.Load(
-					models.ChatRels.ParentChats,
-				),
-			).All(ctx, db)
-			if err != nil {
+			// 1. Validate the request
+			if input.IncludePrivate && input.UserId == "" {
+				return nil, ErrorMap.GetErrorResponse(Err401_AuthorizationRequired)
+			}
+			memberId := null.NewString(input.UserId, input.IncludePrivate)
+			q := strings.TrimSpace(input.Q)
+			// 2. Find matching chat channels of public chat groups (and private ones the user has access to)
+			hits := []chatSearchHit{}
+			if err := queries.Raw(
+				`select
+					c.id as channel_id,
+					c.title as channel_title,
+					c.resource as channel_resource,
+					c.created_at as channel_created_at,
+					c.last_message_at as channel_last_message_at,
+					c.topic as channel_topic,
+					c.updated_at as channel_updated_at,
+					c.avatar_updated_at as channel_avatar_updated_at,
+					ts_headline('english', c.title, q.query, $3) as channel_highlight,
+					g.id as group_id,
+					g.title as group_title,
+					g.summary as group_summary,
+					g.resource as group_resource,
+					g.created_at as group_created_at,
+					g.avatar_updated_at as group_avatar_updated_at,
+					ts_headline('english', g.title, q.query, $3) as group_title_highlight,
+					ts_headline('english', coalesce(g.summary, ''), q.query, $3) as group_summary_highlight,
+					ts_rank(`+chatSearchVector("g")+` || `+chatSearchVector("c")+`, q.query) as rank
+				from chats c
+				join chats g on g.id = c.parent_id
+				cross join websearch_to_tsquery('english', $1) as q(query)
+				where c.deleted_at is null
+				and g.deleted_at is null
+				and ($1 = '' or `+chatSearchVector("g")+` @@ q.query or `+chatSearchVector("c")+` @@ q.query)
+				and (
+					not coalesce(g.is_private, false)
+					or g.owner_id = $2
+					or exists (
+						select 1 from chat_user cu
+						where cu.chat_id = c.id
+						and cu.user_id = $2
+						and not cu.disabled
+						and cu.deleted_at is null
+					)
+				)
+				order by rank desc, c.id
+				limit $4`,
+				q,
+				memberId,
+				chatSearchHeadlineOptions,
+				MAX_SEARCH_HITS,
+			).Bind(ctx, db, &hits); err != nil {
 				return nil, ErrorMap.GetErrorResponse(
 					Err500_UnknownError,
 					err,
 				)
 			}
-			// 2. Extract associated chat channels
+			// 3. Group matching chat channels by chat group (group's rank is the best rank of its channels)
 			chatChannelsGroupSlice := []ChatChannelsGroup{}
-			for _, chatGroup := range chatGroups {
-				chatChannels := make([]ChatChannel, len(chatGroup.R.ParentChats))
-				for idx, chat := range chatGroup.R.ParentChats {
-					chatChannels[idx] = ChatChannel{
-						ID:       chat.ID,
-						Title:    chat.Title,
-						Resource: chatGroup.Resource + ":" + chat.Resource,
+			groupIdx := map[string]int{}
+			for _, hit := range hits {
+				chatChannel := ChatChannel{
+					ID:              hit.ChannelID,
+					Title:           hit.ChannelTitle,
+					Resource:        hit.GroupResource + ":" + hit.ChannelResource,
+					Topic:           hit.ChannelTopic.Ptr(),
+					AvatarUpdatedAt: hit.ChannelAvatarUpdatedAt.Ptr(),
+					UpdatedAt:       hit.ChannelUpdatedAt.Time,
+					CreatedAt:       hit.ChannelCreatedAt.Time,
+					LastMessageAt:   hit.ChannelLastMessageAt.Ptr(),
+				}
+				if title := highlighted(hit.ChannelHighlight); title != nil {
+					chatChannel.Highlight = &SearchHighlight{Title: title}
+				}
+				idx, ok := groupIdx[hit.GroupID]
+				if !ok {
+					idx = len(chatChannelsGroupSlice)
+					groupIdx[hit.GroupID] = idx
+					rank := hit.Rank
+					group := ChatChannelsGroup{
+						ID:              hit.GroupID,
+						Title:           hit.GroupTitle,
+						Summary:         hit.GroupSummary.Ptr(),
+						ChatChannels:    []ChatChannel{},
+						CreatedAt:       hit.GroupCreatedAt.Time,
+						AvatarUpdatedAt: hit.GroupAvatarUpdatedAt.Ptr(),
+					}
+					title, summary := highlighted(hit.GroupTitleHighlight), highlighted(hit.GroupSummaryHighlight)
+					if q != "" {
+						group.Rank = &rank
+					}
+					if title != nil || summary != nil {
+						group.Highlight = &SearchHighlight{Title: title, Summary: summary}
 					}
+					chatChannelsGroupSlice = append(chatChannelsGroupSlice, group)
 				}
-				chatChannelsGroupSlice = append(
-					chatChannelsGroupSlice,
-					ChatChannelsGroup{
-						ID:           chatGroup.ID,
-						Title:        chatGroup.Title,
-						Summary:      chatGroup.Summary.Ptr(),
-						ChatChannels: chatChannels,
-					},
-				)
+				chatChannelsGroupSlice[idx].ChatChannels = append(chatChannelsGroupSlice[idx].ChatChannels, chatChannel)
+			}
+			// 4. Prepare and return the response
+			sortParams := ChatSortParams{
+				PageParams: input.PageParams,
+				Sort:       input.Sort,
+			}
+			switch {
+			case input.Sort == "relevance" && q == "":
+				sortParams.Sort = "title"
+			case input.Sort == "relevance":
+				sortParams.Sort = "-relevance"
+			}
+			page, nextCursor, err := paginateChats(chatChannelsGroupSlice, sortParams, func(sortBy string, group ChatChannelsGroup) libAPI.SortKey {
+				if sortBy == "relevance" {
+					return libAPI.SortKey{fmt.Sprintf("%020.10f", *group.Rank), group.ID}
+				}
+				return chatChannelsGroupSortKey(sortBy, group)
+			})
+			if err != nil {
+				return nil, err
 			}
-			// 3. Prepare and return the response
 			return &SearchChatChannelsOutput{
-				Body: chatChannelsGroupSlice,
+				NextCursor: nextCursor,
+				Body:       page,
 			}, nil
 		},
 	)
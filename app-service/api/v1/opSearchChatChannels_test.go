@@ -0,0 +1,186 @@
+package v1_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/h2non/gock"
+	v1 "github.com/quible-io/quible-api/app-service/api/v1"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/suite"
+)
+
+func (tc *SerialTestCases) TestSearchChatChannels(t *testing.T) {
+	// 1. Import users and chats from CSV files, make chat records of several groups match the search terms
+	db := tc.DBStore.RetrieveDB(t.Name())
+	deps := tc.ServiceAPI.SetContext("opSearchChatChannels")
+	deps.Set("db", db)
+	if err := suite.InsertFromCSV(db, "users", UsersCSV); err != nil {
+		t.Fatalf("unable to import users data from CSV: %s", err)
+	}
+	if err := suite.InsertFromCSV(db, "chats", ChatsCSV); err != nil {
+		t.Fatalf("unable to import chat data from CSV: %s", err)
+	}
+	for _, stmt := range []string{
+		// PubGr1 channel matches by title (weight A)
+		`update chats set title = 'Basketball talk' where id = 'd8ccd6ae-6367-4cb6-ac3f-adc86c8dfab3'`,
+		// PubGr2 and PrGr1 match by summary (weight B)
+		`update chats set summary = 'Everything about basketball playoffs' where id in ('cd54bef3-5793-4b3f-809d-8b73fad05f4c', '6e22a7e1-a7ed-46ec-8043-c49055d0e528')`,
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("unable to update chat records: %s", err)
+		}
+	}
+	authServiceHost := "http://localhost"
+	mockUser := func(userId string) {
+		gock.New(authServiceHost).
+			Get("/api/v1/user").
+			MatchHeader("Authorization", "valid").
+			Reply(http.StatusOK).
+			JSON(map[string]string{
+				"id": userId,
+			})
+	}
+	decodeGroups := func(res *httptest.ResponseRecorder) []v1.ChatChannelsGroup {
+		var chatChannelsGroups []v1.ChatChannelsGroup
+		if err := json.NewDecoder(res.Result().Body).Decode(&chatChannelsGroups); err != nil {
+			return nil
+		}
+		return chatChannelsGroups
+	}
+	// 2. Define test scenarios
+	testCases := libAPI.TCScenarios{
+		"SuccessRankedWithHighlights": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success ranking title matches above summary matches, matched terms are highlighted",
+				Request: libAPI.TCRequest{
+					Params: map[string]any{
+						"query": "?q=basketball",
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						groups := decodeGroups(res)
+						if len(groups) != 2 || groups[0].ID != "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf" || groups[1].ID != "cd54bef3-5793-4b3f-809d-8b73fad05f4c" {
+							return false
+						}
+						if groups[0].Rank == nil || groups[1].Rank == nil || *groups[0].Rank <= *groups[1].Rank {
+							return false
+						}
+						// PubGr1: only the matching channel is reported, highlighted by its title
+						titleMatch, summaryMatch := groups[0], groups[1]
+						if len(titleMatch.ChatChannels) != 1 || titleMatch.Highlight != nil {
+							return false
+						}
+						if highlight := titleMatch.ChatChannels[0].Highlight; highlight == nil || highlight.Title == nil || *highlight.Title != "<b>Basketball</b> talk" {
+							return false
+						}
+						// PubGr2: both channels are reported by the group's summary, highlighted at the group
+						if len(summaryMatch.ChatChannels) != 2 || summaryMatch.ChatChannels[0].Highlight != nil {
+							return false
+						}
+						highlight := summaryMatch.Highlight
+						return highlight != nil && highlight.Title == nil && highlight.Summary != nil &&
+							*highlight.Summary == "Everything about <b>basketball</b> playoffs"
+					},
+				},
+			}
+		},
+		"SuccessIncludePrivate": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success including matching channels of private chat group owned by the user",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						"Authorization: valid",
+					},
+					Params: map[string]any{
+						"query": "?q=basketball&includePrivate=true",
+					},
+				},
+				Envs: libAPI.TCEnv{
+					"ENV_URL_AUTH_SERVICE": authServiceHost,
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				PreHook: func(t *testing.T) any {
+					// User A
+					mockUser("9bef41ed-fb10-4791-b02e-96b372c09466")
+					return nil
+				},
+				PostHook: func(t *testing.T, a any) {
+					gock.Off()
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						groups := decodeGroups(res)
+						if len(groups) != 3 || groups[0].ID != "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf" {
+							return false
+						}
+						for _, group := range groups[1:] {
+							if group.ID == "6e22a7e1-a7ed-46ec-8043-c49055d0e528" {
+								return len(group.ChatChannels) == 2
+							}
+						}
+						return false
+					},
+				},
+			}
+		},
+		"SuccessWithoutTerms": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Success listing channels of public chat groups by title when search terms are omitted, without ranks and highlights",
+				Request: libAPI.TCRequest{
+					Params: map[string]any{
+						"query": "",
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(_ libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						groups := decodeGroups(res)
+						if len(groups) != 2 || groups[0].ID != "8482ba32-840b-4ccd-8d0f-ab5f6628bbcf" || groups[1].ID != "cd54bef3-5793-4b3f-809d-8b73fad05f4c" {
+							return false
+						}
+						for _, group := range groups {
+							if group.Rank != nil || group.Highlight != nil || len(group.ChatChannels) != 2 {
+								return false
+							}
+							for _, chatChannel := range group.ChatChannels {
+								if chatChannel.Highlight != nil {
+									return false
+								}
+							}
+						}
+						return true
+					},
+				},
+			}
+		},
+		"FailureIncludePrivateAnonymous": func(t *testing.T) libAPI.TCData {
+			return libAPI.TCData{
+				Description: "Failure including private chat groups without authorization",
+				Request: libAPI.TCRequest{
+					Params: map[string]any{
+						"query": "?q=basketball&includePrivate=true",
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status:    http.StatusUnauthorized,
+					ErrorCode: v1.Err401_AuthorizationRequired.Ptr(),
+				},
+			}
+		},
+	}
+	// 3. Run scenarios in sequence
+	for name, scenario := range testCases {
+		t.Run(name, scenario.GetRunner(tc.TestAPI, http.MethodGet, "/chat/channels/search%s", "query"))
+	}
+}
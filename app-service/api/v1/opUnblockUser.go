@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+type UnblockUserInput struct {
+	AuthorizationHeaderResolver
+	BlockedUserId string `path:"userId" format:"uuid"`
+}
+
+type UnblockUserOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterUnblockUser(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID:   "delete-block-user",
+				Summary:       "Unblock user",
+				Description:   "Allow previously blocked user to send direct messages to the logged in user",
+				Method:        http.MethodDelete,
+				DefaultStatus: http.StatusNoContent,
+				Errors: []int{
+					http.StatusUnauthorized,
+				},
+				Tags: []string{"chat", "protected"},
+				Path: "/chat/blocks/{userId}",
+			},
+		),
+		func(ctx context.Context, input *UnblockUserInput) (*UnblockUserOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opUnblockUser")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Remove the block (if any)
+			if _, err := models.UserBlocks(
+				models.UserBlockWhere.UserID.EQ(input.UserId),
+				models.UserBlockWhere.BlockedUserID.EQ(input.BlockedUserId),
+			).DeleteAll(ctx, db); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateUserBlock, err)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -7,24 +7,31 @@ import (
 	"reflect"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type UpdateChatInput struct {
 	AuthorizationHeaderResolver
 	ChatId string `path:"chatId"`
 	Body   struct {
-		Name      *string `json:"name,omitempty" pattern:"\\w+" doc:"resource name"`
-		Title     *string `json:"title,omitempty" doc:"human-readable 'title' of the chat group/channel"`
+		Name      *string `json:"name,omitempty" pattern:"\\w+" doc:"only for chat groups, name of the chat group"`
+		Title     *string `json:"title,omitempty" minLength:"1" doc:"human-readable 'title' of the chat group/channel"`
 		Summary   *string `json:"summary,omitempty" doc:"optional summary, to clear send empty string"`
 		IsPrivate *bool   `json:"isPrivate,omitempty" doc:"only for chat groups"`
+		Topic     *string `json:"topic,omitempty" maxLength:"250" doc:"only for chat channels, to clear send empty string"`
+		// fields below are not columns of chat record and handled separately
+		Pinned       *[]PinnedItem `json:"pinned,omitempty" maxItems:"20" doc:"only for chat channels, replaces the list of pinned items"`
+		RemoveAvatar *bool         `json:"removeAvatar,omitempty" doc:"remove avatar image (uploaded with PUT /chat/{chatId}/avatar)"`
 	}
 }
 
 type UpdateChatOutput struct {
-	Body models.Chat
+	Body ChatRecord
 }
 
 func (impl *VersionedImpl) RegisterUpdateChat(api huma.API, vc libAPI.VersionConfig) {
@@ -34,11 +41,12 @@ func (impl *VersionedImpl) RegisterUpdateChat(api huma.API, vc libAPI.VersionCon
 			huma.Operation{
 				OperationID: "patch-update-chat",
 				Summary:     "Update chat record",
-				Description: "Update chat record (group/channel) with provided details",
+				Description: "Update chat record (group/channel) with provided details (logged in user must be the owner)",
 				Method:      http.MethodPatch,
 				Errors: []int{
 					http.StatusBadRequest,
 					http.StatusUnauthorized,
+					http.StatusNotFound,
 				},
 				DefaultStatus: http.StatusOK,
 				Tags:          []string{"chat", "protected"},
@@ -50,13 +58,22 @@ func (impl *VersionedImpl) RegisterUpdateChat(api huma.API, vc libAPI.VersionCon
 			deps := impl.Deps.GetContext("opUpdateChat")
 			db := deps.Get("db").(*sql.DB)
 			// 1. Retrieve the chat record for update
-			chat, err := models.FindChat(ctx, db, input.ChatId)
+			chat, err := ownedChat(ctx, db, input.ChatId, input.UserId)
 			if err != nil {
-				return nil, ErrorMap.GetErrorResponse(Err404_ChatRecordNotFound, err)
+				return nil, err
 			}
-			if chat.ParentID.Ptr() != nil && input.Body.IsPrivate != nil {
-				// for chat channels IsPrivate cannot be set
-				return nil, ErrorMap.GetErrorResponse(Err400_OnlyForChatGroups, err)
+			if chat.ParentID.Ptr() != nil && (input.Body.IsPrivate != nil || input.Body.Name != nil) {
+				// for chat channels IsPrivate and name cannot be set (the resource of a channel is immutable)
+				return nil, ErrorMap.GetErrorResponse(Err400_OnlyForChatGroups)
+			}
+			if chat.ParentID.Ptr() == nil && (input.Body.Topic != nil || input.Body.Pinned != nil) {
+				// topic and pinned items are set for chat channels only
+				return nil, ErrorMap.GetErrorResponse(Err400_OnlyForChatChannels)
+			}
+			if input.Body.Pinned != nil {
+				if err := validatePinnedItems(*input.Body.Pinned); err != nil {
+					return nil, err
+				}
 			}
 			// 2. Update chat record with respect to provided PATCH data
 			patchDataType := reflect.TypeOf(input.Body)
@@ -67,6 +84,9 @@ func (impl *VersionedImpl) RegisterUpdateChat(api huma.API, vc libAPI.VersionCon
 				fieldValue := patchDataValue.Field(i).Elem()
 				if fieldValue.IsValid() {
 					target := userValue.FieldByName(fieldName)
+					if target.Kind() == reflect.String {
+						target.SetString(fieldValue.String())
+					}
 					if target.Kind().String() == "struct" {
 						switch target.Type().String() {
 						case "null.String":
@@ -79,13 +99,72 @@ func (impl *VersionedImpl) RegisterUpdateChat(api huma.API, vc libAPI.VersionCon
 					}
 				}
 			}
-			// 3. Store updated chat record
-			if _, err := chat.Update(ctx, db, boil.Infer()); err != nil {
+			// -- the name and the title of a chat group remain unique among chat groups of the owner
+			if chat.ParentID.Ptr() == nil && (input.Body.Name != nil || input.Body.Title != nil) {
+				chatGroupFound, err := models.Chats(
+					models.ChatWhere.OwnerID.EQ(chat.OwnerID),
+					models.ChatWhere.ParentID.IsNull(),
+					models.ChatWhere.ID.NEQ(chat.ID),
+					qm.Expr(
+						qm.Or2(models.ChatWhere.Name.EQ(chat.Name)),
+						qm.Or2(models.ChatWhere.Title.EQ(chat.Title)),
+					),
+				).Exists(ctx, db)
+				if err != nil {
+					return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+				}
+				if chatGroupFound {
+					return nil, ErrorMap.GetErrorResponse(Err400_ChatGroupExists)
+				}
+			}
+			removeAvatar := input.Body.RemoveAvatar != nil && *input.Body.RemoveAvatar
+			if removeAvatar {
+				chat.AvatarUpdatedAt = null.TimeFromPtr(nil)
+			}
+			// 3. Store updated chat record along with pinned items and avatar
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateChatRecord, err)
+			}
+			if _, err := chat.Update(ctx, tx, boil.Infer()); err != nil {
+				_ = tx.Rollback()
 				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateChatRecord, err)
 			}
+			if input.Body.Pinned != nil {
+				if err := replacePinnedItems(ctx, tx, chat.ID, input.UserId, *input.Body.Pinned); err != nil {
+					_ = tx.Rollback()
+					return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateChatPins, err)
+				}
+			}
+			if removeAvatar {
+				if _, err := models.ChatAvatars(
+					models.ChatAvatarWhere.ChatID.EQ(chat.ID),
+				).DeleteAll(ctx, tx); err != nil {
+					_ = tx.Rollback()
+					return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+				}
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableUpdateChatRecord, err)
+			}
+			if chat.ParentID.Valid {
+				chatService.EmitWebhookEventForChat(ctx, db, chat, chatService.WebhookEventChannelUpdated, ChatWebhookData{
+					ChatChannelID: chat.ID,
+					ChatChannel:   chat.Title,
+					Topic:         chat.Topic.Ptr(),
+					UserID:        input.UserId,
+				})
+			}
 			// 4. Prepare and return the response
+			pinnedItems, err := pinnedItemsByChatId(ctx, db, []string{chat.ID})
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+			}
 			response := &UpdateChatOutput{
-				Body: *chat,
+				Body: ChatRecord{
+					Chat:   *chat,
+					Pinned: append([]PinnedItem{}, pinnedItems[chat.ID]...),
+				},
 			}
 			return response, nil
 		},
@@ -0,0 +1,104 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/danielgtaylor/huma/v2"
+	libAPI "github.com/quible-io/quible-api/lib/api"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type UploadChatAvatarInput struct {
+	AuthorizationHeaderResolver
+	ChatId      string `path:"chatId"`
+	ContentType string `header:"Content-Type"`
+	RawBody     []byte
+	*libAPI.ImageData
+}
+
+func (input *UploadChatAvatarInput) Resolve(ctx huma.Context) (errs []error) {
+	if errs = input.AuthorizationHeaderResolver.Resolve(ctx); len(errs) > 0 {
+		return
+	}
+	input.ImageData, errs = libAPI.ParseImageUpload(input.ContentType, input.RawBody)
+	return
+}
+
+type UploadChatAvatarOutput struct {
+}
+
+func (impl *VersionedImpl) RegisterUploadChatAvatar(api huma.API, vc libAPI.VersionConfig) {
+	huma.Register(
+		api,
+		vc.Prefixer(
+			huma.Operation{
+				OperationID: "put-upload-chat-avatar",
+				Summary:     "Upload chat avatar",
+				Description: "Upload avatar image of chat record (group/channel) as multipart body with the image under key `image` (logged in user must be the owner)",
+				Method:      http.MethodPut,
+				Errors: []int{
+					http.StatusBadRequest,
+					http.StatusUnauthorized,
+					http.StatusNotFound,
+				},
+				DefaultStatus: http.StatusAccepted,
+				Tags:          []string{"chat", "protected"},
+				Path:          "/chat/{chatId}/avatar",
+			},
+		),
+		func(ctx context.Context, input *UploadChatAvatarInput) (*UploadChatAvatarOutput, error) {
+			// 0. Dependences
+			deps := impl.Deps.GetContext("opUploadChatAvatar")
+			db := deps.Get("db").(*sql.DB)
+			// 1. Analyze result of resolver execution
+			if input.ImageData == nil {
+				return nil, ErrorMap.GetErrorResponse(Err400_ImageDataNotPresent)
+			}
+			if len(input.ImageData.BinaryContent) > libAPI.MAX_IMAGE_SIZE {
+				return nil, ErrorMap.GetErrorResponse(Err400_FileTooLarge)
+			}
+			// 2. Locate the chat record to be updated with image data
+			chat, err := ownedChat(ctx, db, input.ChatId, input.UserId)
+			if err != nil {
+				return nil, err
+			}
+			b, err := json.Marshal(input.ImageData)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+			}
+			// 3. Store the image and mark the chat record as having an avatar
+			now := time.Now()
+			tx, err := db.BeginTx(ctx, nil)
+			if err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+			}
+			chatAvatar := models.ChatAvatar{
+				ChatID:    chat.ID,
+				Image:     b,
+				UpdatedAt: now,
+			}
+			if err := chatAvatar.Upsert(ctx, tx, true, []string{models.ChatAvatarColumns.ChatID}, boil.Infer(), boil.Infer()); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+			}
+			chat.AvatarUpdatedAt = null.TimeFrom(now)
+			if _, err := chat.Update(ctx, tx, boil.Whitelist(
+				models.ChatColumns.AvatarUpdatedAt,
+				models.ChatColumns.UpdatedAt,
+			)); err != nil {
+				_ = tx.Rollback()
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+			}
+			if err := tx.Commit(); err != nil {
+				return nil, ErrorMap.GetErrorResponse(Err500_UnableStoreChatAvatar, err)
+			}
+			return nil, nil
+		},
+	)
+}
@@ -4,8 +4,10 @@ import (
 	_ "embed"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/danielgtaylor/huma/v2"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/email/postmark"
 	"github.com/volatiletech/sqlboiler/v4/boil"
@@ -22,12 +24,21 @@ func WithDeps(deps libAPI.Deps) WithOption {
 	}
 }
 
+// WithLegacyResourceCutoff sets the end of the compatibility window of name-based chat resources (see LegacyResourceCutoff)
+func WithLegacyResourceCutoff(cutoff time.Time) WithOption {
+	return func(vi *VersionedImpl) {
+		vi.Deps.Set("legacyResourceCutoff", cutoff)
+	}
+}
+
 func NewServiceAPI(opts ...WithOption) libAPI.ServiceAPI {
 	impl := &VersionedImpl{
 		Deps: libAPI.NewDeps(
 			map[string]any{
 				"db":     boil.GetDB(),
 				"mailer": postmark.NewClient(),
+				// message moderation is configured once (rate limits are tracked in DB), the instance is shared across requests
+				"moderator": chatService.NewModerator(),
 			},
 		),
 	}
@@ -47,6 +58,8 @@ func (impl VersionedImpl) NewError(status int, message string, errs ...error) hu
 			"header.authorization": Err401_InvalidAccessToken,
 			"auth-service":         Err401_AuthServiceError,
 			"db.users":             Err401_UserNotFound,
+			"apikey.operation":     Err403_OperationNotAvailableToBots,
+			"apikey.scope":         Err403_InsufficientScope,
 		}
 		for i := 0; i < len(errs); i++ {
 			if converted, ok := errs[i].(huma.ErrorDetailer); ok {
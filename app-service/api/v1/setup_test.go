@@ -23,6 +23,24 @@ var ChatsCSV string
 //go:embed TestData/chat-user.csv
 var ChatUserCSV string
 
+//go:embed TestData/restore-chats.csv
+var RestoreChatsCSV string
+
+//go:embed TestData/chat-invitations.csv
+var ChatInvitationsCSV string
+
+//go:embed TestData/invite-links.csv
+var InviteLinksCSV string
+
+//go:embed TestData/user-blocks.csv
+var UserBlocksCSV string
+
+//go:embed TestData/legacy-chats.csv
+var LegacyChatsCSV string
+
+//go:embed TestData/legacy-chat-user.csv
+var LegacyChatUserCSV string
+
 type tlogWriter struct {
 	t *testing.T
 }
@@ -39,9 +57,16 @@ type (
 		humatest.TestAPI
 		libAPI.ServiceAPI
 	}
+	// SerialTestCases are scenarios which set environment variables or mock auth-service (both are process-wide),
+	// they run one at a time
+	SerialTestCases struct {
+		suite.TestSuite
+		humatest.TestAPI
+		libAPI.ServiceAPI
+	}
 )
 
-func TestRunner(t *testing.T) {
+func newTestAPI(t *testing.T) (libAPI.ServiceAPI, humatest.TestAPI) {
 	log.Logger = log.Output(zerolog.ConsoleWriter{Out: &tlogWriter{t}})
 	gin.SetMode(gin.ReleaseMode)
 	serviceAPI := v1.NewServiceAPI(
@@ -56,8 +81,11 @@ func TestRunner(t *testing.T) {
 		gin.Default(),
 		libAPI.VersionConfig{},
 	)
+	return serviceAPI, suite.NewTestAPI(t, api)
+}
 
-	testAPI := suite.NewTestAPI(t, api)
+func TestRunner(t *testing.T) {
+	serviceAPI, testAPI := newTestAPI(t)
 	suite.RunSuite(
 		t,
 		&TestCases{
@@ -70,3 +98,18 @@ func TestRunner(t *testing.T) {
 		true,
 	)
 }
+
+func TestSerialRunner(t *testing.T) {
+	serviceAPI, testAPI := newTestAPI(t)
+	suite.RunSuite(
+		t,
+		&SerialTestCases{
+			ServiceAPI: serviceAPI,
+			TestSuite: suite.TestSuite{
+				DBStore: suite.NewDBs(),
+			},
+			TestAPI: testAPI,
+		},
+		false,
+	)
+}
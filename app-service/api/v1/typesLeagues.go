@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type League struct {
+	ID   string `json:"id" doc:"ID of the league to pass as league parameter"`
+	Name string `json:"name"`
+}
+
+func leagueFromLib(league libBasketAPI.League) League {
+	return League{
+		ID:   league.ID,
+		Name: league.Name,
+	}
+}
+
+// LeagueFilter narrows down the data to a league (NBA by default)
+type LeagueFilter struct {
+	League string `query:"league" default:"nba" doc:"ID of the league (see GET /leagues)"`
+}
+
+// league retrieves the league requested, unknown (or disabled) leagues are reported as not found
+func (filter LeagueFilter) league(ctx context.Context, exec boil.ContextExecutor) (*libBasketAPI.League, error) {
+	league, err := libBasketAPI.FindLeague(ctx, exec, filter.League)
+	if err != nil {
+		return nil, ErrorMap.GetErrorResponse(Err500_UnknownError, err)
+	}
+	if league == nil {
+		return nil, ErrorMap.GetErrorResponse(Err404_LeagueNotFound, errors.New(filter.League))
+	}
+	return league, nil
+}
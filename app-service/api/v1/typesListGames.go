@@ -11,8 +11,3 @@ type Game struct {
 	AwayScore  *uint              `json:"awayScore"`
 	Date       string             `json:"date"`
 }
-
-// -- MatchSchedules (MS) API
-type MS_Data struct {
-	Events []BasketAPI.Event `json:"events"`
-}
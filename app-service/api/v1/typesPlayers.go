@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+type Player struct {
+	ID             uint                         `json:"id"`
+	Name           string                       `json:"name"`
+	Position       string                       `json:"position"`
+	JerseyNumber   string                       `json:"jerseyNumber"`
+	Headshot       *string                      `json:"headshot,omitempty" doc:"URL of ESPN headshot"`
+	Team           *BasketAPI.TeamInfo          `json:"team,omitempty"`
+	SeasonAverages *libBasketAPI.PlayerAverages `json:"seasonAverages,omitempty" doc:"per-game averages of the current season"`
+}
+
+func playerFromProfile(
+	profile libBasketAPI.PlayerProfile,
+	teamEnhancer func(libBasketAPI.TeamId) BasketAPI.TeamInfo,
+	averages map[uint]libBasketAPI.PlayerAverages,
+) Player {
+	player := Player{
+		ID:           profile.ID,
+		Name:         profile.Name,
+		Position:     profile.Position,
+		JerseyNumber: profile.JerseyNumber,
+		Headshot:     profile.Headshot,
+	}
+	if profile.TeamID != nil {
+		team := teamEnhancer(libBasketAPI.TeamId{ID: *profile.TeamID})
+		player.Team = &team
+	}
+	if average, ok := averages[profile.ID]; ok {
+		player.SeasonAverages = &average
+	}
+	return player
+}
+
+type PlayerGameLog struct {
+	GameID        uint                     `json:"gameId"`
+	Date          string                   `json:"date"`
+	IsHome        bool                     `json:"isHome"`
+	Opponent      BasketAPI.TeamInfo       `json:"opponent"`
+	TeamScore     *uint                    `json:"teamScore"`
+	OpponentScore *uint                    `json:"opponentScore"`
+	Stats         libBasketAPI.PlayerStats `json:"stats"`
+}
+
+type PlayerDetails struct {
+	Player
+	RecentGames []PlayerGameLog `json:"recentGames" doc:"stat lines of the most recent finished games, the latest first"`
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
+	"github.com/quible-io/quible-api/lib/models"
+)
+
+type Standing struct {
+	Conference    string    `json:"conference"`
+	Division      *string   `json:"division,omitempty"`
+	Position      int       `json:"position" doc:"position in the conference"`
+	Wins          int       `json:"wins"`
+	Losses        int       `json:"losses"`
+	WinPercentage float64   `json:"winPercentage"`
+	GamesBack     float64   `json:"gamesBack" doc:"games behind the conference leader"`
+	Streak        string    `json:"streak" doc:"current streak, e.g. W3 or L1 (empty if unknown)"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+func standingFromModel(standing *models.Standing) Standing {
+	return Standing{
+		Conference:    standing.Conference,
+		Division:      standing.Division.Ptr(),
+		Position:      standing.Position,
+		Wins:          standing.Wins,
+		Losses:        standing.Losses,
+		WinPercentage: standing.WinPercentage,
+		GamesBack:     standing.GamesBack,
+		Streak:        standing.Streak,
+		UpdatedAt:     standing.UpdatedAt,
+	}
+}
+
+type TeamStanding struct {
+	Team BasketAPI.TeamInfo `json:"team"`
+	Standing
+}
+
+type ConferenceStandings struct {
+	Conference string         `json:"conference"`
+	Teams      []TeamStanding `json:"teams"`
+}
+
+type TeamDetails struct {
+	BasketAPI.TeamInfo
+	Standing      *Standing `json:"standing,omitempty"`
+	RecentGames   []Game    `json:"recentGames" doc:"the most recent finished games, the latest first"`
+	UpcomingGames []Game    `json:"upcomingGames" doc:"the nearest scheduled games"`
+}
@@ -15,11 +15,13 @@ import (
 	v1 "github.com/quible-io/quible-api/app-service/api/v1"
 	"github.com/quible-io/quible-api/app-service/services/BasketAPI"
 	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/env"
 	"github.com/quible-io/quible-api/lib/store"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 type ServiceOptions struct {
@@ -44,6 +46,13 @@ func Server() {
 	if err := ablyService.Setup(); err != nil {
 		log.Fatal().Msgf("unable to setup Ably SDK: %s", err)
 	}
+	// -- Compatibility window of name-based chat resources
+	legacyResourceCutoff, err := v1.LegacyResourceCutoff(context.Background(), boil.GetContextDB())
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	// -- BasketAPI client
+	BasketAPI.Setup()
 	// -- Live data BasketAPI
 	quit, err := BasketAPI.StartLive()
 	if err != nil {
@@ -52,6 +61,38 @@ func Server() {
 	defer func() {
 		quit <- struct{}{}
 	}()
+	// -- Chat rooms for games
+	quitGameRooms, err := BasketAPI.StartGameRooms()
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	defer func() {
+		quitGameRooms <- struct{}{}
+	}()
+	// -- Standings of teams
+	quitStandings, err := BasketAPI.StartStandings()
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	defer func() {
+		quitStandings <- struct{}{}
+	}()
+	// -- Purge of deleted chat records
+	quitPurge, err := chatService.StartPurge()
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	defer func() {
+		quitPurge <- struct{}{}
+	}()
+	// -- Delivery of chat webhooks
+	quitWebhooks, err := chatService.StartWebhookDeliveries()
+	if err != nil {
+		log.Fatal().Err(err).Send()
+	}
+	defer func() {
+		quitWebhooks <- struct{}{}
+	}()
 	// -- Huma CLI
 	cli := huma.NewCLI(func(hooks huma.Hooks, options *ServiceOptions) {
 		gin.SetMode(gin.ReleaseMode)
@@ -60,6 +101,7 @@ func Server() {
 		corsConfig.AllowAllOrigins = true
 		corsConfig.AllowCredentials = true
 		corsConfig.AllowHeaders = append(corsConfig.AllowHeaders, "authorization")
+		corsConfig.ExposeHeaders = append(corsConfig.ExposeHeaders, "Next-Cursor")
 		router.Use(cors.New(corsConfig))
 		// http server based on Gin router
 		port, _ := strconv.Atoi(os.Getenv("PORT"))
@@ -72,7 +114,9 @@ func Server() {
 		}
 		// -- V1
 		srvAPI.Setup(
-			v1.NewServiceAPI(),
+			v1.NewServiceAPI(
+				v1.WithLegacyResourceCutoff(legacyResourceCutoff),
+			),
 			router,
 			libAPI.VersionConfig{
 				Tag:         "v1",
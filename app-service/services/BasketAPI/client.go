@@ -0,0 +1,16 @@
+package BasketAPI
+
+import (
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+var client *libBasketAPI.Client
+
+// Setup initializes BasketAPI client shared by the live feed, game rooms and API handlers
+func Setup(options ...libBasketAPI.Option) {
+	client = libBasketAPI.NewClient(options...)
+}
+
+func GetClient() *libBasketAPI.Client {
+	return client
+}
@@ -4,14 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	"github.com/quible-io/quible-api/lib/models"
 )
 
-const (
-	Host = "basketapi1.p.rapidapi.com"
-)
-
-func GetTeamEnhancer(ctx context.Context) (func(TeamId) TeamInfo, error) {
+func GetTeamEnhancer(ctx context.Context) (func(libBasketAPI.TeamId) TeamInfo, error) {
 	teamsInfo, err := models.TeamInfos().AllG(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to retrieve combined team info (for all teams): %w", err)
@@ -20,10 +17,15 @@ func GetTeamEnhancer(ctx context.Context) (func(TeamId) TeamInfo, error) {
 	for _, teamInfo := range teamsInfo {
 		teamInfoById[teamInfo.ID] = teamInfo
 	}
-	return func(team TeamId) TeamInfo {
-		teamInfo := *teamInfoById[int(team.ID)]
+	return func(team libBasketAPI.TeamId) TeamInfo {
+		teamInfo, ok := teamInfoById[int(team.ID)]
+		// teams not yet crawled are reported by ID only
+		if !ok {
+			return TeamInfo{ID: int(team.ID)}
+		}
 		return TeamInfo{
 			ID:             teamInfo.ID,
+			League:         teamInfo.LeagueID,
 			Name:           teamInfo.Name,
 			Slug:           teamInfo.Slug,
 			ShortName:      teamInfo.ShortName,
@@ -0,0 +1,99 @@
+package BasketAPI
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/quible-io/quible-api/lib/store"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const GAME_ROOMS_INTERVAL = time.Hour
+
+// MAX_GAME_DURATION is the upper bound of time between the game start and its end
+const MAX_GAME_DURATION = 4 * time.Hour
+
+// GameRoomTitle returns title of the chat channel associated with the game
+func GameRoomTitle(teamEnhancer func(libBasketAPI.TeamId) TeamInfo, ev libBasketAPI.Event) string {
+	return fmt.Sprintf("%s @ %s", teamEnhancer(ev.AwayTeam).Abbr, teamEnhancer(ev.HomeTeam).Abbr)
+}
+
+// StartGameRooms periodically provisions chat rooms for games of enabled leagues scheduled around the current date
+func StartGameRooms() (chan<- struct{}, error) {
+	ctx := context.Background()
+	quit := make(chan struct{})
+	ticker := time.NewTicker(GAME_ROOMS_INTERVAL)
+	teamEnhancer, err := GetTeamEnhancer(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize team entity enhancer: %w", err)
+	}
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return nil, errors.New("unable to access DB connection")
+	}
+	// only the leading replica polls BasketAPI for scheduled games
+	election := store.NewLeaderElection(db, GAME_ROOMS_LEADER_LOCK)
+	provision := func() {
+		if election.IsLeader(ctx) {
+			provisionGameRooms(ctx, teamEnhancer)
+		}
+	}
+	go func() {
+		provision()
+		for {
+			select {
+			case <-ticker.C:
+				provision()
+			case <-quit:
+				ticker.Stop()
+				election.Release(ctx)
+				return
+			}
+		}
+	}()
+
+	return quit, nil
+}
+
+// provisionGameRooms creates chat rooms for games of enabled leagues of yesterday/today/tomorrow and archives
+// the rooms of games finished long enough ago
+func provisionGameRooms(ctx context.Context, teamEnhancer func(libBasketAPI.TeamId) TeamInfo) {
+	exec := boil.GetContextDB()
+	leagues, err := libBasketAPI.EnabledLeagues(ctx, exec)
+	if err != nil {
+		log.Error().Err(err).Msg("unable to retrieve leagues for game rooms")
+		return
+	}
+	now := time.Now().UTC()
+	for _, date := range []time.Time{now.AddDate(0, 0, -1), now, now.AddDate(0, 0, 1)} {
+		res, err := client.Matches(ctx, date)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to retrieve matches for game rooms")
+			continue
+		}
+		for _, ev := range res.Events {
+			league := libBasketAPI.LeagueOf(leagues, ev)
+			if league == nil {
+				continue
+			}
+			if _, err := chatService.EnsureGameRoom(ctx, exec, league, ev.ID, GameRoomTitle(teamEnhancer, ev)); err != nil {
+				log.Error().Err(err).Send()
+				continue
+			}
+			// fallback for games which have not been seen finished by the live feed (e.g. service restart)
+			isFinishedLongAgo := ev.Status.Type == libBasketAPI.StatusType_Finished &&
+				time.Since(time.Unix(ev.StartTimestamp, 0)) > MAX_GAME_DURATION+chatService.GAME_ROOM_ARCHIVE_DELAY
+			if isFinishedLongAgo {
+				if err := chatService.ArchiveGameRoom(ctx, exec, ev.ID); err != nil {
+					log.Error().Err(err).Send()
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,11 @@
+package BasketAPI
+
+// keys of Postgres advisory locks electing the single replica which polls BasketAPI (see store.LeaderElection)
+const (
+	// LIVE_LEADER_LOCK is held by the replica polling live data
+	LIVE_LEADER_LOCK int64 = 0x6c697665
+	// GAME_ROOMS_LEADER_LOCK is held by the replica provisioning chat rooms for games
+	GAME_ROOMS_LEADER_LOCK int64 = 0x726f6f6d
+	// STANDINGS_LEADER_LOCK is held by the replica refreshing standings of enabled leagues
+	STANDINGS_LEADER_LOCK int64 = 0x7374616e
+)
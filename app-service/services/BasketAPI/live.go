@@ -2,30 +2,88 @@ package BasketAPI
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
-	"net/http"
 	"os"
-	"slices"
 	"time"
 
 	"github.com/quible-io/quible-api/app-service/services/ablyService"
+	"github.com/quible-io/quible-api/app-service/services/chatService"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	"github.com/quible-io/quible-api/lib/email"
 	"github.com/quible-io/quible-api/lib/email/postmark"
-	"github.com/quible-io/quible-api/lib/misc"
+	"github.com/quible-io/quible-api/lib/store"
 	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 const ERRORS_IN_A_ROW_TO_SET_ALERT = 10
 const OK_IN_A_ROW_TO_CLEAR_ALERT = 10
 
+// BOX_SCORE_INTERVAL is the default minimal interval between box scores of a game in progress, they are stored
+// only when the live state of the game changes (configured by ENV_LIVE_BOX_SCORE_INTERVAL)
+const BOX_SCORE_INTERVAL = time.Minute
+
+// LEAGUES_INTERVAL is how often leagues covered by the live feed are refreshed
+const LEAGUES_INTERVAL = 5 * time.Minute
+
+// intervalFromEnv reads the interval (Go duration, e.g. "90s") from the variable, `fallback` is used when it is unset
+// or invalid
+func intervalFromEnv(name string, fallback time.Duration) time.Duration {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback
+	}
+	interval, err := time.ParseDuration(value)
+	if err != nil || interval <= 0 {
+		log.Error().Msgf("invalid %s %q, %s is used", name, value, fallback)
+		return fallback
+	}
+	return interval
+}
+
+// boxScoreSync is the time and the live state of the game its box score has been stored at
+type boxScoreSync struct {
+	at    time.Time
+	state string
+}
+
+// due reports if the box score of the game in `state` is to be stored, i.e. the state has changed since the box
+// score was stored and `interval` has passed (the box score of the game seen for the first time is always due)
+func (last boxScoreSync) due(state string, now time.Time, interval time.Duration) bool {
+	return last.at.IsZero() || (last.state != state && now.Sub(last.at) >= interval)
+}
+
 func StartLive() (chan<- struct{}, error) {
 	ctx := context.Background()
 	quit := make(chan struct{})
-	ticker := time.NewTicker(2 * time.Second)
+	timer := time.NewTimer(0)
 	countError := uint(0)
 	countOK := uint(0)
 	isInError := false
-	states := map[uint]string{}
+	// state of live games published so far (restored when the replica becomes the leader)
+	live := newLiveStates()
+	isLeader := false
+	// polling intervals of box scores and play-by-play of games in progress (RapidAPI calls are billed)
+	boxScoreInterval := intervalFromEnv("ENV_LIVE_BOX_SCORE_INTERVAL", BOX_SCORE_INTERVAL)
+	incidentsInterval := intervalFromEnv("ENV_LIVE_INCIDENTS_INTERVAL", INCIDENTS_INTERVAL)
+	// time and state of the game its box score has been stored at for the last time
+	boxScores := map[uint]boxScoreSync{}
+	// play-by-play events of live games published so far
+	timelines := newTimelineTracker(incidentsInterval)
+	// enabled leagues covered by the live feed
+	var leagues []libBasketAPI.League
+	var leaguesAt time.Time
+	// result of the last poll driving the schedule of the next one
+	status := LiveStatus{Mode: LiveMode_Follower}
+	inProgress := false
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return nil, errors.New("unable to access DB connection")
+	}
+	// only the leading replica polls BasketAPI and publishes live data
+	election := store.NewLeaderElection(db, LIVE_LEADER_LOCK)
 	teamEnhancer, err := GetTeamEnhancer(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize team entity enhancer: %w", err)
@@ -34,95 +92,247 @@ func StartLive() (chan<- struct{}, error) {
 	emailSender := postmark.NewClient()
 	// ably
 	ablyRealTime := ablyService.GetAbly()
-	ablyChannel := ablyRealTime.Channels.Get("live:main")
-	go func() {
-		for {
-			select {
-			case <-ticker.C:
-				url := fmt.Sprintf("https://%s/api/basketball/matches/live", Host)
-				res, err := misc.GetOne[LM_Data]{
-					Client: *http.DefaultClient,
-					URL:    url,
-					UpdateRequest: func(req *http.Request) {
-						req.Header.Set("X-RapidAPI-Key", os.Getenv("ENV_RAPIDAPI_KEY"))
-						req.Header.Set("X-RapidAPI-Host", Host)
-					},
-					ExpectedStatus: http.StatusOK,
-				}.Do()
-				if err != nil {
-					countError++
-					countOK = 0
-					log.Info().Msgf("BasketAPI error: %s", err)
-					if !isInError && countError > ERRORS_IN_A_ROW_TO_SET_ALERT {
-						isInError = true
-						if err := emailSender.SendEmail(ctx, email.EmailPayload{
-							From:    "api@quible.io",
-							To:      "devops@quible.io",
-							Subject: "BasketAPI failure",
-							TextBody: fmt.Sprintf(
-								"At least %d API errors happened in a row\nThe last error reads %q",
-								ERRORS_IN_A_ROW_TO_SET_ALERT,
-								err,
-							),
-						}); err != nil {
-							log.Info().Msgf("unable to send BasketAPI error report: %s", err)
-						}
+	ablyChannel := ablyRealTime.Channels.Get(LIVE_MAIN_CHANNEL)
+	poll := func() {
+		// -- follow the leader election
+		if !election.IsLeader(ctx) {
+			if isLeader {
+				log.Info().Msg("live poller leadership is lost")
+				isLeader = false
+			}
+			return
+		}
+		if !isLeader {
+			restored, err := loadLiveStates(ctx, db)
+			if err != nil {
+				log.Error().Err(err).Send()
+				election.Release(ctx)
+				return
+			}
+			log.Info().Msgf("live poller leadership is taken over with %d games", len(restored.states))
+			live = restored
+			boxScores = map[uint]boxScoreSync{}
+			timelines = newTimelineTracker(incidentsInterval)
+			isLeader = true
+		}
+		if time.Since(leaguesAt) >= LEAGUES_INTERVAL {
+			if enabledLeagues, err := libBasketAPI.EnabledLeagues(ctx, db); err != nil {
+				log.Error().Err(err).Send()
+			} else {
+				leagues, leaguesAt = enabledLeagues, time.Now()
+			}
+		}
+		now := time.Now()
+		status.LastPollAt = &now
+		res, err := client.LiveMatches(ctx)
+		if err != nil {
+			countError++
+			countOK = 0
+			log.Info().Msgf("BasketAPI error: %s", err)
+			if !isInError && countError > ERRORS_IN_A_ROW_TO_SET_ALERT {
+				isInError = true
+				if err := emailSender.SendEmail(ctx, email.EmailPayload{
+					From:    "api@quible.io",
+					To:      "devops@quible.io",
+					Subject: "BasketAPI failure",
+					TextBody: fmt.Sprintf(
+						"At least %d API errors happened in a row\nThe last error reads %q",
+						ERRORS_IN_A_ROW_TO_SET_ALERT,
+						err,
+					),
+				}); err != nil {
+					log.Info().Msgf("unable to send BasketAPI error report: %s", err)
+				}
+			}
+			return
+		} else {
+			countOK++
+			countError = 0
+			if isInError && countOK > OK_IN_A_ROW_TO_CLEAR_ALERT {
+				isInError = false
+			}
+		}
+		// -- process and publish to clients
+		var liveMessage LiveMessage
+		var timelineMessages []TimelineMessage
+		var gameChanges []GameChange
+		inProgress = false
+		for _, ev := range res.Events {
+			if league := libBasketAPI.LeagueOf(leagues, ev); league != nil {
+				liveMessage.IDs = append(liveMessage.IDs, ev.ID)
+				isFinished := ev.Status.Type == libBasketAPI.StatusType_Finished
+				inProgress = inProgress || !isFinished
+				_, wasFinished := live.finishedAt[ev.ID]
+				// play-by-play is polled once more when the game gets finished
+				if !wasFinished {
+					events, err := timelines.poll(ctx, ev.ID, isFinished)
+					if err != nil {
+						log.Error().Err(err).Msg("unable to retrieve play-by-play")
+					} else if len(events) > 0 {
+						timelineMessages = append(timelineMessages, TimelineMessage{GameID: ev.ID, Events: events})
 					}
-					continue
-				} else {
-					countOK++
-					countError = 0
-					if isInError && countOK > OK_IN_A_ROW_TO_CLEAR_ALERT {
-						isInError = false
+				}
+				state := fmt.Sprintf("%d:%d@%s+%d", *ev.HomeScore.Current, *ev.AwayScore.Current, ev.Status.Description, *ev.Time.Played)
+				if !wasFinished && isFinished {
+					live.finishedAt[ev.ID] = time.Now()
+					// the final box score is stored once
+					if _, err := client.SyncGame(ctx, db, ev.ID); err != nil {
+						log.Error().Err(err).Msg("unable to store final box score")
 					}
+					boxScores[ev.ID] = boxScoreSync{at: time.Now(), state: state}
 				}
-				// -- process and publish to clients
-				var liveMessage LiveMessage
-				tournaments := []string{"NBA"}
-				for _, ev := range res.Events {
-					if slices.Index(tournaments, ev.Tournament.Name) != -1 {
-						liveMessage.IDs = append(liveMessage.IDs, ev.ID)
-						state := fmt.Sprintf("%d:%d@%s+%d", *ev.HomeScore.Current, *ev.AwayScore.Current, ev.Status.Description, *ev.Time.Played)
-						value, ok := states[ev.ID]
-						if ok && value == state {
-							continue
-						}
-						liveEvent := LiveEvent{
-							ID:             ev.ID,
-							Status:         ev.Status,
-							HomeTeam:       teamEnhancer(ev.HomeTeam),
-							AwayTeam:       teamEnhancer(ev.AwayTeam),
-							HomeScore:      ev.HomeScore,
-							AwayScore:      ev.AwayScore,
-							Time:           ev.Time,
-							StartTimestamp: ev.StartTimestamp,
-						}
-						liveMessage.Events = append(liveMessage.Events, liveEvent)
-						states[ev.ID] = state
-						log.Info().Msgf(
-							"[%d %s %s %d] %s (%d) vs. %s (%d)",
-							ev.ID,
-							ev.Status.Description,
-							ev.Status.Type,
-							ev.Status.Code,
-							liveEvent.AwayTeam.Abbr,
-							*liveEvent.AwayScore.Current,
-							liveEvent.HomeTeam.Abbr,
-							*liveEvent.HomeScore.Current,
-						)
+				// box scores of games in progress change only along with their live state
+				if !isFinished && boxScores[ev.ID].due(state, time.Now(), boxScoreInterval) {
+					if _, err := client.SyncGame(ctx, db, ev.ID); err != nil {
+						log.Error().Err(err).Msg("unable to store box score")
 					}
+					boxScores[ev.ID] = boxScoreSync{at: time.Now(), state: state}
 				}
-				if len(liveMessage.IDs) == 0 && len(states) > 0 {
-					log.Info().Msg("no events in qualified tournaments...")
-					states = map[uint]string{}
+				value, ok := live.states[ev.ID]
+				if ok && value == state {
+					continue
 				}
-				if len(liveMessage.Events) > 0 {
-					if err := ablyChannel.Publish(ctx, "message", liveMessage); err != nil {
-						log.Error().Err(err).Msg("unable to publish live data to Ably")
-					}
+				// the previous state of the game seen for the first time is the stored one (if any)
+				var prev *libBasketAPI.Event
+				if lastEvent, ok := live.events[ev.ID]; ok {
+					prev = &lastEvent
+				} else if storedGame, err := libBasketAPI.LoadGame(ctx, db, ev.ID); err != nil {
+					log.Error().Err(err).Send()
+				} else if storedGame != nil {
+					prev = &storedGame.Event
+				}
+				gameChanges = append(gameChanges, DiffLiveEvents(prev, ev)...)
+				live.events[ev.ID] = ev
+				if _, err := libBasketAPI.StoreGame(ctx, db, ev); err != nil {
+					log.Error().Err(err).Send()
 				}
+				liveEvent := LiveEvent{
+					ID:             ev.ID,
+					League:         league.ID,
+					Status:         ev.Status,
+					HomeTeam:       teamEnhancer(ev.HomeTeam),
+					AwayTeam:       teamEnhancer(ev.AwayTeam),
+					HomeScore:      ev.HomeScore,
+					AwayScore:      ev.AwayScore,
+					Time:           ev.Time,
+					StartTimestamp: ev.StartTimestamp,
+				}
+				liveMessage.Events = append(liveMessage.Events, liveEvent)
+				live.states[ev.ID] = state
+				if err := live.store(ctx, db, ev.ID); err != nil {
+					log.Error().Err(err).Send()
+				}
+				log.Info().Msgf(
+					"[%d %s %s %d] %s (%d) vs. %s (%d)",
+					ev.ID,
+					ev.Status.Description,
+					ev.Status.Type,
+					ev.Status.Code,
+					liveEvent.AwayTeam.Abbr,
+					*liveEvent.AwayScore.Current,
+					liveEvent.HomeTeam.Abbr,
+					*liveEvent.HomeScore.Current,
+				)
+			}
+		}
+		if len(liveMessage.IDs) == 0 && len(live.states) > 0 {
+			log.Info().Msg("no events in enabled leagues...")
+			if err := live.reset(ctx, db); err != nil {
+				log.Error().Err(err).Send()
+			}
+			timelines = newTimelineTracker(incidentsInterval)
+		}
+		status.LiveGames = len(liveMessage.IDs)
+		// -- the next tip-off matters once no games are in progress (games running late are waited for)
+		status.NextGameAt = nil
+		if !inProgress {
+			// the poller does not fall asleep while the schedule is unknown (e.g. the crawler has not stored it yet)
+			scheduleKnown, err := libBasketAPI.ScheduleKnown(ctx, db, leagues)
+			if err != nil {
+				log.Error().Err(err).Send()
+			}
+			status.ScheduleKnown = scheduleKnown
+			if scheduleKnown {
+				nextGameAt, err := libBasketAPI.NextGameStart(ctx, db, libBasketAPI.TournamentIDs(leagues), now.Add(-LATE_START_WINDOW))
+				if err != nil {
+					log.Error().Err(err).Send()
+					status.ScheduleKnown = false
+				}
+				status.NextGameAt = nextGameAt
+			}
+		}
+		// -- archive chat rooms of games finished long enough ago
+		for gameId, t := range live.finishedAt {
+			if time.Since(t) < chatService.GAME_ROOM_ARCHIVE_DELAY {
+				continue
+			}
+			if err := chatService.ArchiveGameRoom(ctx, boil.GetContextDB(), gameId); err != nil {
+				log.Error().Err(err).Send()
+				continue
+			}
+			if err := live.forget(ctx, db, gameId); err != nil {
+				log.Error().Err(err).Send()
+			}
+			delete(boxScores, gameId)
+			timelines.forget(gameId)
+		}
+		if len(liveMessage.Events) > 0 {
+			if err := ablyChannel.Publish(ctx, "message", liveMessage); err != nil {
+				log.Error().Err(err).Msg("unable to publish live data to Ably")
+			}
+		}
+		for _, timelineMessage := range timelineMessages {
+			if err := ablyChannel.Publish(ctx, "timeline", timelineMessage); err != nil {
+				log.Error().Err(err).Msg("unable to publish play-by-play to Ably")
+			}
+			gameChannel := ablyRealTime.Channels.Get(LiveGameChannel(timelineMessage.GameID))
+			if err := gameChannel.Publish(ctx, "timeline", timelineMessage); err != nil {
+				log.Error().Err(err).Msg("unable to publish play-by-play to Ably")
+			}
+		}
+		// -- fine-grained changes go to channels of their games
+		for _, gameChange := range gameChanges {
+			gameChannel := ablyRealTime.Channels.Get(LiveGameChannel(gameChange.GameID))
+			if err := gameChannel.Publish(ctx, string(gameChange.Type), gameChange); err != nil {
+				log.Error().Err(err).Msg("unable to publish game change to Ably")
+			}
+		}
+	}
+	// schedule decides the delay of the next poll (and reports the status of the poller)
+	schedule := func() time.Duration {
+		now := time.Now()
+		mode, delay := LiveMode_Follower, LIVE_INTERVAL
+		if isLeader {
+			mode, delay = nextLivePoll(now, inProgress, countError, status.ScheduleKnown, status.NextGameAt)
+			// chat rooms of finished games are archived in time even if the poller sleeps
+			for _, t := range live.finishedAt {
+				delay = min(delay, max(time.Until(t.Add(chatService.GAME_ROOM_ARCHIVE_DELAY)), LIVE_INTERVAL))
+			}
+		}
+		status.Mode = mode
+		status.IsLeader = isLeader
+		status.NextPollAt = now.Add(delay)
+		status.ErrorsInRow = countError
+		status.Quota = client.Quota()
+		status.UpdatedAt = now
+		setLiveStatus(status)
+		// the leader reports its status to all replicas
+		if isLeader {
+			if err := storeLiveStatus(ctx, db, status); err != nil {
+				log.Error().Err(err).Send()
+			}
+		}
+		return delay
+	}
+	go func() {
+		for {
+			select {
+			case <-timer.C:
+				poll()
+				timer.Reset(schedule())
 			case <-quit:
-				ticker.Stop()
+				timer.Stop()
+				election.Release(ctx)
 				return
 			}
 		}
@@ -0,0 +1,93 @@
+package BasketAPI
+
+import (
+	"fmt"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+// LIVE_MAIN_CHANNEL carries snapshots of all live games
+const LIVE_MAIN_CHANNEL = "live:main"
+
+// LiveGameChannel returns the name of the channel carrying changes of the game
+func LiveGameChannel(gameId uint) string {
+	return fmt.Sprintf("live:game:%d", gameId)
+}
+
+type GameChangeType string
+
+const (
+	GameChange_Start  GameChangeType = "game_start"
+	GameChange_Score  GameChangeType = "score_change"
+	GameChange_Period GameChangeType = "period_change"
+	GameChange_Status GameChangeType = "status_change"
+	GameChange_End    GameChangeType = "game_end"
+)
+
+// GameChange is the change of the live game published to its channel (Ably message name is the type of change)
+type GameChange struct {
+	Type      GameChangeType      `json:"type"`
+	GameID    uint                `json:"gameId"`
+	Status    libBasketAPI.Status `json:"status"`
+	HomeScore *uint               `json:"homeScore,omitempty"`
+	AwayScore *uint               `json:"awayScore,omitempty"`
+	// points scored since the previous score change
+	HomePoints int `json:"homePoints,omitempty"`
+	AwayPoints int `json:"awayPoints,omitempty"`
+	// status of the game before a period or status change
+	PreviousStatus *libBasketAPI.Status `json:"previousStatus,omitempty"`
+	Time           libBasketAPI.Time    `json:"time"`
+}
+
+func scoreValue(score libBasketAPI.Score) int {
+	if score.Current == nil {
+		return 0
+	}
+	return int(*score.Current)
+}
+
+// DiffLiveEvents reports changes of the game between its previous state (nil if unknown) and the current one.
+// Without the previous state nothing can be diffed, the current state is reported as a single status change
+func DiffLiveEvents(prev *libBasketAPI.Event, next libBasketAPI.Event) []GameChange {
+	change := func(changeType GameChangeType) GameChange {
+		return GameChange{
+			Type:      changeType,
+			GameID:    next.ID,
+			Status:    next.Status,
+			HomeScore: next.HomeScore.Current,
+			AwayScore: next.AwayScore.Current,
+			Time:      next.Time,
+		}
+	}
+	if prev == nil {
+		return []GameChange{change(GameChange_Status)}
+	}
+	changes := []GameChange{}
+	// -- status
+	switch {
+	case prev.Status.Type == next.Status.Type:
+		if next.Status.Type == libBasketAPI.StatusType_Inprogress && prev.Status.Code != next.Status.Code {
+			periodChange := change(GameChange_Period)
+			periodChange.PreviousStatus = &prev.Status
+			changes = append(changes, periodChange)
+		}
+	case prev.Status.Type == libBasketAPI.StatusType_Notstarted && next.Status.Type == libBasketAPI.StatusType_Inprogress:
+		changes = append(changes, change(GameChange_Start))
+	case next.Status.Type == libBasketAPI.StatusType_Finished:
+		changes = append(changes, change(GameChange_End))
+	default:
+		statusChange := change(GameChange_Status)
+		statusChange.PreviousStatus = &prev.Status
+		changes = append(changes, statusChange)
+	}
+	// -- score
+	homePoints := scoreValue(next.HomeScore) - scoreValue(prev.HomeScore)
+	awayPoints := scoreValue(next.AwayScore) - scoreValue(prev.AwayScore)
+	if homePoints != 0 || awayPoints != 0 {
+		scoreChange := change(GameChange_Score)
+		scoreChange.HomePoints = homePoints
+		scoreChange.AwayPoints = awayPoints
+		changes = append(changes, scoreChange)
+	}
+	return changes
+}
@@ -0,0 +1,55 @@
+package BasketAPI
+
+import (
+	"testing"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/stretchr/testify/assert"
+)
+
+func liveEvent(statusType libBasketAPI.StatusType, code uint, home uint, away uint) libBasketAPI.Event {
+	return libBasketAPI.Event{
+		ID:        1,
+		Status:    libBasketAPI.Status{Code: code, Type: statusType},
+		HomeScore: libBasketAPI.Score{Current: &home},
+		AwayScore: libBasketAPI.Score{Current: &away},
+	}
+}
+
+func changeTypes(changes []GameChange) []GameChangeType {
+	types := []GameChangeType{}
+	for _, change := range changes {
+		types = append(types, change.Type)
+	}
+	return types
+}
+
+func TestDiffLiveEvents(t *testing.T) {
+	notStarted := liveEvent(libBasketAPI.StatusType_Notstarted, 0, 0, 0)
+	firstQuarter := liveEvent(libBasketAPI.StatusType_Inprogress, 13, 2, 0)
+	secondQuarter := liveEvent(libBasketAPI.StatusType_Inprogress, 14, 30, 27)
+	finished := liveEvent(libBasketAPI.StatusType_Finished, 100, 101, 99)
+
+	changes := DiffLiveEvents(&notStarted, firstQuarter)
+	assert.Equal(t, []GameChangeType{GameChange_Start, GameChange_Score}, changeTypes(changes))
+	assert.Equal(t, 2, changes[1].HomePoints)
+	assert.Equal(t, 0, changes[1].AwayPoints)
+
+	changes = DiffLiveEvents(&firstQuarter, secondQuarter)
+	assert.Equal(t, []GameChangeType{GameChange_Period, GameChange_Score}, changeTypes(changes))
+	assert.Equal(t, uint(13), changes[0].PreviousStatus.Code)
+
+	assert.Equal(t, []GameChangeType{GameChange_End, GameChange_Score}, changeTypes(DiffLiveEvents(&secondQuarter, finished)))
+	assert.Empty(t, DiffLiveEvents(&finished, finished))
+
+	// unknown previous state of the game (e.g. not stored by the crawler), the current state is reported as is
+	changes = DiffLiveEvents(nil, secondQuarter)
+	assert.Equal(t, []GameChangeType{GameChange_Status}, changeTypes(changes))
+	assert.Equal(t, uint(30), *changes[0].HomeScore)
+	assert.Nil(t, changes[0].PreviousStatus)
+	assert.Zero(t, changes[0].HomePoints)
+	assert.Equal(t, []GameChangeType{GameChange_Status}, changeTypes(DiffLiveEvents(nil, finished)))
+
+	postponed := liveEvent(libBasketAPI.StatusType_TypePostponed, 60, 0, 0)
+	assert.Equal(t, []GameChangeType{GameChange_Status}, changeTypes(DiffLiveEvents(&notStarted, postponed)))
+}
@@ -0,0 +1,145 @@
+package BasketAPI
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type LiveMode string
+
+const (
+	// games are in progress
+	LiveMode_Live LiveMode = "live"
+	// tip-off of the next game is near (or overdue), or the schedule is unknown
+	LiveMode_Pregame LiveMode = "pregame"
+	// no games are near, the poller sleeps until shortly before the next tip-off
+	LiveMode_Idle LiveMode = "idle"
+	// BasketAPI errors happened in a row
+	LiveMode_Backoff LiveMode = "backoff"
+	// another replica is the leader
+	LiveMode_Follower LiveMode = "follower"
+)
+
+const (
+	// LIVE_INTERVAL is how often live data is polled while games are in progress (and how often followers
+	// check the leader is still there)
+	LIVE_INTERVAL = 2 * time.Second
+	// PREGAME_INTERVAL is how often live data is polled within PREGAME_WINDOW before the next tip-off
+	PREGAME_INTERVAL = 10 * time.Second
+	PREGAME_WINDOW   = 15 * time.Minute
+	// LATE_START_WINDOW is how long games not started past their scheduled time are still waited for
+	LATE_START_WINDOW = 3 * time.Hour
+	// IDLE_MAX_SLEEP caps sleeping of the idle poller (the schedule may change meanwhile)
+	IDLE_MAX_SLEEP = time.Hour
+	// BACKOFF_MAX caps the delay of polling after BasketAPI errors (the delay is doubled per error)
+	BACKOFF_MAX = 5 * time.Minute
+	// LIVE_STATUS_ID is the ID of the (single) record of the status reported by the leader
+	LIVE_STATUS_ID = 1
+)
+
+// nextLivePoll decides the mode of the poller and the delay of the next poll from the result of the last one:
+// whether games are in progress, the number of errors in a row, whether the schedule is known and the start of
+// the next game (if any)
+func nextLivePoll(now time.Time, inProgress bool, errorsInRow uint, scheduleKnown bool, nextGameAt *time.Time) (LiveMode, time.Duration) {
+	if errorsInRow > 0 {
+		delay := LIVE_INTERVAL
+		for i := uint(1); i < errorsInRow && delay < BACKOFF_MAX; i++ {
+			delay *= 2
+		}
+		return LiveMode_Backoff, min(delay, BACKOFF_MAX)
+	}
+	if inProgress {
+		return LiveMode_Live, LIVE_INTERVAL
+	}
+	// games may start any time unless the schedule is known
+	if !scheduleKnown {
+		return LiveMode_Pregame, PREGAME_INTERVAL
+	}
+	if nextGameAt == nil {
+		return LiveMode_Idle, IDLE_MAX_SLEEP
+	}
+	untilPregame := nextGameAt.Sub(now) - PREGAME_WINDOW
+	if untilPregame <= 0 {
+		return LiveMode_Pregame, PREGAME_INTERVAL
+	}
+	return LiveMode_Idle, min(untilPregame, IDLE_MAX_SLEEP)
+}
+
+// LiveStatus is the state of the live poller, the leader persists it so every replica reports it
+type LiveStatus struct {
+	Mode          LiveMode                `json:"mode" enum:"live,pregame,idle,backoff,follower"`
+	IsLeader      bool                    `json:"isLeader" doc:"the status is reported by the leader (which polls BasketAPI)"`
+	LastPollAt    *time.Time              `json:"lastPollAt,omitempty" doc:"the last poll of BasketAPI live data by the leader"`
+	NextPollAt    time.Time               `json:"nextPollAt" doc:"the next wake-up of the poller"`
+	NextGameAt    *time.Time              `json:"nextGameAt,omitempty" doc:"scheduled start of the next game"`
+	ScheduleKnown bool                    `json:"scheduleKnown" doc:"the season schedule of every enabled league is stored"`
+	LiveGames     int                     `json:"liveGames" doc:"number of games reported live by the last poll"`
+	ErrorsInRow   uint                    `json:"errorsInRow"`
+	Quota         libBasketAPI.QuotaStats `json:"quota" doc:"requests made to BasketAPI by the leader"`
+	UpdatedAt     time.Time               `json:"updatedAt" doc:"when the status was reported"`
+}
+
+var liveStatus = struct {
+	sync.RWMutex
+	status LiveStatus
+}{
+	status: LiveStatus{Mode: LiveMode_Follower},
+}
+
+func setLiveStatus(status LiveStatus) {
+	liveStatus.Lock()
+	defer liveStatus.Unlock()
+	liveStatus.status = status
+}
+
+// storeLiveStatus persists the status reported by the leader
+func storeLiveStatus(ctx context.Context, exec boil.ContextExecutor, status LiveStatus) error {
+	data, err := json.Marshal(status)
+	if err != nil {
+		return fmt.Errorf("unable to serialize live status: %w", err)
+	}
+	record := models.LiveStatus{
+		ID:        LIVE_STATUS_ID,
+		Status:    data,
+		UpdatedAt: status.UpdatedAt,
+	}
+	if err := record.Upsert(
+		ctx,
+		exec,
+		true,
+		[]string{models.LiveStatusColumns.ID},
+		boil.Whitelist(models.LiveStatusColumns.Status, models.LiveStatusColumns.UpdatedAt),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("unable to store live status: %w", err)
+	}
+	return nil
+}
+
+// GetLiveStatus reports the status of the live poller persisted by the leader, the status of this replica is
+// reported until any leader has reported its status
+func GetLiveStatus(ctx context.Context, exec boil.ContextExecutor) (LiveStatus, error) {
+	record, err := models.FindLiveStatus(ctx, exec, LIVE_STATUS_ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		liveStatus.RLock()
+		defer liveStatus.RUnlock()
+		return liveStatus.status, nil
+	}
+	if err != nil {
+		return LiveStatus{}, fmt.Errorf("unable to retrieve live status: %w", err)
+	}
+	var status LiveStatus
+	if err := record.Status.Unmarshal(&status); err != nil {
+		return LiveStatus{}, fmt.Errorf("unable to parse live status: %w", err)
+	}
+	return status, nil
+}
@@ -0,0 +1,42 @@
+package BasketAPI
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextLivePoll(t *testing.T) {
+	now := time.Date(2024, time.March, 25, 18, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := now.Add(d)
+		return &ts
+	}
+	type expected struct {
+		mode  LiveMode
+		delay time.Duration
+	}
+	poll := func(inProgress bool, errorsInRow uint, nextGameAt *time.Time) expected {
+		mode, delay := nextLivePoll(now, inProgress, errorsInRow, true, nextGameAt)
+		return expected{mode, delay}
+	}
+
+	assert.Equal(t, expected{LiveMode_Live, LIVE_INTERVAL}, poll(true, 0, at(time.Hour)))
+	// sleeping until shortly before the next tip-off
+	assert.Equal(t, expected{LiveMode_Idle, 30 * time.Minute}, poll(false, 0, at(45*time.Minute)))
+	assert.Equal(t, expected{LiveMode_Idle, IDLE_MAX_SLEEP}, poll(false, 0, at(10*time.Hour)))
+	assert.Equal(t, expected{LiveMode_Idle, IDLE_MAX_SLEEP}, poll(false, 0, nil))
+	// the schedule is unknown (e.g. the crawler has not stored it yet)
+	mode, delay := nextLivePoll(now, false, 0, false, nil)
+	assert.Equal(t, expected{LiveMode_Pregame, PREGAME_INTERVAL}, expected{mode, delay})
+	mode, delay = nextLivePoll(now, true, 0, false, nil)
+	assert.Equal(t, expected{LiveMode_Live, LIVE_INTERVAL}, expected{mode, delay})
+	assert.Equal(t, expected{LiveMode_Pregame, PREGAME_INTERVAL}, poll(false, 0, at(10*time.Minute)))
+	// the game running late
+	assert.Equal(t, expected{LiveMode_Pregame, PREGAME_INTERVAL}, poll(false, 0, at(-10*time.Minute)))
+	// errors back off regardless of games
+	assert.Equal(t, expected{LiveMode_Backoff, LIVE_INTERVAL}, poll(true, 1, nil))
+	assert.Equal(t, expected{LiveMode_Backoff, 4 * LIVE_INTERVAL}, poll(true, 3, nil))
+	assert.Equal(t, expected{LiveMode_Backoff, BACKOFF_MAX}, poll(false, 100, nil))
+}
@@ -0,0 +1,107 @@
+package BasketAPI
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// liveStates is the state of live games published so far, it's persisted so the next leader
+// does not republish games unchanged since
+type liveStates struct {
+	// the published state of the game (score, status and time)
+	states map[uint]string
+	// the latest event of the game (to report changes to per-game channels)
+	events map[uint]libBasketAPI.Event
+	// time when the game has been seen finished for the first time (to archive its chat room later)
+	finishedAt map[uint]time.Time
+}
+
+func newLiveStates() *liveStates {
+	return &liveStates{
+		states:     map[uint]string{},
+		events:     map[uint]libBasketAPI.Event{},
+		finishedAt: map[uint]time.Time{},
+	}
+}
+
+// loadLiveStates restores the state of live games published by the previous leader
+func loadLiveStates(ctx context.Context, exec boil.ContextExecutor) (*liveStates, error) {
+	records, err := models.LiveGameStates().All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve live game states: %w", err)
+	}
+	live := newLiveStates()
+	for _, record := range records {
+		gameId := uint(record.GameID)
+		var ev libBasketAPI.Event
+		if err := record.Event.Unmarshal(&ev); err != nil {
+			return nil, fmt.Errorf("unable to parse live game state %d: %w", record.GameID, err)
+		}
+		live.states[gameId] = record.State
+		live.events[gameId] = ev
+		if record.FinishedAt.Valid {
+			live.finishedAt[gameId] = record.FinishedAt.Time
+		}
+	}
+	return live, nil
+}
+
+// store persists the published state of the game
+func (l *liveStates) store(ctx context.Context, exec boil.ContextExecutor, gameId uint) error {
+	event, err := json.Marshal(l.events[gameId])
+	if err != nil {
+		return fmt.Errorf("unable to serialize live game state %d: %w", gameId, err)
+	}
+	record := models.LiveGameState{
+		GameID: int(gameId),
+		State:  l.states[gameId],
+		Event:  event,
+	}
+	if t, ok := l.finishedAt[gameId]; ok {
+		record.FinishedAt = null.TimeFrom(t)
+	}
+	if err := record.Upsert(
+		ctx,
+		exec,
+		true,
+		[]string{models.LiveGameStateColumns.GameID},
+		boil.Whitelist(
+			models.LiveGameStateColumns.State,
+			models.LiveGameStateColumns.Event,
+			models.LiveGameStateColumns.FinishedAt,
+			models.LiveGameStateColumns.UpdatedAt,
+		),
+		boil.Infer(),
+	); err != nil {
+		return fmt.Errorf("unable to store live game state %d: %w", gameId, err)
+	}
+	return nil
+}
+
+// forget drops the state of the game (the game is over for good)
+func (l *liveStates) forget(ctx context.Context, exec boil.ContextExecutor, gameId uint) error {
+	delete(l.states, gameId)
+	delete(l.events, gameId)
+	delete(l.finishedAt, gameId)
+	if _, err := models.LiveGameStates(models.LiveGameStateWhere.GameID.EQ(int(gameId))).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("unable to delete live game state %d: %w", gameId, err)
+	}
+	return nil
+}
+
+// reset drops the state of all games (no games are live)
+func (l *liveStates) reset(ctx context.Context, exec boil.ContextExecutor) error {
+	l.states = map[uint]string{}
+	l.events = map[uint]libBasketAPI.Event{}
+	if _, err := models.LiveGameStates(models.LiveGameStateWhere.FinishedAt.IsNull()).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("unable to delete live game states: %w", err)
+	}
+	return nil
+}
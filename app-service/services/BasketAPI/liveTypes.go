@@ -1,23 +1,26 @@
 package BasketAPI
 
+import libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+
 type LiveMessage struct {
 	IDs    []uint      `json:"eventIDs"`
 	Events []LiveEvent `json:"events"`
 }
 
 type LiveEvent struct {
-	ID             uint     `json:"id"`
-	Status         Status   `json:"status"`
-	HomeTeam       TeamInfo `json:"homeTeam"`
-	AwayTeam       TeamInfo `json:"awayTeam"`
-	HomeScore      Score    `json:"homeScore"`
-	AwayScore      Score    `json:"awayScore"`
-	Time           Time     `json:"time"`
-	StartTimestamp int64    `json:"startTimestamp"`
+	ID             uint                `json:"id"`
+	League         string              `json:"league"`
+	Status         libBasketAPI.Status `json:"status"`
+	HomeTeam       TeamInfo            `json:"homeTeam"`
+	AwayTeam       TeamInfo            `json:"awayTeam"`
+	HomeScore      libBasketAPI.Score  `json:"homeScore"`
+	AwayScore      libBasketAPI.Score  `json:"awayScore"`
+	Time           libBasketAPI.Time   `json:"time"`
+	StartTimestamp int64               `json:"startTimestamp"`
 }
 
-// -- LiveMatches (LM) API
-
-type LM_Data struct {
-	Events []Event `json:"events"`
+// TimelineMessage carries new play-by-play events of the game (published as "timeline" messages)
+type TimelineMessage struct {
+	GameID uint                         `json:"gameId"`
+	Events []libBasketAPI.TimelineEvent `json:"events"`
 }
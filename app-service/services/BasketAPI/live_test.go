@@ -0,0 +1,33 @@
+package BasketAPI
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoxScoreDue(t *testing.T) {
+	now := time.Date(2024, time.March, 25, 18, 0, 0, 0, time.UTC)
+	last := boxScoreSync{at: now.Add(-2 * time.Minute), state: "10:8@1st quarter+180"}
+
+	// the game seen for the first time (e.g. after restart)
+	assert.True(t, boxScoreSync{}.due("10:8@1st quarter+180", now, time.Minute))
+	// the state has changed since the last box score
+	assert.True(t, last.due("12:8@1st quarter+200", now, time.Minute))
+	// the state is the same (e.g. timeout), nothing new in the box score
+	assert.False(t, last.due("10:8@1st quarter+180", now, time.Minute))
+	// the state has changed too recently
+	assert.False(t, last.due("12:8@1st quarter+200", now, 5*time.Minute))
+}
+
+func TestIntervalFromEnv(t *testing.T) {
+	t.Setenv("ENV_LIVE_BOX_SCORE_INTERVAL", "")
+	assert.Equal(t, BOX_SCORE_INTERVAL, intervalFromEnv("ENV_LIVE_BOX_SCORE_INTERVAL", BOX_SCORE_INTERVAL))
+	t.Setenv("ENV_LIVE_BOX_SCORE_INTERVAL", "3m")
+	assert.Equal(t, 3*time.Minute, intervalFromEnv("ENV_LIVE_BOX_SCORE_INTERVAL", BOX_SCORE_INTERVAL))
+	t.Setenv("ENV_LIVE_BOX_SCORE_INTERVAL", "every minute")
+	assert.Equal(t, BOX_SCORE_INTERVAL, intervalFromEnv("ENV_LIVE_BOX_SCORE_INTERVAL", BOX_SCORE_INTERVAL))
+	t.Setenv("ENV_LIVE_BOX_SCORE_INTERVAL", "-1s")
+	assert.Equal(t, BOX_SCORE_INTERVAL, intervalFromEnv("ENV_LIVE_BOX_SCORE_INTERVAL", BOX_SCORE_INTERVAL))
+}
@@ -0,0 +1,59 @@
+package BasketAPI
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/quible-io/quible-api/lib/store"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+// STANDINGS_INTERVAL is how often standings of enabled leagues are refreshed (BasketAPI responses are cached for an hour anyway)
+const STANDINGS_INTERVAL = time.Hour
+
+// StartStandings periodically stores standings of the current season of enabled leagues
+func StartStandings() (chan<- struct{}, error) {
+	ctx := context.Background()
+	quit := make(chan struct{})
+	ticker := time.NewTicker(STANDINGS_INTERVAL)
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return nil, errors.New("unable to access DB connection")
+	}
+	// only the leading replica polls BasketAPI for standings
+	election := store.NewLeaderElection(db, STANDINGS_LEADER_LOCK)
+	refresh := func() {
+		if !election.IsLeader(ctx) {
+			return
+		}
+		leagues, err := libBasketAPI.EnabledLeagues(ctx, db)
+		if err != nil {
+			log.Error().Err(err).Msg("unable to refresh standings")
+			return
+		}
+		for _, league := range leagues {
+			if err := client.SyncStandings(ctx, db, league.TournamentID, league.SeasonID); err != nil {
+				log.Error().Err(err).Msgf("unable to refresh %s standings", league.Name)
+			}
+		}
+	}
+	go func() {
+		refresh()
+		for {
+			select {
+			case <-ticker.C:
+				refresh()
+			case <-quit:
+				ticker.Stop()
+				election.Release(ctx)
+				return
+			}
+		}
+	}()
+
+	return quit, nil
+}
@@ -0,0 +1,62 @@
+package BasketAPI
+
+import (
+	"context"
+	"time"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+)
+
+// INCIDENTS_INTERVAL is the default interval of polling play-by-play of games in progress (configured by
+// ENV_LIVE_INCIDENTS_INTERVAL)
+const INCIDENTS_INTERVAL = 10 * time.Second
+
+// timelineTracker remembers timeline events of live games published so far
+type timelineTracker struct {
+	seen     map[uint]map[string]struct{}
+	polledAt map[uint]time.Time
+	interval time.Duration
+}
+
+func newTimelineTracker(interval time.Duration) *timelineTracker {
+	return &timelineTracker{
+		seen:     map[uint]map[string]struct{}{},
+		polledAt: map[uint]time.Time{},
+		interval: interval,
+	}
+}
+
+// poll reports timeline events of the game not seen before (nothing if the game has been polled recently
+// unless forced), events reported by the first poll of the game (e.g. after restart) are only remembered
+func (t *timelineTracker) poll(ctx context.Context, gameId uint, force bool) ([]libBasketAPI.TimelineEvent, error) {
+	if polledAt, ok := t.polledAt[gameId]; ok && !force && time.Since(polledAt) < t.interval {
+		return nil, nil
+	}
+	t.polledAt[gameId] = time.Now()
+	response, err := client.Incidents(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	seen, ok := t.seen[gameId]
+	if !ok {
+		seen = map[string]struct{}{}
+		t.seen[gameId] = seen
+	}
+	var events []libBasketAPI.TimelineEvent
+	for _, event := range libBasketAPI.ParseTimeline(response) {
+		if _, ok := seen[event.Key]; ok {
+			continue
+		}
+		seen[event.Key] = struct{}{}
+		events = append(events, event)
+	}
+	if !ok {
+		return nil, nil
+	}
+	return events, nil
+}
+
+func (t *timelineTracker) forget(gameId uint) {
+	delete(t.seen, gameId)
+	delete(t.polledAt, gameId)
+}
@@ -1,13 +1,20 @@
 package ablyService
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/ably/ably-go/ably"
 )
 
 var (
 	ablyRealTime *ably.Realtime
+	// REST client is used for presence queries, which don't require attaching to the channel
+	ablyREST *ably.REST
 )
 
 func Setup() error {
@@ -19,6 +26,14 @@ func Setup() error {
 		return err
 	}
 	ablyRealTime = client
+	restClient, err := ably.NewREST(
+		ably.WithKey(os.Getenv("ENV_ABLY_KEY")),
+		ably.WithClientID("backend"),
+	)
+	if err != nil {
+		return err
+	}
+	ablyREST = restClient
 	return nil
 }
 
@@ -29,3 +44,69 @@ func GetAbly() *ably.Realtime {
 func CreateTokenRequest(params *ably.TokenParams, opts ...ably.AuthOption) (*ably.TokenRequest, error) {
 	return ablyRealTime.Auth.CreateTokenRequest(params, opts...)
 }
+
+// CountMessagesSince counts messages published to the channel after `since` (zero value means from the beginning of
+// the channel history) skipping ones published by `excludeClientId`. Counting stops when `limit` is reached
+func CountMessagesSince(ctx context.Context, channelName string, since time.Time, excludeClientId string, limit int) (int, error) {
+	options := []ably.HistoryOption{
+		ably.HistoryWithDirection(ably.Forwards),
+		ably.HistoryWithLimit(limit),
+	}
+	if !since.IsZero() {
+		options = append(options, ably.HistoryWithStart(since.Add(time.Millisecond)))
+	}
+	pages, err := ablyRealTime.Channels.Get(channelName).History(options...).Pages(ctx)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for pages.Next(ctx) {
+		for _, message := range pages.Items() {
+			if message.ClientID == excludeClientId {
+				continue
+			}
+			count++
+			if count >= limit {
+				return count, nil
+			}
+		}
+	}
+	return count, pages.Err()
+}
+
+// PresentClientIds returns IDs of clients present on the channel
+func PresentClientIds(ctx context.Context, channelName string) (map[string]bool, error) {
+	pages, err := ablyREST.Channels.Get(channelName).Presence.Get(
+		ably.GetPresenceWithLimit(1000),
+	).Pages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	clientIds := map[string]bool{}
+	for pages.Next(ctx) {
+		for _, presence := range pages.Items() {
+			clientIds[presence.ClientID] = true
+		}
+	}
+	return clientIds, pages.Err()
+}
+
+// RevokeClientTokens revokes all tokens issued to the client (revocable tokens must be enabled for the API key), the
+// client is disconnected and has to request a new token, i.e. changes of its capabilities apply immediately
+func RevokeClientTokens(ctx context.Context, clientId string) error {
+	keyName, _, _ := strings.Cut(os.Getenv("ENV_ABLY_KEY"), ":")
+	response, err := ablyREST.Request(
+		http.MethodPost,
+		"/keys/"+keyName+"/revokeTokens",
+		ably.RequestWithBody(map[string]any{
+			"targets": []string{"clientId:" + clientId},
+		}),
+	).Pages(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to revoke tokens of %s: %w", clientId, err)
+	}
+	if !response.Success() {
+		return fmt.Errorf("unable to revoke tokens of %s: %s", clientId, response.ErrorMessage())
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package botService
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type Scope string
+
+const (
+	ScopeChatRead  Scope = "chat:read"
+	ScopeChatWrite Scope = "chat:write"
+)
+
+var Scopes = []Scope{
+	ScopeChatRead,
+	ScopeChatWrite,
+}
+
+const (
+	// API_KEY_PREFIX marks API keys, the full key is "qbk_{prefix}_{secret}"
+	API_KEY_PREFIX = "qbk_"
+	// API_KEY_USED_AT_RESOLUTION limits how often `last_used_at` of API key is updated
+	API_KEY_USED_AT_RESOLUTION = time.Minute
+)
+
+var ErrInvalidApiKey = errors.New("invalid, revoked or expired API key")
+
+// GenerateApiKey returns a new API key along with its public prefix (used for lookups) and hash (stored in DB)
+func GenerateApiKey() (key string, prefix string, hashedKey string, err error) {
+	b := make([]byte, 4+32)
+	if _, err = rand.Read(b); err != nil {
+		return
+	}
+	prefix = hex.EncodeToString(b[:4])
+	key = API_KEY_PREFIX + prefix + "_" + base64.RawURLEncoding.EncodeToString(b[4:])
+	hashedKey = HashApiKey(key)
+	return
+}
+
+// HashApiKey computes hex encoded SHA-256 of the API key
+func HashApiKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// ParseApiKey extracts API key from the authorization header, both "Bearer qbk_..." and "ApiKey qbk_..." are accepted.
+// `ok` is false when the header holds other credentials (e.g. access token of a user)
+func ParseApiKey(authorization string) (key string, prefix string, ok bool) {
+	scheme, credentials, found := strings.Cut(strings.TrimSpace(authorization), " ")
+	if !found || !(strings.EqualFold(scheme, "Bearer") || strings.EqualFold(scheme, "ApiKey")) {
+		return "", "", false
+	}
+	key = strings.TrimSpace(credentials)
+	if !strings.HasPrefix(key, API_KEY_PREFIX) {
+		return "", "", false
+	}
+	prefix, _, found = strings.Cut(strings.TrimPrefix(key, API_KEY_PREFIX), "_")
+	if !found || prefix == "" {
+		return "", "", false
+	}
+	return key, prefix, true
+}
+
+// ScopesString serializes scopes for storage
+func ScopesString(scopes []Scope) string {
+	items := make([]string, len(scopes))
+	for idx, scope := range scopes {
+		items[idx] = string(scope)
+	}
+	slices.Sort(items)
+	return strings.Join(slices.Compact(items), ",")
+}
+
+// ScopesFromString parses stored scopes
+func ScopesFromString(scopes string) []Scope {
+	result := []Scope{}
+	for _, scope := range strings.Split(scopes, ",") {
+		if scope != "" {
+			result = append(result, Scope(scope))
+		}
+	}
+	return result
+}
+
+// HasScope checks if the scope is granted, "chat:write" implies "chat:read"
+func HasScope(scopes []Scope, scope Scope) bool {
+	if scope == ScopeChatRead && slices.Contains(scopes, ScopeChatWrite) {
+		return true
+	}
+	return slices.Contains(scopes, scope)
+}
+
+// ResolveApiKey finds active API key of an existing bot, the time of the last use is recorded
+func ResolveApiKey(ctx context.Context, exec boil.ContextExecutor, key string, prefix string) (*models.APIKey, error) {
+	apiKey, err := models.APIKeys(
+		models.APIKeyWhere.Prefix.EQ(prefix),
+		qm.Load(models.APIKeyRels.Bot),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidApiKey, err)
+	}
+	now := time.Now()
+	switch {
+	case subtle.ConstantTimeCompare([]byte(apiKey.HashedKey), []byte(HashApiKey(key))) != 1,
+		apiKey.RevokedAt.Valid,
+		apiKey.ExpiresAt.Valid && apiKey.ExpiresAt.Time.Before(now),
+		apiKey.R.Bot == nil:
+		return nil, ErrInvalidApiKey
+	}
+	if !apiKey.LastUsedAt.Valid || now.Sub(apiKey.LastUsedAt.Time) > API_KEY_USED_AT_RESOLUTION {
+		apiKey.LastUsedAt = null.TimeFrom(now)
+		if _, err := apiKey.Update(ctx, exec, boil.Whitelist(models.APIKeyColumns.LastUsedAt)); err != nil {
+			return nil, fmt.Errorf("unable to record use of API key %q: %w", apiKey.ID, err)
+		}
+	}
+	return apiKey, nil
+}
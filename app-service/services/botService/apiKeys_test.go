@@ -0,0 +1,47 @@
+package botService
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateApiKey(t *testing.T) {
+	key, prefix, hashedKey, err := GenerateApiKey()
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(key, API_KEY_PREFIX+prefix+"_"))
+	assert.Equal(t, HashApiKey(key), hashedKey)
+	assert.NotContains(t, hashedKey, key)
+
+	otherKey, otherPrefix, _, err := GenerateApiKey()
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+	assert.NotEqual(t, prefix, otherPrefix)
+}
+
+func TestParseApiKey(t *testing.T) {
+	key, prefix, _, _ := GenerateApiKey()
+	for _, authorization := range []string{"Bearer " + key, "ApiKey " + key, "apikey  " + key} {
+		parsedKey, parsedPrefix, ok := ParseApiKey(authorization)
+		assert.True(t, ok, authorization)
+		assert.Equal(t, key, parsedKey)
+		assert.Equal(t, prefix, parsedPrefix)
+	}
+	for _, authorization := range []string{"", key, "Bearer eyJhbGciOiJIUzI1NiJ9.e30.x", "Basic " + key, "Bearer qbk_", "Bearer qbk__secret"} {
+		_, _, ok := ParseApiKey(authorization)
+		assert.False(t, ok, authorization)
+	}
+}
+
+func TestScopes(t *testing.T) {
+	scopes := ScopesString([]Scope{ScopeChatWrite, ScopeChatRead, ScopeChatWrite})
+	assert.Equal(t, "chat:read,chat:write", scopes)
+	assert.Equal(t, []Scope{ScopeChatRead, ScopeChatWrite}, ScopesFromString(scopes))
+	assert.Empty(t, ScopesFromString(""))
+
+	assert.True(t, HasScope([]Scope{ScopeChatWrite}, ScopeChatRead))
+	assert.True(t, HasScope([]Scope{ScopeChatRead}, ScopeChatRead))
+	assert.False(t, HasScope([]Scope{ScopeChatRead}, ScopeChatWrite))
+	assert.False(t, HasScope(nil, ScopeChatRead))
+}
@@ -0,0 +1,30 @@
+package chatService
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// RegisterActivity moves `last_message_at` of the chat channel (and its chat group) forward to `at`
+func RegisterActivity(ctx context.Context, exec boil.ContextExecutor, chat *models.Chat, at time.Time) error {
+	chatIds := []string{chat.ID}
+	if chat.ParentID.Valid {
+		chatIds = append(chatIds, chat.ParentID.String)
+	}
+	if _, err := models.Chats(
+		models.ChatWhere.ID.IN(chatIds),
+		qm.Expr(
+			models.ChatWhere.LastMessageAt.IsNull(),
+			qm.Or2(models.ChatWhere.LastMessageAt.LT(null.TimeFrom(at))),
+		),
+	).UpdateAll(ctx, exec, models.M{models.ChatColumns.LastMessageAt: at}); err != nil {
+		return fmt.Errorf("unable to update activity of chat %q: %w", chat.ID, err)
+	}
+	return nil
+}
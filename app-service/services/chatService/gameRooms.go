@@ -0,0 +1,124 @@
+package chatService
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+const (
+	// GAME_ROOMS_GROUP_RESOURCE is the resource of the game rooms chat group of the default league, the groups of
+	// other leagues are suffixed with the league ID (e.g. `chat:games-wnba`)
+	GAME_ROOMS_GROUP_RESOURCE = "chat:games"
+	// GAME_ROOM_ARCHIVE_DELAY is the time game room remains writable after the game is finished
+	GAME_ROOM_ARCHIVE_DELAY = time.Hour
+)
+
+// GameRoomResource returns resource name of the chat channel associated with the game
+func GameRoomResource(gameId uint) string {
+	return fmt.Sprintf("game-%d", gameId)
+}
+
+// GameRoomsGroupResource returns resource of the chat group holding game rooms of the league
+func GameRoomsGroupResource(league *libBasketAPI.League) string {
+	if league.ID == libBasketAPI.DEFAULT_LEAGUE {
+		return GAME_ROOMS_GROUP_RESOURCE
+	}
+	return GAME_ROOMS_GROUP_RESOURCE + "-" + league.ID
+}
+
+// EnsureGameRoomsGroup returns public chat group (not owned by any user) holding game rooms of the league, it gets
+// created if missing
+func EnsureGameRoomsGroup(ctx context.Context, exec boil.ContextExecutor, league *libBasketAPI.League) (*models.Chat, error) {
+	findChatGroup := func() (*models.Chat, error) {
+		return models.Chats(
+			models.ChatWhere.Resource.EQ(GameRoomsGroupResource(league)),
+			models.ChatWhere.ParentID.IsNull(),
+			models.ChatWhere.OwnerID.IsNull(),
+		).One(ctx, exec)
+	}
+	chatGroup, err := findChatGroup()
+	if err == nil {
+		return chatGroup, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("unable to retrieve %s game rooms chat group: %w", league.Name, err)
+	}
+	chatGroup = &models.Chat{
+		Resource:  GameRoomsGroupResource(league),
+		Title:     league.Name + " games",
+		IsPrivate: null.BoolFrom(false),
+	}
+	if err := chatGroup.Insert(ctx, exec, boil.Infer()); err != nil {
+		// concurrent request might have created the same chat group (the resource of chat groups is unique)
+		chatGroupFound, errFind := findChatGroup()
+		if errFind != nil {
+			return nil, fmt.Errorf("unable to create %s game rooms chat group: %w", league.Name, errors.Join(err, errFind))
+		}
+		return chatGroupFound, nil
+	}
+	return chatGroup, nil
+}
+
+// EnsureGameRoom returns chat channel associated with the game of the league, it gets created if missing
+func EnsureGameRoom(ctx context.Context, exec boil.ContextExecutor, league *libBasketAPI.League, gameId uint, title string) (*models.Chat, error) {
+	chatChannel, err := FindGameRoom(ctx, exec, gameId)
+	if err == nil {
+		return chatChannel, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+	chatGroup, err := EnsureGameRoomsGroup(ctx, exec, league)
+	if err != nil {
+		return nil, err
+	}
+	chatChannel = &models.Chat{
+		Resource: GameRoomResource(gameId),
+		Title:    title,
+		ParentID: null.StringFrom(chatGroup.ID),
+		GameID:   null.Int64From(int64(gameId)),
+	}
+	// concurrent requests (or the scheduler) may provision the same game room, nothing is inserted then and the
+	// room created by the winner is returned
+	if err := chatChannel.Upsert(
+		ctx,
+		exec,
+		false,
+		[]string{models.ChatColumns.GameID},
+		boil.None(),
+		boil.Infer(),
+	); err != nil {
+		return nil, fmt.Errorf("unable to create game room for game %d: %w", gameId, err)
+	}
+	return FindGameRoom(ctx, exec, gameId)
+}
+
+// FindGameRoom returns chat channel associated with the game
+func FindGameRoom(ctx context.Context, exec boil.ContextExecutor, gameId uint) (*models.Chat, error) {
+	chatChannel, err := models.Chats(
+		models.ChatWhere.GameID.EQ(null.Int64From(int64(gameId))),
+	).One(ctx, exec)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("unable to retrieve game room for game %d: %w", gameId, err)
+	}
+	return chatChannel, err
+}
+
+// ArchiveGameRoom makes chat channel associated with the game read-only
+func ArchiveGameRoom(ctx context.Context, exec boil.ContextExecutor, gameId uint) error {
+	if _, err := models.Chats(
+		models.ChatWhere.GameID.EQ(null.Int64From(int64(gameId))),
+		models.ChatWhere.ArchivedAt.IsNull(),
+	).UpdateAll(ctx, exec, models.M{models.ChatColumns.ArchivedAt: time.Now()}); err != nil {
+		return fmt.Errorf("unable to archive game room for game %d: %w", gameId, err)
+	}
+	return nil
+}
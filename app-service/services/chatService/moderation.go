@@ -0,0 +1,243 @@
+package chatService
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type ModerationReason string
+
+const (
+	ModerationReasonProfanity ModerationReason = "profanity"
+	ModerationReasonLink      ModerationReason = "link"
+	ModerationReasonRateLimit ModerationReason = "rate_limit"
+)
+
+type LinkPolicy string
+
+const (
+	LinkPolicyAllow     LinkPolicy = "allow"
+	LinkPolicyBlock     LinkPolicy = "block"
+	LinkPolicyAllowlist LinkPolicy = "allowlist"
+)
+
+const (
+	// RATE_LIMIT_MESSAGES is the max number of messages a user can publish to a channel within RATE_LIMIT_WINDOW
+	RATE_LIMIT_MESSAGES = 10
+	RATE_LIMIT_WINDOW   = 10 * time.Second
+	// MUTE_DURATION is the time during which user exceeding the rate limit gets read-only access to the channel
+	MUTE_DURATION = 15 * time.Minute
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)\b(?:https?://|www\.)([a-z0-9.-]+)`)
+
+type Violation struct {
+	Reason  ModerationReason
+	Details string
+}
+
+// Moderator scans chat messages against the word list and the link policy and tracks publish rate per user and channel
+// (in DB, so the limit is shared by all replicas)
+type Moderator struct {
+	words          []string
+	linkPolicy     LinkPolicy
+	allowedDomains []string
+}
+
+// NewModerator creates moderator configured by env vars:
+//   - ENV_MODERATION_WORDS: comma-separated list of banned words
+//   - ENV_MODERATION_LINK_POLICY: one of `allow` (default), `block`, `allowlist`
+//   - ENV_MODERATION_LINK_DOMAINS: comma-separated list of domains allowed by `allowlist` policy (subdomains included)
+func NewModerator() *Moderator {
+	linkPolicy := LinkPolicy(os.Getenv("ENV_MODERATION_LINK_POLICY"))
+	if !slices.Contains([]LinkPolicy{LinkPolicyBlock, LinkPolicyAllowlist}, linkPolicy) {
+		linkPolicy = LinkPolicyAllow
+	}
+	return &Moderator{
+		words:          splitList(os.Getenv("ENV_MODERATION_WORDS")),
+		linkPolicy:     linkPolicy,
+		allowedDomains: splitList(os.Getenv("ENV_MODERATION_LINK_DOMAINS")),
+	}
+}
+
+func splitList(value string) []string {
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item := strings.ToLower(strings.TrimSpace(item)); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Check returns violations found in the message published by the user to the channel
+func (m *Moderator) Check(ctx context.Context, exec boil.ContextExecutor, userId string, channel string, text string, publishedAt time.Time) ([]Violation, error) {
+	violations := m.scan(text)
+	// rate limit (reported once per burst, i.e. on the first message exceeding the limit)
+	count, err := registerPublish(ctx, exec, userId, channel, publishedAt)
+	if err != nil {
+		return violations, err
+	}
+	if count == RATE_LIMIT_MESSAGES+1 {
+		violations = append(violations, Violation{
+			Reason:  ModerationReasonRateLimit,
+			Details: fmt.Sprintf("more than %d messages within %s", RATE_LIMIT_MESSAGES, RATE_LIMIT_WINDOW),
+		})
+	}
+	return violations, nil
+}
+
+// scan returns violations of the word list and the link policy found in the message
+func (m *Moderator) scan(text string) []Violation {
+	violations := []Violation{}
+	// 1. Word list
+	found := []string{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if slices.Contains(m.words, word) && !slices.Contains(found, word) {
+			found = append(found, word)
+		}
+	}
+	if len(found) > 0 {
+		violations = append(violations, Violation{
+			Reason:  ModerationReasonProfanity,
+			Details: strings.Join(found, ","),
+		})
+	}
+	// 2. Link policy
+	if m.linkPolicy != LinkPolicyAllow {
+		blocked := []string{}
+		for _, match := range linkRegexp.FindAllStringSubmatch(text, -1) {
+			domain := strings.ToLower(match[1])
+			if m.linkPolicy == LinkPolicyAllowlist && m.isDomainAllowed(domain) {
+				continue
+			}
+			blocked = append(blocked, domain)
+		}
+		if len(blocked) > 0 {
+			violations = append(violations, Violation{
+				Reason:  ModerationReasonLink,
+				Details: strings.Join(blocked, ","),
+			})
+		}
+	}
+	return violations
+}
+
+func (m *Moderator) isDomainAllowed(domain string) bool {
+	for _, allowed := range m.allowedDomains {
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// registerPublish records the publish and returns number of messages published within the sliding window, the
+// window is updated atomically (concurrent webhook calls are serialized by the row lock)
+func registerPublish(ctx context.Context, exec boil.ContextExecutor, userId string, channel string, publishedAt time.Time) (int, error) {
+	var count int
+	err := exec.QueryRowContext(
+		ctx,
+		fmt.Sprintf(
+			`INSERT INTO %[1]s (%[2]s, %[3]s, %[4]s, %[5]s) VALUES ($1, $2, ARRAY[$3::bigint], now())
+			ON CONFLICT (%[2]s, %[3]s) DO UPDATE SET
+				%[4]s = array(SELECT t FROM unnest(%[1]s.%[4]s) AS t WHERE t >= $4) || $3::bigint,
+				%[5]s = now()
+			RETURNING cardinality(%[4]s)`,
+			models.TableNames.ChatPublishRates,
+			models.ChatPublishRateColumns.UserID,
+			models.ChatPublishRateColumns.Channel,
+			models.ChatPublishRateColumns.PublishedAt,
+			models.ChatPublishRateColumns.UpdatedAt,
+		),
+		userId,
+		channel,
+		publishedAt.UnixMilli(),
+		publishedAt.Add(-RATE_LIMIT_WINDOW).UnixMilli(),
+	).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("unable to register publish rate: %w", err)
+	}
+	return count, nil
+}
+
+// PurgePublishRates removes publish rates of users idle since `cutoff`
+func PurgePublishRates(ctx context.Context, exec boil.ContextExecutor, cutoff time.Time) (int64, error) {
+	count, err := models.ChatPublishRates(
+		models.ChatPublishRateWhere.UpdatedAt.LT(cutoff),
+	).DeleteAll(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge publish rates: %w", err)
+	}
+	return count, nil
+}
+
+// FindChatByResource returns chat channel (or direct message) by its Ably channel name
+func FindChatByResource(ctx context.Context, exec boil.ContextExecutor, channelName string) (*models.Chat, error) {
+	directMessage, err := models.Chats(
+		models.ChatWhere.Resource.EQ(channelName),
+		models.ChatWhere.DMKey.IsNotNull(),
+	).One(ctx, exec)
+	if err == nil {
+		return directMessage, nil
+	}
+	idx := strings.LastIndex(channelName, ":")
+	if idx == -1 {
+		return nil, fmt.Errorf("unable to parse channel name %q", channelName)
+	}
+	chatChannel, err := models.Chats(
+		qm.Select(models.TableNames.Chats+".*"),
+		qm.InnerJoin(fmt.Sprintf(
+			"%[1]s AS p ON p.%[2]s = %[1]s.%[3]s",
+			models.TableNames.Chats,
+			models.ChatColumns.ID,
+			models.ChatColumns.ParentID,
+		)),
+		models.ChatWhere.Resource.EQ(channelName[idx+1:]),
+		qm.Where("p."+models.ChatColumns.Resource+" = ?", channelName[:idx]),
+		qm.Where("p."+models.ChatColumns.DeletedAt+" IS NULL"),
+	).One(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find chat channel %q: %w", channelName, err)
+	}
+	return chatChannel, nil
+}
+
+// ReportViolations stores moderation reports for the message, users exceeding the rate limit get muted in the channel
+func ReportViolations(ctx context.Context, exec boil.ContextExecutor, chat *models.Chat, userId string, messageId string, text string, violations []Violation) error {
+	for _, violation := range violations {
+		report := models.ChatModerationReport{
+			ChatID:      chat.ID,
+			UserID:      userId,
+			MessageID:   messageId,
+			MessageText: text,
+			Reason:      string(violation.Reason),
+			Details:     null.StringFrom(violation.Details),
+		}
+		if err := report.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("unable to store moderation report: %w", err)
+		}
+		if violation.Reason == ModerationReasonRateLimit {
+			if _, err := models.ChatUsers(
+				models.ChatUserWhere.ChatID.EQ(chat.ID),
+				models.ChatUserWhere.UserID.EQ(userId),
+			).UpdateAll(ctx, exec, models.M{models.ChatUserColumns.MutedUntil: time.Now().Add(MUTE_DURATION)}); err != nil {
+				return fmt.Errorf("unable to mute user: %w", err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package chatService
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModeratorScan(t *testing.T) {
+	t.Setenv("ENV_MODERATION_WORDS", "darn, Heck")
+	t.Setenv("ENV_MODERATION_LINK_POLICY", "allowlist")
+	t.Setenv("ENV_MODERATION_LINK_DOMAINS", "quible.io")
+	moderator := NewModerator()
+	violations := moderator.scan("What the HECK, see https://spam.com and https://app.quible.io")
+	assert.Equal(t, []Violation{
+		{Reason: ModerationReasonProfanity, Details: "heck"},
+		{Reason: ModerationReasonLink, Details: "spam.com"},
+	}, violations)
+	assert.Empty(t, moderator.scan("hello, darnit"))
+	// links are allowed by default
+	t.Setenv("ENV_MODERATION_LINK_POLICY", "")
+	assert.Empty(t, NewModerator().scan("see https://spam.com"))
+}
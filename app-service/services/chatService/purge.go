@@ -0,0 +1,181 @@
+package chatService
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/store"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// GRACE_PERIOD is the time during which deleted chat groups/channels can be restored
+const GRACE_PERIOD = 7 * 24 * time.Hour
+const PURGE_INTERVAL = time.Hour
+
+// PURGE_LEADER_LOCK is the key of Postgres advisory lock held by the replica purging chat records
+const PURGE_LEADER_LOCK int64 = 0x70757267
+
+// StartPurge periodically removes chat records which have been deleted more than GRACE_PERIOD ago
+func StartPurge() (chan<- struct{}, error) {
+	ctx := context.Background()
+	quit := make(chan struct{})
+	ticker := time.NewTicker(PURGE_INTERVAL)
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return nil, errors.New("unable to access DB connection")
+	}
+	// only the leading replica purges, so replicas do not contend for the same records
+	election := store.NewLeaderElection(db, PURGE_LEADER_LOCK)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if !election.IsLeader(ctx) {
+					continue
+				}
+				if _, err := PurgePublishRates(ctx, boil.GetContextDB(), time.Now().Add(-RATE_LIMIT_WINDOW)); err != nil {
+					log.Error().Err(err).Send()
+				}
+				count, err := Purge(ctx, time.Now().Add(-GRACE_PERIOD))
+				if err != nil {
+					log.Error().Err(err).Msg("unable to purge deleted chat records")
+					continue
+				}
+				if count > 0 {
+					log.Info().Msgf("purged %d deleted chat records", count)
+				}
+			case <-quit:
+				ticker.Stop()
+				election.Release(ctx)
+				return
+			}
+		}
+	}()
+
+	return quit, nil
+}
+
+// Purge permanently removes chat records (along with associated chat_user records) deleted before `cutoff`
+func Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("unable to create an SQL transaction: %w", err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+	expiredChats, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.DeletedAt.LT(null.TimeFrom(cutoff)),
+	).All(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve expired chat records: %w", err)
+	}
+	if len(expiredChats) == 0 {
+		return 0, nil
+	}
+	chatIds := make([]string, len(expiredChats))
+	for idx, chat := range expiredChats {
+		chatIds[idx] = chat.ID
+	}
+	// chat channels held by expired chat groups are purged as well
+	chatChannels, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ParentID.IN(chatIds),
+		models.ChatWhere.ID.NIN(chatIds),
+	).All(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve chat channels of expired chat groups: %w", err)
+	}
+	for _, chat := range chatChannels {
+		chatIds = append(chatIds, chat.ID)
+	}
+	if _, err := models.ChatInvitations(
+		models.ChatInvitationWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat invitations: %w", err)
+	}
+	if _, err := models.ChatInviteLinks(
+		models.ChatInviteLinkWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat invite links: %w", err)
+	}
+	if _, err := models.ChatModerationReports(
+		models.ChatModerationReportWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat moderation reports: %w", err)
+	}
+	webhooks, err := models.ChatWebhooks(
+		models.ChatWebhookWhere.ChatID.IN(chatIds),
+	).All(ctx, tx)
+	if err != nil {
+		return 0, fmt.Errorf("unable to retrieve webhooks of expired chat groups: %w", err)
+	}
+	if len(webhooks) > 0 {
+		webhookIds := make([]string, len(webhooks))
+		for idx, webhook := range webhooks {
+			webhookIds[idx] = webhook.ID
+		}
+		if _, err := models.ChatWebhookDeliveries(
+			models.ChatWebhookDeliveryWhere.WebhookID.IN(webhookIds),
+		).DeleteAll(ctx, tx); err != nil {
+			return 0, fmt.Errorf("unable to purge webhook deliveries: %w", err)
+		}
+		if _, err := webhooks.DeleteAll(ctx, tx); err != nil {
+			return 0, fmt.Errorf("unable to purge webhooks: %w", err)
+		}
+	}
+	if _, err := models.ChatPins(
+		models.ChatPinWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge pinned items: %w", err)
+	}
+	if _, err := models.ChatAvatars(
+		models.ChatAvatarWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat avatars: %w", err)
+	}
+	if _, err := models.ChatBots(
+		models.ChatBotWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat bots: %w", err)
+	}
+	if _, err := models.ChatReadCursors(
+		models.ChatReadCursorWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx); err != nil {
+		return 0, fmt.Errorf("unable to purge chat read cursors: %w", err)
+	}
+	if _, err := models.ChatUsers(
+		qm.WithDeleted(),
+		models.ChatUserWhere.ChatID.IN(chatIds),
+	).DeleteAll(ctx, tx, true); err != nil {
+		return 0, fmt.Errorf("unable to purge chat_user records: %w", err)
+	}
+	// chat channels go first since chat groups are referenced by them
+	countChannels, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ID.IN(chatIds),
+		models.ChatWhere.ParentID.IsNotNull(),
+	).DeleteAll(ctx, tx, true)
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge chat channels: %w", err)
+	}
+	countGroups, err := models.Chats(
+		qm.WithDeleted(),
+		models.ChatWhere.ID.IN(chatIds),
+	).DeleteAll(ctx, tx, true)
+	if err != nil {
+		return 0, fmt.Errorf("unable to purge chat groups: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("unable to commit an SQL transaction: %w", err)
+	}
+	return countChannels + countGroups, nil
+}
@@ -0,0 +1,374 @@
+package chatService
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/rs/zerolog/log"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventMemberJoined       WebhookEvent = "member.joined"
+	WebhookEventMemberLeft         WebhookEvent = "member.left"
+	WebhookEventChannelCreated     WebhookEvent = "channel.created"
+	WebhookEventChannelUpdated     WebhookEvent = "channel.updated"
+	WebhookEventInvitationAccepted WebhookEvent = "invitation.accepted"
+	WebhookEventMessagePosted      WebhookEvent = "message.posted"
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookEventMemberJoined,
+	WebhookEventMemberLeft,
+	WebhookEventChannelCreated,
+	WebhookEventChannelUpdated,
+	WebhookEventInvitationAccepted,
+	WebhookEventMessagePosted,
+}
+
+const (
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliverySucceeded = "succeeded"
+	WebhookDeliveryFailed    = "failed"
+)
+
+const (
+	WEBHOOK_POLL_INTERVAL = 5 * time.Second
+	WEBHOOK_BATCH_SIZE    = 50
+	WEBHOOK_TIMEOUT       = 10 * time.Second
+	// WEBHOOK_CONCURRENCY bounds deliveries performed at once by a replica
+	WEBHOOK_CONCURRENCY = 8
+	// claimed deliveries are not picked by other replicas for WEBHOOK_CLAIM_LEASE (a batch is delivered well within
+	// the lease, deliveries of a replica gone meanwhile are retried once it's over)
+	WEBHOOK_CLAIM_LEASE = 2 * time.Minute
+	// failed delivery is retried after 30s, 1m, 2m, 4m, 8m and is given up after WEBHOOK_MAX_ATTEMPTS
+	WEBHOOK_MAX_ATTEMPTS    = 6
+	WEBHOOK_INITIAL_BACKOFF = 30 * time.Second
+	// finished deliveries are kept in the delivery log for WEBHOOK_DELIVERY_RETENTION
+	WEBHOOK_DELIVERY_RETENTION = 30 * 24 * time.Hour
+)
+
+// headers of webhook requests
+const (
+	WEBHOOK_HEADER_EVENT     = "X-Quible-Event"
+	WEBHOOK_HEADER_DELIVERY  = "X-Quible-Delivery"
+	WEBHOOK_HEADER_TIMESTAMP = "X-Quible-Timestamp"
+	WEBHOOK_HEADER_SIGNATURE = "X-Quible-Signature"
+)
+
+// WebhookPayload is the body of webhook request
+type WebhookPayload struct {
+	ID          string       `json:"id" doc:"delivery ID"`
+	Event       WebhookEvent `json:"event"`
+	ChatGroupID string       `json:"chatGroupId"`
+	CreatedAt   time.Time    `json:"createdAt"`
+	Data        any          `json:"data"`
+}
+
+// GenerateWebhookSecret returns a random secret used to sign webhook requests
+func GenerateWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SignWebhookPayload computes signature of the webhook request: hex encoded HMAC-SHA256 of "{timestamp}.{body}"
+// keyed with the webhook secret
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// WebhookBackoff returns delay before the next attempt after `attempts` failed ones
+func WebhookBackoff(attempts int) time.Duration {
+	return WEBHOOK_INITIAL_BACKOFF << max(attempts-1, 0)
+}
+
+// WebhookEventsString serializes subscribed events for storage
+func WebhookEventsString(events []WebhookEvent) string {
+	items := make([]string, len(events))
+	for idx, event := range events {
+		items[idx] = string(event)
+	}
+	slices.Sort(items)
+	return strings.Join(slices.Compact(items), ",")
+}
+
+// WebhookEventsFromString parses subscribed events
+func WebhookEventsFromString(events string) []WebhookEvent {
+	result := []WebhookEvent{}
+	for _, event := range strings.Split(events, ",") {
+		if event != "" {
+			result = append(result, WebhookEvent(event))
+		}
+	}
+	return result
+}
+
+// EmitWebhookEvent enqueues deliveries of the event to webhooks of the chat group subscribed to it. Deliveries are
+// performed by the loop started with StartWebhookDeliveries
+func EmitWebhookEvent(ctx context.Context, exec boil.ContextExecutor, chatGroupId string, event WebhookEvent, data any) error {
+	webhooks, err := models.ChatWebhooks(
+		models.ChatWebhookWhere.ChatID.EQ(chatGroupId),
+	).All(ctx, exec)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve webhooks of chat group %q: %w", chatGroupId, err)
+	}
+	now := time.Now()
+	for _, webhook := range webhooks {
+		if !slices.Contains(WebhookEventsFromString(webhook.Events), event) {
+			continue
+		}
+		delivery := models.ChatWebhookDelivery{
+			ID:            uuid.NewString(),
+			WebhookID:     webhook.ID,
+			Event:         string(event),
+			Status:        WebhookDeliveryPending,
+			NextAttemptAt: null.TimeFrom(now),
+			CreatedAt:     now,
+		}
+		payload, err := json.Marshal(WebhookPayload{
+			ID:          delivery.ID,
+			Event:       event,
+			ChatGroupID: chatGroupId,
+			CreatedAt:   now,
+			Data:        data,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to serialize %q event: %w", event, err)
+		}
+		delivery.Payload = string(payload)
+		if err := delivery.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("unable to enqueue %q event for webhook %q: %w", event, webhook.ID, err)
+		}
+	}
+	return nil
+}
+
+// EmitWebhookEventForChat is EmitWebhookEvent for the chat group holding the chat channel, failures are logged
+func EmitWebhookEventForChat(ctx context.Context, exec boil.ContextExecutor, chatChannel *models.Chat, event WebhookEvent, data any) {
+	if !chatChannel.ParentID.Valid {
+		return
+	}
+	if err := EmitWebhookEvent(ctx, exec, chatChannel.ParentID.String, event, data); err != nil {
+		log.Error().Err(err).Send()
+	}
+}
+
+// StartWebhookDeliveries periodically delivers pending webhook events and cleans up the delivery log
+func StartWebhookDeliveries() (chan<- struct{}, error) {
+	ctx := context.Background()
+	quit := make(chan struct{})
+	ticker := time.NewTicker(WEBHOOK_POLL_INTERVAL)
+	client := NewWebhookClient()
+	var lastCleanup time.Time
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				if err := DeliverWebhooks(ctx, client); err != nil {
+					log.Error().Err(err).Msg("unable to deliver webhooks")
+				}
+				if time.Since(lastCleanup) > PURGE_INTERVAL {
+					lastCleanup = time.Now()
+					if _, err := models.ChatWebhookDeliveries(
+						models.ChatWebhookDeliveryWhere.Status.NEQ(WebhookDeliveryPending),
+						models.ChatWebhookDeliveryWhere.CreatedAt.LT(time.Now().Add(-WEBHOOK_DELIVERY_RETENTION)),
+					).DeleteAllG(ctx); err != nil {
+						log.Error().Err(err).Msg("unable to clean up webhook delivery log")
+					}
+				}
+			case <-quit:
+				ticker.Stop()
+				return
+			}
+		}
+	}()
+
+	return quit, nil
+}
+
+// ErrWebhookAddressNotAllowed is reported for webhook URLs resolving to non-public addresses
+var ErrWebhookAddressNotAllowed = errors.New("webhook address is not public")
+
+// sharedAddressSpace is carrier-grade NAT range (RFC 6598), not covered by netip.Addr.IsPrivate
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// isPublicAddress reports whether webhooks may be delivered to the address: loopback, private, link-local (including
+// cloud metadata endpoints), multicast and unspecified addresses are refused
+func isPublicAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// NewWebhookClient returns HTTP client delivering webhooks to public addresses only. The address is checked after
+// the host is resolved (right before connecting), so DNS records pointing to internal hosts are refused too.
+// Redirects are not followed and proxies are not used
+func NewWebhookClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: WEBHOOK_TIMEOUT,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !isPublicAddress(addrPort.Addr()) {
+				return ErrWebhookAddressNotAllowed
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: WEBHOOK_TIMEOUT,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: WEBHOOK_TIMEOUT,
+			MaxIdleConns:        10,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// claimWebhookDeliveries picks due deliveries not claimed by other replicas and postpones their next attempt by
+// WEBHOOK_CLAIM_LEASE, so every delivery is attempted by a single replica
+func claimWebhookDeliveries(ctx context.Context) ([]*models.ChatWebhookDelivery, error) {
+	tx, err := boil.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create an SQL transaction: %w", err)
+	}
+	now := time.Now()
+	deliveries, err := models.ChatWebhookDeliveries(
+		models.ChatWebhookDeliveryWhere.Status.EQ(WebhookDeliveryPending),
+		models.ChatWebhookDeliveryWhere.NextAttemptAt.LTE(null.TimeFrom(now)),
+		qm.OrderBy(models.ChatWebhookDeliveryColumns.NextAttemptAt),
+		qm.Limit(WEBHOOK_BATCH_SIZE),
+		qm.For("update skip locked"),
+		qm.Load(models.ChatWebhookDeliveryRels.Webhook),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, fmt.Errorf("unable to retrieve pending webhook deliveries: %w", err)
+	}
+	if len(deliveries) > 0 {
+		if _, err := deliveries.UpdateAll(ctx, tx, models.M{
+			models.ChatWebhookDeliveryColumns.NextAttemptAt: now.Add(WEBHOOK_CLAIM_LEASE),
+		}); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("unable to claim pending webhook deliveries: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("unable to claim pending webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// DeliverWebhooks performs due deliveries (one attempt each, up to WEBHOOK_CONCURRENCY at once)
+func DeliverWebhooks(ctx context.Context, client *http.Client) error {
+	deliveries, err := claimWebhookDeliveries(ctx)
+	if err != nil {
+		return err
+	}
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, WEBHOOK_CONCURRENCY)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func(delivery *models.ChatWebhookDelivery) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			attemptWebhookDelivery(ctx, client, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+	return nil
+}
+
+// attemptWebhookDelivery delivers the event and records the outcome of the attempt
+func attemptWebhookDelivery(ctx context.Context, client *http.Client, delivery *models.ChatWebhookDelivery) {
+	statusCode, err := deliverWebhook(ctx, client, delivery.R.Webhook, delivery)
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = null.NewInt(statusCode, statusCode != 0)
+	switch {
+	case err == nil:
+		delivery.Status = WebhookDeliverySucceeded
+		delivery.DeliveredAt = null.TimeFrom(now)
+		delivery.NextAttemptAt = null.TimeFromPtr(nil)
+		delivery.LastError = null.StringFromPtr(nil)
+	case delivery.Attempts >= WEBHOOK_MAX_ATTEMPTS:
+		delivery.Status = WebhookDeliveryFailed
+		delivery.NextAttemptAt = null.TimeFromPtr(nil)
+		delivery.LastError = null.StringFrom(err.Error())
+	default:
+		delivery.NextAttemptAt = null.TimeFrom(now.Add(WebhookBackoff(delivery.Attempts)))
+		delivery.LastError = null.StringFrom(err.Error())
+	}
+	if _, err := delivery.UpdateG(ctx, boil.Infer()); err != nil {
+		log.Error().Err(err).Msgf("unable to update webhook delivery %q", delivery.ID)
+	}
+}
+
+// deliverWebhook sends signed payload to the webhook URL, any 2xx response is considered a success
+func deliverWebhook(ctx context.Context, client *http.Client, webhook *models.ChatWebhook, delivery *models.ChatWebhookDelivery) (int, error) {
+	if webhook == nil {
+		return 0, fmt.Errorf("webhook %q not found", delivery.WebhookID)
+	}
+	body := []byte(delivery.Payload)
+	timestamp := time.Now().Unix()
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set(WEBHOOK_HEADER_EVENT, delivery.Event)
+	request.Header.Set(WEBHOOK_HEADER_DELIVERY, delivery.ID)
+	request.Header.Set(WEBHOOK_HEADER_TIMESTAMP, strconv.FormatInt(timestamp, 10))
+	request.Header.Set(WEBHOOK_HEADER_SIGNATURE, SignWebhookPayload(webhook.Secret, timestamp, body))
+	response, err := client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 64*1024))
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected response status %d", response.StatusCode)
+	}
+	return response.StatusCode, nil
+}
@@ -0,0 +1,112 @@
+package chatService
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebhookEventsString(t *testing.T) {
+	events := WebhookEventsString([]WebhookEvent{WebhookEventMemberLeft, WebhookEventMemberJoined, WebhookEventMemberLeft})
+	assert.Equal(t, "member.joined,member.left", events)
+	assert.Equal(t, []WebhookEvent{WebhookEventMemberJoined, WebhookEventMemberLeft}, WebhookEventsFromString(events))
+	assert.Empty(t, WebhookEventsFromString(""))
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, WebhookBackoff(1))
+	assert.Equal(t, time.Minute, WebhookBackoff(2))
+	assert.Equal(t, 8*time.Minute, WebhookBackoff(5))
+}
+
+func TestDeliverWebhook(t *testing.T) {
+	webhook := &models.ChatWebhook{ID: "webhook", Secret: "secret"}
+	delivery := &models.ChatWebhookDelivery{ID: "delivery", Event: string(WebhookEventMemberJoined), Payload: `{"event":"member.joined"}`}
+	status := http.StatusNoContent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(WEBHOOK_HEADER_TIMESTAMP), 10, 64)
+		assert.Equal(t, delivery.Payload, string(body))
+		assert.Equal(t, delivery.Event, r.Header.Get(WEBHOOK_HEADER_EVENT))
+		assert.Equal(t, delivery.ID, r.Header.Get(WEBHOOK_HEADER_DELIVERY))
+		assert.Equal(t, SignWebhookPayload("secret", timestamp, body), r.Header.Get(WEBHOOK_HEADER_SIGNATURE))
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	webhook.URL = server.URL
+
+	statusCode, err := deliverWebhook(context.Background(), server.Client(), webhook, delivery)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, statusCode)
+
+	status = http.StatusBadGateway
+	statusCode, err = deliverWebhook(context.Background(), server.Client(), webhook, delivery)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, statusCode)
+
+	assert.NotEqual(t, SignWebhookPayload("secret", 1, []byte("a")), SignWebhookPayload("other", 1, []byte("a")))
+}
+
+func TestIsPublicAddress(t *testing.T) {
+	for addr, expected := range map[string]bool{
+		"93.184.216.34":        true,
+		"2606:2800:220:1::1":   true,
+		"127.0.0.1":            false,
+		"::1":                  false,
+		"10.1.2.3":             false,
+		"172.16.0.1":           false,
+		"192.168.1.1":          false,
+		"169.254.169.254":      false,
+		"fe80::1":              false,
+		"fd00:ec2::254":        false,
+		"100.64.0.1":           false,
+		"0.0.0.0":              false,
+		"::ffff:127.0.0.1":     false,
+		"::ffff:93.184.216.34": true,
+		"224.0.0.1":            false,
+		"255.255.255.255":      false,
+	} {
+		assert.Equal(t, expected, isPublicAddress(netip.MustParseAddr(addr)), addr)
+	}
+}
+
+func TestWebhookClientRefusesInternalAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("internal server must not be reached")
+	}))
+	defer server.Close()
+	webhook := &models.ChatWebhook{ID: "webhook", Secret: "secret", URL: server.URL}
+	delivery := &models.ChatWebhookDelivery{ID: "delivery", Event: string(WebhookEventMemberJoined), Payload: `{}`}
+
+	statusCode, err := deliverWebhook(context.Background(), NewWebhookClient(), webhook, delivery)
+	assert.True(t, errors.Is(err, ErrWebhookAddressNotAllowed), err)
+	assert.Equal(t, 0, statusCode)
+}
+
+func TestWebhookClientDoesNotFollowRedirects(t *testing.T) {
+	client := NewWebhookClient()
+	// the test server is on loopback, so the dialer guard is bypassed to check redirects alone
+	client.Transport = http.DefaultTransport
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/hook" {
+			t.Error("redirect must not be followed")
+		}
+		http.Redirect(w, r, "/internal", http.StatusFound)
+	}))
+	defer server.Close()
+	webhook := &models.ChatWebhook{ID: "webhook", Secret: "secret", URL: server.URL + "/hook"}
+	delivery := &models.ChatWebhookDelivery{ID: "delivery", Event: string(WebhookEventMemberJoined), Payload: `{}`}
+
+	statusCode, err := deliverWebhook(context.Background(), client, webhook, delivery)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusFound, statusCode)
+}
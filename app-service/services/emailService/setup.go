@@ -4,3 +4,4 @@ package emailService
 // `go generate` command and will result in creation of Go sources with defined inflators.
 
 //go:generate jade -pkg=emailService -stdlib -stdbuf templates/inviteToPrivateChatGroup.pug
+//go:generate jade -pkg=emailService -stdlib -stdbuf templates/inviteToPrivateChatGroupSignup.pug
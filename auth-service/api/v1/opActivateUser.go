@@ -3,6 +3,7 @@ package v1
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -10,8 +11,10 @@ import (
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/jwt"
 	"github.com/quible-io/quible-api/lib/models"
+	"github.com/rs/zerolog/log"
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 )
 
 type ActivateUserInput struct {
@@ -62,7 +65,69 @@ func (impl *VersionedImpl) RegisterActivateUser(api huma.API, vc libAPI.VersionC
 			if err != nil {
 				return nil, ErrorMap.GetErrorResponse(Err500_UnableToActivateUser, err)
 			}
+			// 4. Attach chat channel memberships the user has been invited to before the account existed
+			if err := attachPendingChatInvitations(ctx, db, user); err != nil {
+				log.Error().Err(err).Str("userId", user.ID).Msg("unable to attach pending chat invitations")
+			}
 			return nil, nil
 		},
 	)
 }
+
+// attachPendingChatInvitations turns pending invitations to private chat channels, sent to the user's email
+// before the account existed, into chat channel memberships
+func attachPendingChatInvitations(ctx context.Context, db *sql.DB, user *models.User) error {
+	invitations, err := models.ChatInvitations(
+		models.ChatInvitationWhere.InviteeID.IsNull(),
+		models.ChatInvitationWhere.Status.EQ("pending"),
+		models.ChatInvitationWhere.ExpiresAt.GT(time.Now()),
+		qm.Where("lower("+models.ChatInvitationColumns.InviteeEmail+") = lower(?)", user.Email),
+		qm.Load(models.ChatInvitationRels.Chat),
+	).All(ctx, db)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve pending chat invitations: %w", err)
+	}
+	if len(invitations) == 0 {
+		return nil
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to create an SQL transaction: %w", err)
+	}
+	for _, invitation := range invitations {
+		// chat channel has been deleted since the invitation was sent
+		if invitation.R.Chat == nil {
+			continue
+		}
+		chatUser := models.ChatUser{
+			ChatID: invitation.ChatID,
+			UserID: user.ID,
+		}
+		if err := chatUser.Upsert(
+			ctx,
+			tx,
+			true,
+			[]string{models.ChatUserColumns.ChatID, models.ChatUserColumns.UserID},
+			boil.Whitelist(models.ChatUserColumns.Disabled),
+			boil.Infer(),
+		); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("unable to create chat-user association: %w", err)
+		}
+		invitation.InviteeID = null.StringFrom(user.ID)
+		invitation.Status = "accepted"
+		invitation.AcceptedAt = null.TimeFrom(time.Now())
+		if _, err := invitation.Update(ctx, tx, boil.Whitelist(
+			models.ChatInvitationColumns.InviteeID,
+			models.ChatInvitationColumns.Status,
+			models.ChatInvitationColumns.AcceptedAt,
+		)); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("unable to update chat invitation: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit an SQL transaction: %w", err)
+	}
+	return nil
+}
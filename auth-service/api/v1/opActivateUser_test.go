@@ -6,12 +6,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	v1 "github.com/quible-io/quible-api/auth-service/api/v1"
 	libAPI "github.com/quible-io/quible-api/lib/api"
 	"github.com/quible-io/quible-api/lib/jwt"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
 )
 
 func (tc *TestCases) TestActivateUser(t *testing.T) {
@@ -142,6 +145,62 @@ func (tc *TestCases) TestActivateUser(t *testing.T) {
 				},
 			}
 		},
+		"SuccessWithPendingChatInvitation": func(t *testing.T) libAPI.TCData {
+			ctx := context.Background()
+			// private chat channel owned by userA with invitation sent to (not yet registered) email of userC
+			chatGroup := models.Chat{
+				Resource:  "chat:private",
+				Title:     "Private group",
+				IsPrivate: null.BoolFrom(true),
+				OwnerID:   null.StringFrom("9bef41ed-fb10-4791-b02e-96b372c09466"),
+			}
+			if err := chatGroup.Insert(ctx, db, boil.Infer()); err != nil {
+				t.Fatalf("unable to insert chat group: %s", err)
+			}
+			chatChannel := models.Chat{
+				Resource: "channel",
+				Title:    "Private channel",
+				ParentID: null.StringFrom(chatGroup.ID),
+			}
+			if err := chatChannel.Insert(ctx, db, boil.Infer()); err != nil {
+				t.Fatalf("unable to insert chat channel: %s", err)
+			}
+			invitation := models.ChatInvitation{
+				ChatID:       chatChannel.ID,
+				InviteeEmail: null.StringFrom("userc@gmail.com"),
+				InvitorID:    "9bef41ed-fb10-4791-b02e-96b372c09466",
+				Status:       "pending",
+				ExpiresAt:    time.Now().Add(time.Hour),
+			}
+			if err := invitation.Insert(ctx, db, boil.Infer()); err != nil {
+				t.Fatalf("unable to insert chat invitation: %s", err)
+			}
+			return libAPI.TCData{
+				Description: "Success with pending chat invitation turned into chat channel membership",
+				Request: libAPI.TCRequest{
+					Args: []any{
+						map[string]any{
+							"token": suite.GetToken(t, db, "c6174e8a-e12f-4d64-a4fe-a3b0c081bd31", jwt.TokenActionActivate),
+						},
+					},
+				},
+				Response: libAPI.TCResponse{
+					Status: http.StatusOK,
+				},
+				ExtraTests: []libAPI.TCExtraTest{
+					func(req libAPI.TCRequest, res *httptest.ResponseRecorder) bool {
+						chatUser, err := models.FindChatUser(ctx, db, chatChannel.ID, "c6174e8a-e12f-4d64-a4fe-a3b0c081bd31")
+						if err != nil || chatUser.Disabled {
+							return false
+						}
+						if err := invitation.Reload(ctx, db); err != nil {
+							return false
+						}
+						return invitation.Status == "accepted" && invitation.InviteeID.String == chatUser.UserID
+					},
+				},
+			}
+		},
 	}
 	// 3. Run scenarios in sequence
 	for name, scenario := range testCases {
@@ -16,8 +16,10 @@ type GetUserProfileImageInput struct {
 }
 
 type GetUserProfileImageOutput struct {
-	ContentType string `header:"content-type"`
-	Body        []byte `doc:"binary content of the user's profile image"`
+	ContentType           string `header:"content-type"`
+	ContentTypeOptions    string `header:"x-content-type-options"`
+	ContentSecurityPolicy string `header:"content-security-policy"`
+	Body                  []byte `doc:"binary content of the user's profile image"`
 }
 
 func (impl *VersionedImpl) RegisterGetUserProfileImage(api huma.API, vc libAPI.VersionConfig) {
@@ -56,8 +58,10 @@ func (impl *VersionedImpl) RegisterGetUserProfileImage(api huma.API, vc libAPI.V
 			}
 			// 3. Return response with binary image data and appropriate content type header
 			response := &GetUserProfileImageOutput{
-				ContentType: imageData.ContentType,
-				Body:        imageData.BinaryContent,
+				ContentType:           imageData.ContentType,
+				ContentTypeOptions:    "nosniff",
+				ContentSecurityPolicy: libAPI.IMAGE_CONTENT_SECURITY_POLICY,
+				Body:                  imageData.BinaryContent,
 			}
 			return response, nil
 		},
@@ -2,11 +2,11 @@ package BasketAPI
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
-	"net/http"
-	"os"
+	"time"
 
-	"github.com/quible-io/quible-api/lib/misc"
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/rs/zerolog/log"
 	"github.com/volatiletech/null/v8"
@@ -14,30 +14,23 @@ import (
 )
 
 type Options struct {
-	TournamentID uint
-	SeasonID     uint
+	// games scheduled within [GamesFrom, GamesTo] are stored, finished ones along with their box scores
+	GamesFrom time.Time
+	GamesTo   time.Time
 }
 
 func NewCrawler(options Options) *Crawler {
-	host := "basketapi1.p.rapidapi.com"
-	header := http.Header{
-		"X-RapidAPI-Key":  []string{os.Getenv("ENV_RAPIDAPI_KEY")},
-		"X-RapidAPI-Host": []string{host},
-	}
-
 	return &Crawler{
-		Client:  *http.DefaultClient,
+		Client:  libBasketAPI.NewClient(),
 		Options: options,
-		URL:     fmt.Sprintf("https://%s/api/basketball", host),
-		Header:  header,
 	}
 }
 
 type Crawler struct {
-	http.Client
+	*libBasketAPI.Client
 	Options
-	URL    string
-	Header http.Header
+	// enabled leagues are crawled (loaded when the crawler runs)
+	Leagues []libBasketAPI.League
 }
 
 type Action struct {
@@ -51,9 +44,16 @@ func (c *Crawler) Run() error {
 	if err != nil {
 		return fmt.Errorf("unable to create an SQL transaction: %w", err)
 	}
+	if c.Leagues, err = libBasketAPI.EnabledLeagues(ctx, boil.GetContextDB()); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 	actions := []Action{
 		{"clean up old data", c.CleanUp},
 		{"update team info", c.UpdateTeamInfo},
+		{"update season schedule", c.UpdateSchedule},
+		{"update games", c.UpdateGames},
+		{"update standings", c.UpdateStandings},
 	}
 	for _, action := range actions {
 		log.Info().Msgf("Running %q ...\n", action.Name)
@@ -71,51 +71,43 @@ func (c *Crawler) Run() error {
 }
 
 func (c *Crawler) CleanUp(ctx context.Context) error {
-	if _, err := models.TeamInfos().DeleteAllG(ctx); err != nil {
+	if len(c.Leagues) == 0 {
+		return nil
+	}
+	leagueIds := make([]string, 0, len(c.Leagues))
+	for _, league := range c.Leagues {
+		leagueIds = append(leagueIds, league.ID)
+	}
+	if _, err := models.TeamInfos(models.TeamInfoWhere.LeagueID.IN(leagueIds)).DeleteAllG(ctx); err != nil {
 		return fmt.Errorf("CleanUp: unable to delete records: %w", err)
 	}
 	return nil
 }
 
 func (c *Crawler) UpdateTeamInfo(ctx context.Context) error {
-	response, err := misc.GetOne[Standings]{
-		Client: c.Client,
-		URL:    fmt.Sprintf("%s/tournament/%d/season/%d/standings/total", c.URL, c.TournamentID, c.SeasonID),
-		UpdateRequest: func(req *http.Request) {
-			req.Header = c.Header
-		},
-	}.Do()
-	if err != nil {
-		return fmt.Errorf("UpdateTeamInfo: get list of teams: %w", err)
-	}
-	teamsIDs := make(map[uint]struct{})
-	for _, item := range response.Standings {
-		if item.Type != "total" {
-			continue
+	// -- retrieve list of teams of every league
+	teamsIDs := make(map[uint]string)
+	for _, league := range c.Leagues {
+		response, err := c.Standings(ctx, league.TournamentID, league.SeasonID)
+		if err != nil {
+			return fmt.Errorf("UpdateTeamInfo: get list of %s teams: %w", league.Name, err)
 		}
-		for _, row := range item.Rows {
-			teamsIDs[row.Team.ID] = struct{}{}
+		for _, item := range response.Standings {
+			if item.Type != "total" {
+				continue
+			}
+			for _, row := range item.Rows {
+				teamsIDs[row.Team.ID] = league.ID
+			}
 		}
 	}
-	teamsURLs := make([]string, len(teamsIDs))
-	idx := 0
+	teamsDetails := make([]*libBasketAPI.TeamDetailsData, 0, len(teamsIDs))
 	for id := range teamsIDs {
-		teamsURLs[idx] = fmt.Sprintf("%s/team/%d", c.URL, id)
-		idx++
-	}
-	// -- retrieve list of teams
-	teamsDetails, err := misc.GetList[TeamDetailsData]{
-		Client:         c.Client,
-		URLs:           teamsURLs,
-		Concurrency:    2,
-		RPS:            4,
-		ExpectedStatus: 200,
-		UpdateRequest: func(req *http.Request) {
-			req.Header = c.Header
-		},
-	}.Do()
-	if err != nil {
-		return fmt.Errorf("UpdateTeamInfo: get team info records: %w", err)
+		teamDetails, err := c.Team(ctx, id)
+		if err != nil {
+			return fmt.Errorf("UpdateTeamInfo: get team info records: %w", err)
+		}
+		teamsDetails = append(teamsDetails, teamDetails)
 	}
 	// logos
 	images, err := models.Images(models.ImageWhere.ParentID.IsNull()).AllG(ctx)
@@ -140,6 +132,7 @@ func (c *Crawler) UpdateTeamInfo(ctx context.Context) error {
 			Color:          team.TeamColors.Primary,
 			SecondaryColor: team.TeamColors.Secondary,
 			Logo:           null.StringFromPtr(logoByShortName[team.ShortName]),
+			LeagueID:       teamsIDs[uint(team.ID)],
 		}
 		if err := teamInfo.InsertG(ctx, boil.Infer()); err != nil {
 			return fmt.Errorf("unable to insert record with id %q: %w", team.ID, err)
@@ -148,3 +141,83 @@ func (c *Crawler) UpdateTeamInfo(ctx context.Context) error {
 
 	return nil
 }
+
+// UpdateSchedule stores played and upcoming games of the season of every league, days whose games are all
+// finished are recorded as complete (they are served from DB)
+func (c *Crawler) UpdateSchedule(ctx context.Context) error {
+	for _, league := range c.Leagues {
+		events := []libBasketAPI.Event{}
+		for _, direction := range []libBasketAPI.SeasonMatchesDirection{libBasketAPI.SeasonMatchesLast, libBasketAPI.SeasonMatchesNext} {
+			for page := uint(0); ; page++ {
+				response, err := c.SeasonMatches(ctx, league.TournamentID, league.SeasonID, direction, page)
+				if err != nil {
+					return fmt.Errorf("UpdateSchedule: get %s %s games (page %d): %w", direction, league.Name, page, err)
+				}
+				for _, ev := range response.Events {
+					if _, err := libBasketAPI.StoreGame(ctx, boil.GetContextDB(), ev); err != nil {
+						return fmt.Errorf("UpdateSchedule: %w", err)
+					}
+				}
+				events = append(events, response.Events...)
+				if !response.HasNextPage {
+					break
+				}
+			}
+		}
+		days := libBasketAPI.CompleteGameDays(events, league.TournamentID, time.Now())
+		if err := libBasketAPI.StoreGameDays(ctx, boil.GetContextDB(), league.TournamentID, days); err != nil {
+			return fmt.Errorf("UpdateSchedule: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *Crawler) UpdateGames(ctx context.Context) error {
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return fmt.Errorf("UpdateGames: unexpected DB handle")
+	}
+	for date := c.GamesFrom; !date.After(c.GamesTo); date = date.AddDate(0, 0, 1) {
+		response, err := c.Matches(ctx, date)
+		if err != nil {
+			return fmt.Errorf("UpdateGames: get list of games: %w", err)
+		}
+		for _, ev := range response.Events {
+			if libBasketAPI.LeagueOf(c.Leagues, ev) == nil {
+				continue
+			}
+			game, err := libBasketAPI.StoreGame(ctx, db, ev)
+			if err != nil {
+				return fmt.Errorf("UpdateGames: %w", err)
+			}
+			if ev.Status.Type != libBasketAPI.StatusType_Finished || game.BoxScoreAt.Valid {
+				continue
+			}
+			if _, err := c.SyncGame(ctx, db, ev.ID); err != nil {
+				return fmt.Errorf("UpdateGames: %w", err)
+			}
+		}
+		for _, league := range c.Leagues {
+			if !libBasketAPI.CompleteGameDay(response.Events, league.TournamentID, date, time.Now()) {
+				continue
+			}
+			if err := libBasketAPI.StoreGameDays(ctx, db, league.TournamentID, []time.Time{date}); err != nil {
+				return fmt.Errorf("UpdateGames: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Crawler) UpdateStandings(ctx context.Context) error {
+	db, ok := boil.GetDB().(*sql.DB)
+	if !ok {
+		return fmt.Errorf("UpdateStandings: unexpected DB handle")
+	}
+	for _, league := range c.Leagues {
+		if err := c.SyncStandings(ctx, db, league.TournamentID, league.SeasonID); err != nil {
+			return fmt.Errorf("UpdateStandings: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"slices"
 
+	libBasketAPI "github.com/quible-io/quible-api/lib/BasketAPI"
 	"github.com/quible-io/quible-api/lib/misc"
 	"github.com/quible-io/quible-api/lib/models"
 	"github.com/rs/zerolog/log"
@@ -46,6 +47,7 @@ func (c *Crawler) Run() error {
 		{"clean up old images", c.CleanUp},
 		{"update teams", c.UpdateTeams},
 		{"update players", c.UpdatePlayers},
+		{"link player headshots", c.LinkPlayers},
 	}
 	for _, action := range actions {
 		log.Info().Msgf("Running %q ...\n", action.Name)
@@ -160,3 +162,13 @@ func (c *Crawler) UpdatePlayers(ctx context.Context) error {
 
 	return nil
 }
+
+// LinkPlayers links players stored from BasketAPI box scores to the headshots
+func (c *Crawler) LinkPlayers(ctx context.Context) error {
+	count, err := libBasketAPI.LinkPlayerImages(ctx, boil.GetContextDB())
+	if err != nil {
+		return fmt.Errorf("LinkPlayers: %w", err)
+	}
+	log.Info().Msgf("%d players linked to headshots", count)
+	return nil
+}
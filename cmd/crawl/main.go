@@ -3,6 +3,7 @@ package main
 import (
 	"log"
 	"os"
+	"time"
 
 	"github.com/quible-io/quible-api/cmd/crawl/BasketAPI"
 	"github.com/quible-io/quible-api/cmd/crawl/espn"
@@ -30,9 +31,10 @@ func main() {
 	}
 	switch crawlerImpl {
 	case "BasketAPI":
+		today := time.Now().UTC().Truncate(24 * time.Hour)
 		crawler = BasketAPI.NewCrawler(BasketAPI.Options{
-			TournamentID: 132,
-			SeasonID:     54105,
+			GamesFrom: today.AddDate(0, 0, -7),
+			GamesTo:   today.AddDate(0, 0, 7),
 		})
 	default:
 		crawler = espn.NewCrawler()
@@ -0,0 +1,196 @@
+package BasketAPI
+
+import "errors"
+
+type GameTeamsStats struct {
+	HomeTeam TeamStats
+	AwayTeam TeamStats
+}
+type GamePlayers struct {
+	HomeTeam []PlayerEntity
+	AwayTeam []PlayerEntity
+}
+
+type TeamStats struct {
+	Rebounds           uint `json:"reb"`
+	Assists            uint `json:"ast"`
+	Steals             uint `json:"stl"`
+	Blocks             uint `json:"blk"`
+	Turnovers          uint `json:"to"`
+	Fouls              uint `json:"fp"`
+	FieldGoalsMade     uint `json:"fgm"`
+	FieldGoalAttempts  uint `json:"fga"`
+	ThreePointsMade    uint `json:"tpm"`
+	ThreePointAttempts uint `json:"tpa"`
+	FreeThrowsMade     uint `json:"ftm"`
+	FreeThrowAttempts  uint `json:"fta"`
+}
+
+type PlayerEntity struct {
+	ID           uint
+	Name         string
+	Slug         string
+	Position     string
+	JerseyNumber string
+	// URL of ESPN headshot (if linked)
+	Headshot *string
+	Stats    PlayerStats
+}
+
+type PlayerStats struct {
+	MinutesPlayed      float64 `json:"min"`
+	SecondsPlayed      uint    `json:"sec"`
+	FieldGoalsMade     uint    `json:"fgm"`
+	FieldGoalAttempts  uint    `json:"fga"`
+	ThreePointsMade    uint    `json:"tpm"`
+	ThreePointAttempts uint    `json:"tpa"`
+	FreeThrowsMade     uint    `json:"ftm"`
+	FreeThrowAttempts  uint    `json:"fta"`
+	OffensiveRebounds  uint    `json:"oreb"`
+	DefensiveRebounds  uint    `json:"dreb"`
+	Rebounds           uint    `json:"reb"`
+	Assists            uint    `json:"ast"`
+	Steals             uint    `json:"stl"`
+	Blocks             uint    `json:"blk"`
+	Turnovers          uint    `json:"to"`
+	PersonalFouls      uint    `json:"fp"`
+	Points             uint    `json:"pts"`
+}
+
+func applyMapper[F any, T any](s []F, m func(F) T) []T {
+	result := make([]T, len(s))
+	for idx := range s {
+		result[idx] = m(s[idx])
+	}
+	return result
+}
+
+// ParseTeamStats extracts team statistics of the whole game (period "ALL") from MatchStatistics response
+func ParseTeamStats(response *MStat_Data) (*GameTeamsStats, error) {
+	var statGroups []MStat_Group
+	for idx := range response.Statistics {
+		if response.Statistics[idx].Period == "ALL" {
+			statGroups = response.Statistics[idx].Groups
+			break
+		}
+	}
+	if len(statGroups) == 0 {
+		return nil, errors.New("statistics groups not found")
+	}
+	var statItems []MStat_GroupItem
+	var result GameTeamsStats
+	for idx := range statGroups {
+		isGroupOthers := statGroups[idx].GroupName == MStat_GroupName_Other
+		isGroupScoring := statGroups[idx].GroupName == MStat_GroupName_Scoring
+		if isGroupOthers || isGroupScoring {
+			statItems = statGroups[idx].StatisticsItems
+			if len(statItems) == 0 {
+				return nil, errors.New("statistics group items not found")
+			}
+			for _, item := range statItems {
+				switch item.Name {
+				case MStat_GroupItemName_ScoringFieldGoals:
+					{
+						if item.HomeTotal != nil {
+							result.HomeTeam.FieldGoalAttempts = *item.HomeTotal
+						}
+						if item.AwayTotal != nil {
+							result.AwayTeam.FieldGoalAttempts = *item.AwayTotal
+						}
+						result.HomeTeam.FieldGoalsMade = item.HomeValue
+						result.AwayTeam.FieldGoalsMade = item.AwayValue
+					}
+				case MStat_GroupItemName_ScoringFreeThrows:
+					{
+						if item.HomeTotal != nil {
+							result.HomeTeam.FreeThrowAttempts = *item.HomeTotal
+						}
+						if item.AwayTotal != nil {
+							result.AwayTeam.FreeThrowAttempts = *item.AwayTotal
+						}
+						result.HomeTeam.FreeThrowsMade = item.HomeValue
+						result.AwayTeam.FreeThrowsMade = item.AwayValue
+					}
+				case MStat_GroupItemName_ScoringThreePoints:
+					{
+						if item.HomeTotal != nil {
+							result.HomeTeam.ThreePointAttempts = *item.HomeTotal
+						}
+						if item.AwayTotal != nil {
+							result.AwayTeam.ThreePointAttempts = *item.AwayTotal
+						}
+						result.HomeTeam.ThreePointsMade = item.HomeValue
+						result.AwayTeam.ThreePointsMade = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherAssists:
+					{
+						result.HomeTeam.Assists = item.HomeValue
+						result.AwayTeam.Assists = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherBlocks:
+					{
+						result.HomeTeam.Blocks = item.HomeValue
+						result.AwayTeam.Blocks = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherFouls:
+					{
+						result.HomeTeam.Fouls = item.HomeValue
+						result.AwayTeam.Fouls = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherRebounds:
+					{
+						result.HomeTeam.Rebounds = item.HomeValue
+						result.AwayTeam.Rebounds = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherSteals:
+					{
+						result.HomeTeam.Steals = item.HomeValue
+						result.AwayTeam.Steals = item.AwayValue
+					}
+				case MStat_GroupItemName_OtherTurnovers:
+					{
+						result.HomeTeam.Turnovers = item.HomeValue
+						result.AwayTeam.Turnovers = item.AwayValue
+					}
+				}
+			}
+		}
+	}
+	return &result, nil
+}
+
+// ParsePlayers extracts players of both teams along with their statistics from MatchLineups response
+func ParsePlayers(response *ML_Data) *GamePlayers {
+	mapper := func(playerElement ML_PlayerElement) PlayerEntity {
+		return PlayerEntity{
+			ID:           playerElement.Player.ID,
+			Name:         playerElement.Player.Name,
+			Slug:         playerElement.Player.Slug,
+			Position:     playerElement.Player.Position,
+			JerseyNumber: playerElement.Player.JerseyNumber,
+			Stats: PlayerStats{
+				MinutesPlayed:      float64(playerElement.Statistics.SecondsPlayed) / 60.0,
+				SecondsPlayed:      playerElement.Statistics.SecondsPlayed,
+				FieldGoalsMade:     playerElement.Statistics.FieldGoalsMade,
+				FieldGoalAttempts:  playerElement.Statistics.FieldGoalAttempts,
+				ThreePointsMade:    playerElement.Statistics.ThreePointsMade,
+				ThreePointAttempts: playerElement.Statistics.ThreePointAttempts,
+				FreeThrowsMade:     playerElement.Statistics.FreeThrowsMade,
+				FreeThrowAttempts:  playerElement.Statistics.FreeThrowAttempts,
+				OffensiveRebounds:  playerElement.Statistics.OffensiveRebounds,
+				DefensiveRebounds:  playerElement.Statistics.DefensiveRebounds,
+				Rebounds:           playerElement.Statistics.Rebounds,
+				Assists:            playerElement.Statistics.Assists,
+				Steals:             playerElement.Statistics.Steals,
+				Blocks:             playerElement.Statistics.Blocks,
+				Turnovers:          playerElement.Statistics.Turnovers,
+				PersonalFouls:      playerElement.Statistics.PersonalFouls,
+				Points:             playerElement.Statistics.Points,
+			},
+		}
+	}
+	return &GamePlayers{
+		HomeTeam: applyMapper(response.Home.Players, mapper),
+		AwayTeam: applyMapper(response.Away.Players, mapper),
+	}
+}
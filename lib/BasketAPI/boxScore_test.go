@@ -0,0 +1,25 @@
+package BasketAPI
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePlayers(t *testing.T) {
+	var data ML_Data
+	assert.NoError(t, json.Unmarshal([]byte(`{
+		"home": {"players": [{"player": {"id": 1, "name": "Home"}, "statistics": {"secondsPlayed": 90, "threePointAttempts": 5, "freeThrowAttempts": 2}}]},
+		"away": {"players": []}
+	}`), &data))
+
+	players := ParsePlayers(&data)
+	assert.Len(t, players.HomeTeam, 1)
+	assert.Len(t, players.AwayTeam, 0)
+	player := players.HomeTeam[0]
+	assert.Equal(t, uint(1), player.ID)
+	assert.Equal(t, 1.5, player.Stats.MinutesPlayed)
+	assert.Equal(t, uint(5), player.Stats.ThreePointAttempts)
+	assert.Equal(t, uint(2), player.Stats.FreeThrowAttempts)
+}
@@ -0,0 +1,351 @@
+package BasketAPI
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	Host            = "basketapi1.p.rapidapi.com"
+	DEFAULT_TIMEOUT = 10 * time.Second
+	// failed requests (network errors, 429 and 5xx responses) are retried after 250ms, 500ms, ...
+	DEFAULT_MAX_RETRIES     = 2
+	DEFAULT_INITIAL_BACKOFF = 250 * time.Millisecond
+)
+
+// headers of RapidAPI responses reporting the quota
+const (
+	HEADER_QUOTA_LIMIT     = "X-RateLimit-Requests-Limit"
+	HEADER_QUOTA_REMAINING = "X-RateLimit-Requests-Remaining"
+)
+
+type Endpoint string
+
+const (
+	EndpointMatches       Endpoint = "matches"
+	EndpointMatch         Endpoint = "match"
+	EndpointStatistics    Endpoint = "statistics"
+	EndpointLineups       Endpoint = "lineups"
+	EndpointIncidents     Endpoint = "incidents"
+	EndpointLiveMatches   Endpoint = "live"
+	EndpointSeasonMatches Endpoint = "season matches"
+	EndpointStandings     Endpoint = "standings"
+	EndpointTeam          Endpoint = "team"
+)
+
+// SeasonMatchesDirection selects either played ("last") or upcoming ("next") matches of the season
+type SeasonMatchesDirection string
+
+const (
+	SeasonMatchesLast SeasonMatchesDirection = "last"
+	SeasonMatchesNext SeasonMatchesDirection = "next"
+)
+
+// DefaultCacheTTL is the time responses of each endpoint are cached for, the live feed is never cached
+var DefaultCacheTTL = map[Endpoint]time.Duration{
+	EndpointMatches:       30 * time.Second,
+	EndpointMatch:         5 * time.Second,
+	EndpointStatistics:    10 * time.Second,
+	EndpointLineups:       10 * time.Second,
+	EndpointIncidents:     5 * time.Second,
+	EndpointSeasonMatches: time.Hour,
+	EndpointStandings:     time.Hour,
+	EndpointTeam:          24 * time.Hour,
+}
+
+var ErrUnexpectedStatus = errors.New("unexpected response status")
+
+type Option func(client *Client)
+
+func WithBaseUrl(baseURL string) Option {
+	return func(client *Client) {
+		client.BaseURL = baseURL
+	}
+}
+func WithHttpClient(httpClient http.Client) Option {
+	return func(client *Client) {
+		client.Client = httpClient
+	}
+}
+func WithApiKey(apiKey string) Option {
+	return func(client *Client) {
+		client.apiKey = apiKey
+	}
+}
+func WithRetries(maxRetries int, initialBackoff time.Duration) Option {
+	return func(client *Client) {
+		client.MaxRetries = maxRetries
+		client.InitialBackoff = initialBackoff
+	}
+}
+func WithCacheTTL(endpoint Endpoint, ttl time.Duration) Option {
+	return func(client *Client) {
+		client.CacheTTL[endpoint] = ttl
+	}
+}
+
+// Client of BasketAPI (RapidAPI), safe for concurrent use
+type Client struct {
+	http.Client
+	apiKey         string
+	BaseURL        string
+	MaxRetries     int
+	InitialBackoff time.Duration
+	CacheTTL       map[Endpoint]time.Duration
+	cache          cache
+	quota          quota
+}
+
+func NewClient(options ...Option) *Client {
+	client := Client{
+		Client:         http.Client{Timeout: DEFAULT_TIMEOUT},
+		apiKey:         os.Getenv("ENV_RAPIDAPI_KEY"),
+		BaseURL:        fmt.Sprintf("https://%s/api/basketball", Host),
+		MaxRetries:     DEFAULT_MAX_RETRIES,
+		InitialBackoff: DEFAULT_INITIAL_BACKOFF,
+		CacheTTL:       map[Endpoint]time.Duration{},
+		cache:          cache{entries: map[string]cacheEntry{}},
+	}
+	for endpoint, ttl := range DefaultCacheTTL {
+		client.CacheTTL[endpoint] = ttl
+	}
+	for _, option := range options {
+		option(&client)
+	}
+	return &client
+}
+
+// Matches lists matches scheduled for the date (UTC)
+func (client *Client) Matches(ctx context.Context, date time.Time) (*MS_Data, error) {
+	var data MS_Data
+	path := fmt.Sprintf("/matches/%s", date.UTC().Format("2/1/2006"))
+	return &data, client.get(ctx, EndpointMatches, path, &data)
+}
+
+// Match reports details of the match
+func (client *Client) Match(ctx context.Context, matchId uint) (*MD_Data, error) {
+	var data MD_Data
+	return &data, client.get(ctx, EndpointMatch, fmt.Sprintf("/match/%d", matchId), &data)
+}
+
+// Statistics reports team statistics of the match
+func (client *Client) Statistics(ctx context.Context, matchId uint) (*MStat_Data, error) {
+	var data MStat_Data
+	return &data, client.get(ctx, EndpointStatistics, fmt.Sprintf("/match/%d/statistics", matchId), &data)
+}
+
+// Lineups reports players of the match along with their statistics
+func (client *Client) Lineups(ctx context.Context, matchId uint) (*ML_Data, error) {
+	var data ML_Data
+	return &data, client.get(ctx, EndpointLineups, fmt.Sprintf("/match/%d/lineups", matchId), &data)
+}
+
+// Incidents lists incidents (play-by-play) of the match
+func (client *Client) Incidents(ctx context.Context, matchId uint) (*MI_Data, error) {
+	var data MI_Data
+	return &data, client.get(ctx, EndpointIncidents, fmt.Sprintf("/match/%d/incidents", matchId), &data)
+}
+
+// LiveMatches lists matches in progress
+func (client *Client) LiveMatches(ctx context.Context) (*LM_Data, error) {
+	var data LM_Data
+	return &data, client.get(ctx, EndpointLiveMatches, "/matches/live", &data)
+}
+
+// SeasonMatches lists a page (starting from 0) of played or upcoming matches of the tournament season
+func (client *Client) SeasonMatches(
+	ctx context.Context,
+	tournamentId uint,
+	seasonId uint,
+	direction SeasonMatchesDirection,
+	page uint,
+) (*SM_Data, error) {
+	var data SM_Data
+	path := fmt.Sprintf("/tournament/%d/season/%d/matches/%s/%d", tournamentId, seasonId, direction, page)
+	return &data, client.get(ctx, EndpointSeasonMatches, path, &data)
+}
+
+// Standings reports total standings of the tournament season
+func (client *Client) Standings(ctx context.Context, tournamentId uint, seasonId uint) (*Standings, error) {
+	var data Standings
+	path := fmt.Sprintf("/tournament/%d/season/%d/standings/total", tournamentId, seasonId)
+	return &data, client.get(ctx, EndpointStandings, path, &data)
+}
+
+// Team reports details of the team
+func (client *Client) Team(ctx context.Context, teamId uint) (*TeamDetailsData, error) {
+	var data TeamDetailsData
+	return &data, client.get(ctx, EndpointTeam, fmt.Sprintf("/team/%d", teamId), &data)
+}
+
+// Quota reports counters of requests made by the client
+func (client *Client) Quota() QuotaStats {
+	return client.quota.stats()
+}
+
+// get decodes (possibly cached) response of the endpoint into `data`
+func (client *Client) get(ctx context.Context, endpoint Endpoint, path string, data any) error {
+	ttl := client.CacheTTL[endpoint]
+	if ttl > 0 {
+		if body, ok := client.cache.get(path); ok {
+			client.quota.cacheHits.Add(1)
+			return json.Unmarshal(body, data)
+		}
+	}
+	body, err := client.fetch(ctx, path)
+	if err != nil {
+		client.quota.failures.Add(1)
+		return fmt.Errorf("BasketAPI %s: %w", endpoint, err)
+	}
+	if err := json.Unmarshal(body, data); err != nil {
+		client.quota.failures.Add(1)
+		return fmt.Errorf("BasketAPI %s: unable to decode response: %w", endpoint, err)
+	}
+	if ttl > 0 {
+		client.cache.set(path, body, ttl)
+	}
+	return nil
+}
+
+// fetch performs the request retrying it on network errors, 429 and 5xx responses
+func (client *Client) fetch(ctx context.Context, path string) ([]byte, error) {
+	var lastErr error
+	for attempt := 0; attempt <= client.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(client.InitialBackoff << (attempt - 1)):
+			}
+		}
+		body, retryable, err := client.fetchOnce(ctx, path)
+		if err == nil {
+			return body, nil
+		}
+		lastErr = err
+		if !retryable {
+			break
+		}
+	}
+	return nil, lastErr
+}
+
+func (client *Client) fetchOnce(ctx context.Context, path string) (body []byte, retryable bool, err error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, client.BaseURL+path, http.NoBody)
+	if err != nil {
+		return nil, false, fmt.Errorf("unable to prepare request: %w", err)
+	}
+	request.Header.Set("X-RapidAPI-Key", client.apiKey)
+	request.Header.Set("X-RapidAPI-Host", Host)
+	client.quota.requests.Add(1)
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, ctx.Err() == nil, fmt.Errorf("unable to execute the request: %w", err)
+	}
+	defer response.Body.Close()
+	client.quota.update(response.Header)
+	if response.StatusCode != http.StatusOK {
+		_, _ = io.Copy(io.Discard, response.Body)
+		retryable = response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= 500
+		return nil, retryable, fmt.Errorf("%w: request to %q failed: %s", ErrUnexpectedStatus, path, response.Status)
+	}
+	body, err = io.ReadAll(response.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("unable to read response: %w", err)
+	}
+	return body, false, nil
+}
+
+// -- response cache
+
+type cacheEntry struct {
+	body      []byte
+	expiresAt time.Time
+}
+
+type cache struct {
+	sync.Mutex
+	entries map[string]cacheEntry
+}
+
+func (c *cache) get(key string) ([]byte, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.body, true
+}
+
+func (c *cache) set(key string, body []byte, ttl time.Duration) {
+	c.Lock()
+	defer c.Unlock()
+	now := time.Now()
+	// expired entries are evicted on writes to keep the cache bounded by the set of recently used URLs
+	for k, entry := range c.entries {
+		if now.After(entry.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{body: body, expiresAt: now.Add(ttl)}
+}
+
+// -- RapidAPI quota accounting
+
+// QuotaStats are counters of requests, `Limit`/`Remaining` are reported by RapidAPI (-1 until known)
+type QuotaStats struct {
+	Requests  int64 `json:"requests" doc:"requests sent to BasketAPI (including retries)"`
+	CacheHits int64 `json:"cacheHits"`
+	Failures  int64 `json:"failures"`
+	Limit     int64 `json:"limit"`
+	Remaining int64 `json:"remaining"`
+}
+
+type quota struct {
+	requests  atomic.Int64
+	cacheHits atomic.Int64
+	failures  atomic.Int64
+	limit     atomic.Int64
+	remaining atomic.Int64
+	known     atomic.Bool
+}
+
+func (q *quota) update(header http.Header) {
+	limit, errLimit := strconv.ParseInt(header.Get(HEADER_QUOTA_LIMIT), 10, 64)
+	remaining, errRemaining := strconv.ParseInt(header.Get(HEADER_QUOTA_REMAINING), 10, 64)
+	if errLimit != nil || errRemaining != nil {
+		return
+	}
+	q.limit.Store(limit)
+	q.remaining.Store(remaining)
+	q.known.Store(true)
+}
+
+func (q *quota) stats() QuotaStats {
+	stats := QuotaStats{
+		Requests:  q.requests.Load(),
+		CacheHits: q.cacheHits.Load(),
+		Failures:  q.failures.Load(),
+		Limit:     -1,
+		Remaining: -1,
+	}
+	if q.known.Load() {
+		stats.Limit = q.limit.Load()
+		stats.Remaining = q.remaining.Load()
+	}
+	return stats
+}
@@ -0,0 +1,110 @@
+package BasketAPI
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(server *httptest.Server, options ...Option) *Client {
+	return NewClient(append([]Option{
+		WithBaseUrl(server.URL),
+		WithHttpClient(*server.Client()),
+		WithApiKey("key"),
+		WithRetries(2, time.Millisecond),
+	}, options...)...)
+}
+
+func TestClientMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/match/42", r.URL.Path)
+		assert.Equal(t, "key", r.Header.Get("X-RapidAPI-Key"))
+		assert.Equal(t, Host, r.Header.Get("X-RapidAPI-Host"))
+		w.Header().Set(HEADER_QUOTA_LIMIT, "1000")
+		w.Header().Set(HEADER_QUOTA_REMAINING, "998")
+		_, _ = w.Write([]byte(`{"event":{"id":42,"status":{"type":"finished"}}}`))
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	assert.Equal(t, int64(-1), client.Quota().Remaining)
+	data, err := client.Match(context.Background(), 42)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(42), data.Event.ID)
+	assert.Equal(t, StatusType_Finished, data.Event.Status.Type)
+	assert.Equal(t, QuotaStats{Requests: 1, Limit: 1000, Remaining: 998}, client.Quota())
+}
+
+func TestClientSeasonMatches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/tournament/132/season/54105/matches/next/1", r.URL.Path)
+		_, _ = w.Write([]byte(`{"events":[{"id":1},{"id":2}],"hasNextPage":true}`))
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	data, err := client.SeasonMatches(context.Background(), 132, 54105, SeasonMatchesNext, 1)
+	assert.NoError(t, err)
+	assert.Len(t, data.Events, 2)
+	assert.True(t, data.HasNextPage)
+}
+
+func TestClientCache(t *testing.T) {
+	var count atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count.Add(1)
+		_, _ = w.Write([]byte(`{"events":[{"id":1}]}`))
+	}))
+	defer server.Close()
+	client := newTestClient(server, WithCacheTTL(EndpointMatches, time.Hour))
+	date := time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		data, err := client.Matches(context.Background(), date)
+		assert.NoError(t, err)
+		assert.Len(t, data.Events, 1)
+	}
+	assert.Equal(t, int32(1), count.Load())
+	assert.Equal(t, int64(2), client.Quota().CacheHits)
+
+	// live feed is not cached
+	for i := 0; i < 2; i++ {
+		_, err := client.LiveMatches(context.Background())
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, int32(3), count.Load())
+}
+
+func TestClientRetries(t *testing.T) {
+	var count atomic.Int32
+	status := http.StatusBadGateway
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) < 3 {
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"team":{"id":7,"nameCode":"BOS"}}`))
+	}))
+	defer server.Close()
+	client := newTestClient(server)
+
+	// two failures are retried
+	data, err := client.Team(context.Background(), 7)
+	assert.NoError(t, err)
+	assert.Equal(t, "BOS", data.Team.NameCode)
+	assert.Equal(t, int32(3), count.Load())
+
+	// client errors are not retried
+	count.Store(0)
+	status = http.StatusNotFound
+	_, err = client.Lineups(context.Background(), 7)
+	assert.True(t, errors.Is(err, ErrUnexpectedStatus))
+	assert.Equal(t, int32(1), count.Load())
+	assert.Equal(t, int64(1), client.Quota().Failures)
+}
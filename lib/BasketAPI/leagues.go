@@ -0,0 +1,87 @@
+package BasketAPI
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// DEFAULT_LEAGUE is the league assumed when none is requested
+const DEFAULT_LEAGUE = "nba"
+
+// League is the competition covered by the service along with its current season in BasketAPI
+type League struct {
+	ID   string
+	Name string
+	// name of the tournament as reported by BasketAPI
+	Tournament string
+	// events are matched to the league by ID of their tournament
+	TournamentID uint
+	SeasonID     uint
+}
+
+func leagueFromModel(league *models.League) League {
+	return League{
+		ID:           league.ID,
+		Name:         league.Name,
+		Tournament:   league.Tournament,
+		TournamentID: uint(league.TournamentID),
+		SeasonID:     uint(league.SeasonID),
+	}
+}
+
+// EnabledLeagues retrieves leagues covered by the service ordered by ID
+func EnabledLeagues(ctx context.Context, exec boil.ContextExecutor) ([]League, error) {
+	records, err := models.Leagues(
+		models.LeagueWhere.Enabled.EQ(true),
+		qm.OrderBy(models.LeagueColumns.ID),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve leagues: %w", err)
+	}
+	leagues := make([]League, 0, len(records))
+	for _, record := range records {
+		leagues = append(leagues, leagueFromModel(record))
+	}
+	return leagues, nil
+}
+
+// FindLeague retrieves the enabled league, nil is returned for unknown (or disabled) leagues
+func FindLeague(ctx context.Context, exec boil.ContextExecutor, id string) (*League, error) {
+	record, err := models.Leagues(
+		models.LeagueWhere.ID.EQ(id),
+		models.LeagueWhere.Enabled.EQ(true),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve league %q: %w", id, err)
+	}
+	league := leagueFromModel(record)
+	return &league, nil
+}
+
+// LeagueOf reports the league of the event (nil if the event belongs to none of the leagues)
+func LeagueOf(leagues []League, ev Event) *League {
+	for i := range leagues {
+		if leagues[i].TournamentID == ev.Tournament.ID() {
+			return &leagues[i]
+		}
+	}
+	return nil
+}
+
+// TournamentIDs reports IDs of tournaments of the leagues
+func TournamentIDs(leagues []League) []int {
+	tournamentIds := make([]int, 0, len(leagues))
+	for _, league := range leagues {
+		tournamentIds = append(tournamentIds, int(league.TournamentID))
+	}
+	return tournamentIds
+}
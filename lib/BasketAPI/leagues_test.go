@@ -0,0 +1,26 @@
+package BasketAPI
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeagueOf(t *testing.T) {
+	leagues := []League{
+		{ID: "nba", Name: "NBA", Tournament: "NBA", TournamentID: 132, SeasonID: 54105},
+		{ID: "euroleague", Name: "EuroLeague", Tournament: "Euroleague", TournamentID: 138, SeasonID: 56828},
+	}
+	ev := Event{Tournament: Tournament{Name: "Euroleague", UniqueTournament: &UniqueTournament{ID: 138}}}
+	assert.Equal(t, &leagues[1], LeagueOf(leagues, ev))
+	// events are matched by ID, the name of the tournament is irrelevant
+	ev.Tournament.Name = "NBA"
+	assert.Equal(t, &leagues[1], LeagueOf(leagues, ev))
+	ev.Tournament = Tournament{Name: "NBA G League", UniqueTournament: &UniqueTournament{ID: 137}}
+	assert.Nil(t, LeagueOf(leagues, ev))
+	// events without tournament ID belong to no league
+	ev.Tournament = Tournament{Name: "NBA"}
+	assert.Nil(t, LeagueOf(leagues, ev))
+	assert.Nil(t, LeagueOf(nil, ev))
+	assert.Equal(t, []int{132, 138}, TournamentIDs(leagues))
+}
@@ -0,0 +1,269 @@
+package BasketAPI
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// PlayerProfile is the stored player along with the URL of ESPN headshot
+type PlayerProfile struct {
+	ID           uint
+	TeamID       *uint
+	Name         string
+	Slug         string
+	Position     string
+	JerseyNumber string
+	Headshot     *string
+}
+
+// PlayerAverages are per-game averages of the player over games played (shooting percentages are
+// computed from totals)
+type PlayerAverages struct {
+	GamesPlayed          int     `boil:"games_played" json:"gamesPlayed"`
+	Minutes              float64 `boil:"minutes" json:"min"`
+	Points               float64 `boil:"points" json:"pts"`
+	Rebounds             float64 `boil:"rebounds" json:"reb"`
+	OffensiveRebounds    float64 `boil:"offensive_rebounds" json:"oreb"`
+	DefensiveRebounds    float64 `boil:"defensive_rebounds" json:"dreb"`
+	Assists              float64 `boil:"assists" json:"ast"`
+	Steals               float64 `boil:"steals" json:"stl"`
+	Blocks               float64 `boil:"blocks" json:"blk"`
+	Turnovers            float64 `boil:"turnovers" json:"to"`
+	PersonalFouls        float64 `boil:"personal_fouls" json:"fp"`
+	FieldGoalPercentage  float64 `boil:"field_goal_percentage" json:"fgPct"`
+	ThreePointPercentage float64 `boil:"three_point_percentage" json:"tpPct"`
+	FreeThrowPercentage  float64 `boil:"free_throw_percentage" json:"ftPct"`
+	PlayerID             int     `boil:"player_id" json:"-"`
+}
+
+// PlayerGame is the stat line of the player in a finished game
+type PlayerGame struct {
+	Event  Event
+	TeamID uint
+	IsHome bool
+	Stats  PlayerStats
+}
+
+// StorePlayers upserts players of the team, the team of a player is the one of the latest stored box score
+func StorePlayers(ctx context.Context, exec boil.ContextExecutor, teamId uint, players []PlayerEntity) error {
+	for _, player := range players {
+		record := models.Player{
+			ID:           int(player.ID),
+			TeamID:       null.IntFrom(int(teamId)),
+			Name:         player.Name,
+			Slug:         player.Slug,
+			Position:     player.Position,
+			JerseyNumber: player.JerseyNumber,
+		}
+		if err := record.Upsert(
+			ctx,
+			exec,
+			true,
+			[]string{models.PlayerColumns.ID},
+			boil.Whitelist(
+				models.PlayerColumns.TeamID,
+				models.PlayerColumns.Name,
+				models.PlayerColumns.Slug,
+				models.PlayerColumns.Position,
+				models.PlayerColumns.JerseyNumber,
+				models.PlayerColumns.UpdatedAt,
+			),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("unable to store player %d: %w", player.ID, err)
+		}
+	}
+	return nil
+}
+
+// LinkPlayerImages links players to ESPN headshots matching by slug (preferred) or by name, either all players or
+// the requested players (if any) which are not linked yet
+func LinkPlayerImages(ctx context.Context, exec boil.ContextExecutor, ids ...uint) (int64, error) {
+	filter := ""
+	args := []interface{}{}
+	if len(ids) > 0 {
+		placeholders := make([]string, 0, len(ids))
+		for idx, id := range ids {
+			placeholders = append(placeholders, fmt.Sprintf("$%d", idx+1))
+			args = append(args, int(id))
+		}
+		filter = "where p2.image_id is null and p2.id in (" + strings.Join(placeholders, ", ") + ")"
+	}
+	result, err := queries.Raw(
+		`update players p set image_id = m.image_id, updated_at = now()
+		from (
+			select distinct on (p2.id) p2.id as player_id, i.id as image_id
+			from players p2
+			join images i on i.parent_id is not null
+			and (i.slug = p2.slug or lower(i.display_name) = lower(p2.name))
+			`+filter+`
+			order by p2.id, (i.slug = p2.slug) desc, i.id
+		) m
+		where m.player_id = p.id
+		and p.image_id is distinct from m.image_id`,
+		args...,
+	).ExecContext(ctx, exec)
+	if err != nil {
+		return 0, fmt.Errorf("unable to link players to headshots: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// FindPlayers retrieves stored players ordered by name, either players of the team (if `teamId` is not 0)
+// or the players requested by IDs (if any) or all of them
+func FindPlayers(ctx context.Context, exec boil.ContextExecutor, teamId uint, ids ...uint) ([]PlayerProfile, error) {
+	queryMods := []qm.QueryMod{
+		qm.OrderBy(models.PlayerColumns.Name + ", " + models.PlayerColumns.ID),
+	}
+	if teamId != 0 {
+		queryMods = append(queryMods, models.PlayerWhere.TeamID.EQ(null.IntFrom(int(teamId))))
+	}
+	if len(ids) > 0 {
+		playerIds := make([]int, 0, len(ids))
+		for _, id := range ids {
+			playerIds = append(playerIds, int(id))
+		}
+		queryMods = append(queryMods, models.PlayerWhere.ID.IN(playerIds))
+	}
+	players, err := models.Players(queryMods...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve players: %w", err)
+	}
+	imageIds := []string{}
+	for _, player := range players {
+		if player.ImageID.Valid {
+			imageIds = append(imageIds, player.ImageID.String)
+		}
+	}
+	headshots := map[string]string{}
+	if len(imageIds) > 0 {
+		images, err := models.Images(models.ImageWhere.ID.IN(imageIds)).All(ctx, exec)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve headshots: %w", err)
+		}
+		for _, image := range images {
+			headshots[image.ID] = image.ImageURL
+		}
+	}
+	profiles := make([]PlayerProfile, 0, len(players))
+	for _, player := range players {
+		profile := PlayerProfile{
+			ID:           uint(player.ID),
+			Name:         player.Name,
+			Slug:         player.Slug,
+			Position:     player.Position,
+			JerseyNumber: player.JerseyNumber,
+		}
+		if player.TeamID.Valid {
+			teamId := uint(player.TeamID.Int)
+			profile.TeamID = &teamId
+		}
+		if headshot, ok := headshots[player.ImageID.String]; ok {
+			profile.Headshot = &headshot
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// SeasonAverages aggregates stored box scores of finished games of the current season of enabled leagues (as
+// configured in `leagues`) into per-game averages of the players (games the player did not play are skipped)
+func SeasonAverages(ctx context.Context, exec boil.ContextExecutor, ids []uint) (map[uint]PlayerAverages, error) {
+	playerIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		playerIds = append(playerIds, int64(id))
+	}
+	averages := []PlayerAverages{}
+	if err := queries.Raw(
+		`select
+			s.player_id,
+			count(*) as games_played,
+			round(avg(s.seconds_played) / 60.0, 1) as minutes,
+			round(avg(s.points), 1) as points,
+			round(avg(s.rebounds), 1) as rebounds,
+			round(avg(s.offensive_rebounds), 1) as offensive_rebounds,
+			round(avg(s.defensive_rebounds), 1) as defensive_rebounds,
+			round(avg(s.assists), 1) as assists,
+			round(avg(s.steals), 1) as steals,
+			round(avg(s.blocks), 1) as blocks,
+			round(avg(s.turnovers), 1) as turnovers,
+			round(avg(s.personal_fouls), 1) as personal_fouls,
+			coalesce(round(sum(s.field_goals_made)::numeric / nullif(sum(s.field_goal_attempts), 0), 3), 0) as field_goal_percentage,
+			coalesce(round(sum(s.three_points_made)::numeric / nullif(sum(s.three_point_attempts), 0), 3), 0) as three_point_percentage,
+			coalesce(round(sum(s.free_throws_made)::numeric / nullif(sum(s.free_throw_attempts), 0), 3), 0) as free_throw_percentage
+		from game_player_stats s
+		join games g on g.id = s.game_id
+		join leagues l on l.tournament_id = g.tournament_id and l.season_id = g.season_id and l.enabled
+		where s.player_id = any($1)
+		and s.seconds_played > 0
+		and g.status_type = $2
+		group by s.player_id`,
+		playerIds,
+		string(StatusType_Finished),
+	).Bind(ctx, exec, &averages); err != nil {
+		return nil, fmt.Errorf("unable to aggregate stats of players: %w", err)
+	}
+	result := make(map[uint]PlayerAverages, len(averages))
+	for _, average := range averages {
+		result[uint(average.PlayerID)] = average
+	}
+	return result, nil
+}
+
+func playerStatsFromModel(playerStat *models.GamePlayerStat) PlayerStats {
+	return PlayerStats{
+		MinutesPlayed:      float64(playerStat.SecondsPlayed) / 60.0,
+		SecondsPlayed:      uint(playerStat.SecondsPlayed),
+		FieldGoalsMade:     uint(playerStat.FieldGoalsMade),
+		FieldGoalAttempts:  uint(playerStat.FieldGoalAttempts),
+		ThreePointsMade:    uint(playerStat.ThreePointsMade),
+		ThreePointAttempts: uint(playerStat.ThreePointAttempts),
+		FreeThrowsMade:     uint(playerStat.FreeThrowsMade),
+		FreeThrowAttempts:  uint(playerStat.FreeThrowAttempts),
+		OffensiveRebounds:  uint(playerStat.OffensiveRebounds),
+		DefensiveRebounds:  uint(playerStat.DefensiveRebounds),
+		Rebounds:           uint(playerStat.Rebounds),
+		Assists:            uint(playerStat.Assists),
+		Steals:             uint(playerStat.Steals),
+		Blocks:             uint(playerStat.Blocks),
+		Turnovers:          uint(playerStat.Turnovers),
+		PersonalFouls:      uint(playerStat.PersonalFouls),
+		Points:             uint(playerStat.Points),
+	}
+}
+
+// PlayerGames retrieves stat lines of the player in finished games, the latest first
+func PlayerGames(ctx context.Context, exec boil.ContextExecutor, playerId uint, limit int) ([]PlayerGame, error) {
+	playerStats, err := models.GamePlayerStats(
+		qm.InnerJoin(models.TableNames.Games+" g on g.id = "+models.TableNames.GamePlayerStats+"."+models.GamePlayerStatColumns.GameID),
+		models.GamePlayerStatWhere.PlayerID.EQ(int(playerId)),
+		qm.Where("g."+models.GameColumns.StatusType+" = ?", string(StatusType_Finished)),
+		qm.Load(models.GamePlayerStatRels.Game),
+		qm.OrderBy("g."+models.GameColumns.StartAt+" desc"),
+		qm.Limit(limit),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve games of player %d: %w", playerId, err)
+	}
+	games := make([]PlayerGame, 0, len(playerStats))
+	for _, playerStat := range playerStats {
+		ev, err := EventFromModel(playerStat.R.Game)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, PlayerGame{
+			Event:  ev,
+			TeamID: uint(playerStat.TeamID),
+			IsHome: playerStat.IsHome,
+			Stats:  playerStatsFromModel(playerStat),
+		})
+	}
+	return games, nil
+}
@@ -0,0 +1,104 @@
+package BasketAPI
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/stretchr/testify/assert"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+type PlayersTestCases struct {
+	suite.TestSuite
+}
+
+func TestPlayersRunner(t *testing.T) {
+	suite.RunSuite(
+		t,
+		&PlayersTestCases{
+			TestSuite: suite.TestSuite{
+				DBStore: suite.NewDBs(),
+			},
+		},
+		true,
+	)
+}
+
+func (tc *PlayersTestCases) TestSeasonAverages(t *testing.T) {
+	// 1. Store games of the current NBA season (seeded league) along with games of other seasons/tournaments
+	db := tc.DBStore.RetrieveDB(t.Name())
+	ctx := context.Background()
+	euroleague := models.League{
+		ID:           "euroleague",
+		Name:         "EuroLeague",
+		Tournament:   "Euroleague",
+		TournamentID: 138,
+		SeasonID:     56828,
+		Enabled:      false,
+	}
+	if err := euroleague.Insert(ctx, db, boil.Greylist(models.LeagueColumns.Enabled)); err != nil {
+		t.Fatalf("unable to store league: %s", err)
+	}
+	start := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	game := func(id uint, tournament string, tournamentId uint, seasonId uint, status StatusType) {
+		ev := Event{
+			ID:             id,
+			Tournament:     Tournament{Name: tournament, UniqueTournament: &UniqueTournament{ID: tournamentId}},
+			Season:         &Season{ID: seasonId},
+			Status:         Status{Type: status},
+			HomeTeam:       TeamId{ID: 3409},
+			AwayTeam:       TeamId{ID: 3410},
+			StartTimestamp: start.Add(time.Duration(id) * time.Hour).Unix(),
+		}
+		if _, err := StoreGame(ctx, db, ev); err != nil {
+			t.Fatalf("unable to store game %d: %s", id, err)
+		}
+	}
+	game(1, "NBA", 132, 54105, StatusType_Finished)
+	game(2, "NBA", 132, 54105, StatusType_Finished)
+	// the previous season
+	game(3, "NBA", 132, 45096, StatusType_Finished)
+	// another tournament of the same name
+	game(4, "NBA", 137, 54105, StatusType_Finished)
+	// the league is disabled
+	game(5, "Euroleague", 138, 56828, StatusType_Finished)
+	// the game is not finished yet
+	game(6, "NBA", 132, 54105, StatusType_Inprogress)
+	statLine := func(gameId uint, playerId uint, seconds int, made int, attempts int, points int) {
+		record := models.GamePlayerStat{
+			GameID:            int(gameId),
+			TeamID:            3409,
+			PlayerID:          int(playerId),
+			PlayerName:        "Player",
+			IsHome:            true,
+			SecondsPlayed:     seconds,
+			FieldGoalsMade:    made,
+			FieldGoalAttempts: attempts,
+			Points:            points,
+		}
+		if err := record.Insert(ctx, db, boil.Infer()); err != nil {
+			t.Fatalf("unable to store stats of player %d: %s", playerId, err)
+		}
+	}
+	statLine(1, 7, 1800, 8, 16, 20)
+	statLine(2, 7, 1440, 4, 8, 10)
+	for gameId := uint(3); gameId <= 6; gameId++ {
+		statLine(gameId, 7, 2400, 20, 20, 50)
+	}
+	// the player did not play
+	statLine(1, 8, 0, 0, 0, 0)
+	statLine(3, 8, 1200, 5, 10, 12)
+	// 2. Only finished games of the current season of enabled leagues are aggregated
+	averages, err := SeasonAverages(ctx, db, []uint{7, 8})
+	if err != nil {
+		t.Fatalf("unable to aggregate stats: %s", err)
+	}
+	assert.Len(t, averages, 1)
+	assert.Equal(t, 2, averages[7].GamesPlayed)
+	assert.Equal(t, 27.0, averages[7].Minutes)
+	assert.Equal(t, 15.0, averages[7].Points)
+	assert.Equal(t, 0.5, averages[7].FieldGoalPercentage)
+}
@@ -0,0 +1,192 @@
+package BasketAPI
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// STREAK_LOOKBACK limits stored games used to compute streaks of teams
+const STREAK_LOOKBACK = 365 * 24 * time.Hour
+
+// TeamStanding is the standing of the team in its conference
+type TeamStanding struct {
+	TeamID        uint
+	Conference    string
+	Division      string
+	Position      uint
+	Wins          uint
+	Losses        uint
+	WinPercentage float64
+	GamesBack     float64
+}
+
+// groupName strips the season prefix of standing group names, e.g. "NBA 23/24, Eastern Conference"
+func groupName(name string) string {
+	if idx := strings.LastIndex(name, ", "); idx != -1 {
+		return name[idx+2:]
+	}
+	return name
+}
+
+// ParseStandings combines conference and division tables of total standings into standings of teams,
+// games back are counted from the conference leader
+func ParseStandings(response *Standings) []TeamStanding {
+	divisions := map[uint]string{}
+	var conferences []Standing
+	for _, standing := range response.Standings {
+		if standing.Type != "total" {
+			continue
+		}
+		if strings.Contains(standing.Name, "Division") {
+			for _, row := range standing.Rows {
+				divisions[row.Team.ID] = groupName(standing.Name)
+			}
+			continue
+		}
+		conferences = append(conferences, standing)
+	}
+	// teams listed in several tables (e.g. league-wide and conference ones) are taken from conference tables
+	sort.SliceStable(conferences, func(i, j int) bool {
+		return strings.Contains(conferences[i].Name, "Conference") && !strings.Contains(conferences[j].Name, "Conference")
+	})
+	seen := map[uint]struct{}{}
+	var result []TeamStanding
+	for _, conference := range conferences {
+		// the leader has the best wins/losses difference
+		leader := 0
+		for idx, row := range conference.Rows {
+			if int(row.Wins)-int(row.Losses) > int(conference.Rows[leader].Wins)-int(conference.Rows[leader].Losses) {
+				leader = idx
+			}
+		}
+		for idx, row := range conference.Rows {
+			if _, ok := seen[row.Team.ID]; ok {
+				continue
+			}
+			seen[row.Team.ID] = struct{}{}
+			teamStanding := TeamStanding{
+				TeamID:     row.Team.ID,
+				Conference: groupName(conference.Name),
+				Division:   divisions[row.Team.ID],
+				Position:   row.Position,
+				Wins:       row.Wins,
+				Losses:     row.Losses,
+			}
+			if teamStanding.Position == 0 {
+				teamStanding.Position = uint(idx + 1)
+			}
+			if played := row.Wins + row.Losses; played > 0 {
+				teamStanding.WinPercentage = math.Round(float64(row.Wins)/float64(played)*1000) / 1000
+			}
+			leaderRow := conference.Rows[leader]
+			teamStanding.GamesBack = float64(int(leaderRow.Wins)-int(row.Wins)+int(row.Losses)-int(leaderRow.Losses)) / 2
+			result = append(result, teamStanding)
+		}
+	}
+	return result
+}
+
+// Streak reports the current streak of the team ("W3", "L1") based on its finished games, the most recent first
+func Streak(teamId uint, games []Event) string {
+	kind := ""
+	count := 0
+	for _, ev := range games {
+		if ev.HomeScore.Current == nil || ev.AwayScore.Current == nil {
+			continue
+		}
+		isHome := ev.HomeTeam.ID == teamId
+		if !isHome && ev.AwayTeam.ID != teamId {
+			continue
+		}
+		won := (*ev.HomeScore.Current > *ev.AwayScore.Current) == isHome
+		result := "L"
+		if won {
+			result = "W"
+		}
+		if kind == "" {
+			kind = result
+		}
+		if result != kind {
+			break
+		}
+		count++
+	}
+	if count == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s%d", kind, count)
+}
+
+// SyncStandings retrieves total standings of the tournament season and stores them (replacing previous ones),
+// streaks are computed from stored games
+func (client *Client) SyncStandings(ctx context.Context, db boil.ContextBeginner, tournamentId uint, seasonId uint) error {
+	response, err := client.Standings(ctx, tournamentId, seasonId)
+	if err != nil {
+		return err
+	}
+	teamStandings := ParseStandings(response)
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("unable to create an SQL transaction: %w", err)
+	}
+	finishedGames, err := models.Games(
+		models.GameWhere.StatusType.EQ(string(StatusType_Finished)),
+		models.GameWhere.StartAt.GTE(time.Now().Add(-STREAK_LOOKBACK)),
+		qm.OrderBy(models.GameColumns.StartAt+" DESC"),
+	).All(ctx, tx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("unable to retrieve finished games: %w", err)
+	}
+	gamesOfTeam := map[uint][]Event{}
+	for _, game := range finishedGames {
+		ev, err := EventFromModel(game)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		gamesOfTeam[ev.HomeTeam.ID] = append(gamesOfTeam[ev.HomeTeam.ID], ev)
+		gamesOfTeam[ev.AwayTeam.ID] = append(gamesOfTeam[ev.AwayTeam.ID], ev)
+	}
+	if _, err := models.Standings(
+		models.StandingWhere.TournamentID.EQ(int(tournamentId)),
+		models.StandingWhere.SeasonID.EQ(int(seasonId)),
+	).DeleteAll(ctx, tx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("unable to clean up standings: %w", err)
+	}
+	for _, teamStanding := range teamStandings {
+		standing := models.Standing{
+			TournamentID:  int(tournamentId),
+			SeasonID:      int(seasonId),
+			TeamID:        int(teamStanding.TeamID),
+			Conference:    teamStanding.Conference,
+			Position:      int(teamStanding.Position),
+			Wins:          int(teamStanding.Wins),
+			Losses:        int(teamStanding.Losses),
+			WinPercentage: teamStanding.WinPercentage,
+			GamesBack:     teamStanding.GamesBack,
+			Streak:        Streak(teamStanding.TeamID, gamesOfTeam[teamStanding.TeamID]),
+		}
+		if teamStanding.Division != "" {
+			standing.Division = null.StringFrom(teamStanding.Division)
+		}
+		if err := standing.Insert(ctx, tx, boil.Infer()); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("unable to store standing of team %d: %w", teamStanding.TeamID, err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("unable to commit an SQL transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package BasketAPI
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStandings(t *testing.T) {
+	var data Standings
+	assert.NoError(t, json.Unmarshal([]byte(`{"standings": [
+		{"type": "total", "name": "NBA 23/24", "rows": [
+			{"team": {"id": 1}, "position": 1, "wins": 50, "losses": 10},
+			{"team": {"id": 3}, "position": 2, "wins": 41, "losses": 21}
+		]},
+		{"type": "total", "name": "NBA 23/24, Eastern Conference", "rows": [
+			{"team": {"id": 1}, "position": 1, "wins": 50, "losses": 10},
+			{"team": {"id": 2}, "position": 2, "wins": 45, "losses": 15}
+		]},
+		{"type": "total", "name": "NBA 23/24, Atlantic Division", "rows": [
+			{"team": {"id": 2}, "position": 1, "wins": 45, "losses": 15}
+		]},
+		{"type": "home", "name": "NBA 23/24, Eastern Conference", "rows": [
+			{"team": {"id": 1}, "position": 1, "wins": 30, "losses": 0}
+		]}
+	]}`), &data))
+
+	standings := ParseStandings(&data)
+	assert.Equal(t, []TeamStanding{
+		{TeamID: 1, Conference: "Eastern Conference", Position: 1, Wins: 50, Losses: 10, WinPercentage: 0.833},
+		{TeamID: 2, Conference: "Eastern Conference", Division: "Atlantic Division", Position: 2, Wins: 45, Losses: 15, WinPercentage: 0.75, GamesBack: 5},
+		{TeamID: 3, Conference: "NBA 23/24", Position: 2, Wins: 41, Losses: 21, WinPercentage: 0.661, GamesBack: 10},
+	}, standings)
+}
+
+func TestStreak(t *testing.T) {
+	score := func(value uint) Score {
+		return Score{Current: &value}
+	}
+	game := func(home uint, away uint, homeScore uint, awayScore uint) Event {
+		return Event{
+			HomeTeam:  TeamId{ID: home},
+			AwayTeam:  TeamId{ID: away},
+			HomeScore: score(homeScore),
+			AwayScore: score(awayScore),
+		}
+	}
+	games := []Event{
+		game(1, 2, 100, 90),
+		game(3, 1, 99, 101),
+		game(1, 4, 80, 85),
+	}
+	assert.Equal(t, "W2", Streak(1, games))
+	assert.Equal(t, "L1", Streak(2, games))
+	assert.Equal(t, "", Streak(5, games))
+}
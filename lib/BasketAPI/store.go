@@ -0,0 +1,465 @@
+package BasketAPI
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/models"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// StoredGame is the game along with its box score as stored in DB
+type StoredGame struct {
+	Event      Event
+	Teams      *GameTeamsStats
+	Players    *GamePlayers
+	BoxScoreAt *time.Time
+}
+
+// IsFinal reports if the stored game is finished and its final box score is stored, so it can be served from DB
+func (game *StoredGame) IsFinal() bool {
+	return game.Event.Status.Type == StatusType_Finished && game.BoxScoreAt != nil
+}
+
+func optionalInt(value *uint) null.Int {
+	if value == nil {
+		return null.Int{}
+	}
+	return null.IntFrom(int(*value))
+}
+
+// StoreGame upserts the game (schedule, status and score), the box score is kept intact
+func StoreGame(ctx context.Context, exec boil.ContextExecutor, ev Event) (*models.Game, error) {
+	event, err := json.Marshal(ev)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize game %d: %w", ev.ID, err)
+	}
+	game := models.Game{
+		ID:                int(ev.ID),
+		Tournament:        ev.Tournament.Name,
+		StatusCode:        int(ev.Status.Code),
+		StatusDescription: ev.Status.Description,
+		StatusType:        string(ev.Status.Type),
+		HomeTeamID:        int(ev.HomeTeam.ID),
+		AwayTeamID:        int(ev.AwayTeam.ID),
+		HomeScore:         optionalInt(ev.HomeScore.Current),
+		AwayScore:         optionalInt(ev.AwayScore.Current),
+		StartAt:           time.Unix(ev.StartTimestamp, 0),
+		Event:             event,
+	}
+	updateBlacklist := []string{models.GameColumns.ID, models.GameColumns.BoxScoreAt, models.GameColumns.CreatedAt}
+	if ev.Season != nil {
+		game.SeasonID = null.IntFrom(int(ev.Season.ID))
+	} else {
+		// the season already known is kept
+		updateBlacklist = append(updateBlacklist, models.GameColumns.SeasonID)
+	}
+	if tournamentId := ev.Tournament.ID(); tournamentId != 0 {
+		game.TournamentID = null.IntFrom(int(tournamentId))
+	} else {
+		updateBlacklist = append(updateBlacklist, models.GameColumns.TournamentID)
+	}
+	if err := game.Upsert(
+		ctx,
+		exec,
+		true,
+		[]string{models.GameColumns.ID},
+		boil.Blacklist(updateBlacklist...),
+		boil.Infer(),
+	); err != nil {
+		return nil, fmt.Errorf("unable to store game %d: %w", ev.ID, err)
+	}
+	// columns not touched by the upsert (e.g. `box_score_at`) are not reported back
+	if err := game.Reload(ctx, exec); err != nil {
+		return nil, fmt.Errorf("unable to reload game %d: %w", ev.ID, err)
+	}
+	return &game, nil
+}
+
+// StoreBoxScore replaces team and player statistics of the game
+func StoreBoxScore(ctx context.Context, exec boil.ContextExecutor, ev Event, teams *GameTeamsStats, players *GamePlayers) error {
+	gameId := int(ev.ID)
+	if _, err := models.GameTeamStats(models.GameTeamStatWhere.GameID.EQ(gameId)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("unable to clean up team stats of game %d: %w", gameId, err)
+	}
+	if _, err := models.GamePlayerStats(models.GamePlayerStatWhere.GameID.EQ(gameId)).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("unable to clean up player stats of game %d: %w", gameId, err)
+	}
+	sides := []struct {
+		teamId  uint
+		isHome  bool
+		stats   TeamStats
+		players []PlayerEntity
+	}{
+		{ev.HomeTeam.ID, true, teams.HomeTeam, players.HomeTeam},
+		{ev.AwayTeam.ID, false, teams.AwayTeam, players.AwayTeam},
+	}
+	for _, side := range sides {
+		teamStat := models.GameTeamStat{
+			GameID:             gameId,
+			TeamID:             int(side.teamId),
+			IsHome:             side.isHome,
+			Rebounds:           int(side.stats.Rebounds),
+			Assists:            int(side.stats.Assists),
+			Steals:             int(side.stats.Steals),
+			Blocks:             int(side.stats.Blocks),
+			Turnovers:          int(side.stats.Turnovers),
+			Fouls:              int(side.stats.Fouls),
+			FieldGoalsMade:     int(side.stats.FieldGoalsMade),
+			FieldGoalAttempts:  int(side.stats.FieldGoalAttempts),
+			ThreePointsMade:    int(side.stats.ThreePointsMade),
+			ThreePointAttempts: int(side.stats.ThreePointAttempts),
+			FreeThrowsMade:     int(side.stats.FreeThrowsMade),
+			FreeThrowAttempts:  int(side.stats.FreeThrowAttempts),
+		}
+		if err := teamStat.Insert(ctx, exec, boil.Infer()); err != nil {
+			return fmt.Errorf("unable to store team stats of game %d: %w", gameId, err)
+		}
+		if err := StorePlayers(ctx, exec, side.teamId, side.players); err != nil {
+			return err
+		}
+		for idx, player := range side.players {
+			playerStat := models.GamePlayerStat{
+				GameID:             gameId,
+				TeamID:             int(side.teamId),
+				PlayerID:           int(player.ID),
+				PlayerName:         player.Name,
+				IsHome:             side.isHome,
+				Position:           idx,
+				SecondsPlayed:      int(player.Stats.SecondsPlayed),
+				FieldGoalsMade:     int(player.Stats.FieldGoalsMade),
+				FieldGoalAttempts:  int(player.Stats.FieldGoalAttempts),
+				ThreePointsMade:    int(player.Stats.ThreePointsMade),
+				ThreePointAttempts: int(player.Stats.ThreePointAttempts),
+				FreeThrowsMade:     int(player.Stats.FreeThrowsMade),
+				FreeThrowAttempts:  int(player.Stats.FreeThrowAttempts),
+				OffensiveRebounds:  int(player.Stats.OffensiveRebounds),
+				DefensiveRebounds:  int(player.Stats.DefensiveRebounds),
+				Rebounds:           int(player.Stats.Rebounds),
+				Assists:            int(player.Stats.Assists),
+				Steals:             int(player.Stats.Steals),
+				Blocks:             int(player.Stats.Blocks),
+				Turnovers:          int(player.Stats.Turnovers),
+				PersonalFouls:      int(player.Stats.PersonalFouls),
+				Points:             int(player.Stats.Points),
+			}
+			if err := playerStat.Insert(ctx, exec, boil.Infer()); err != nil {
+				return fmt.Errorf("unable to store stats of player %d in game %d: %w", player.ID, gameId, err)
+			}
+		}
+	}
+	if _, err := models.Games(models.GameWhere.ID.EQ(gameId)).UpdateAll(ctx, exec, models.M{
+		models.GameColumns.BoxScoreAt: null.TimeFrom(time.Now()),
+	}); err != nil {
+		return fmt.Errorf("unable to update game %d: %w", gameId, err)
+	}
+	// headshots of newly seen players of the game (the espn crawler links all of them)
+	playerIds := []uint{}
+	for _, side := range sides {
+		for _, player := range side.players {
+			playerIds = append(playerIds, player.ID)
+		}
+	}
+	if len(playerIds) == 0 {
+		return nil
+	}
+	if _, err := LinkPlayerImages(ctx, exec, playerIds...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SyncGame retrieves the game along with its box score from BasketAPI and stores them in a single transaction
+func (client *Client) SyncGame(ctx context.Context, db boil.ContextBeginner, gameId uint) (*StoredGame, error) {
+	match, err := client.Match(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	statistics, err := client.Statistics(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := ParseTeamStats(statistics)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse statistics of game %d: %w", gameId, err)
+	}
+	lineups, err := client.Lineups(ctx, gameId)
+	if err != nil {
+		return nil, err
+	}
+	players := ParsePlayers(lineups)
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create an SQL transaction: %w", err)
+	}
+	if _, err := StoreGame(ctx, tx, match.Event); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := StoreBoxScore(ctx, tx, match.Event, teams, players); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("unable to commit an SQL transaction: %w", err)
+	}
+	now := time.Now()
+	return &StoredGame{
+		Event:      match.Event,
+		Teams:      teams,
+		Players:    players,
+		BoxScoreAt: &now,
+	}, nil
+}
+
+// EventFromModel restores BasketAPI event of the stored game
+func EventFromModel(game *models.Game) (Event, error) {
+	var ev Event
+	if err := game.Event.Unmarshal(&ev); err != nil {
+		return ev, fmt.Errorf("unable to parse stored game %d: %w", game.ID, err)
+	}
+	// events stored before the tournament ID was recorded get it from the column (backfilled by leagues)
+	if ev.Tournament.UniqueTournament == nil && game.TournamentID.Valid {
+		ev.Tournament.UniqueTournament = &UniqueTournament{ID: uint(game.TournamentID.Int)}
+	}
+	return ev, nil
+}
+
+// LoadGame retrieves the game along with its box score (if stored), nil is returned for unknown games
+func LoadGame(ctx context.Context, exec boil.ContextExecutor, gameId uint) (*StoredGame, error) {
+	game, err := models.Games(
+		models.GameWhere.ID.EQ(int(gameId)),
+		qm.Load(models.GameRels.GameTeamStats),
+		qm.Load(models.GameRels.GamePlayerStats, qm.OrderBy(models.GamePlayerStatColumns.Position)),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve game %d: %w", gameId, err)
+	}
+	ev, err := EventFromModel(game)
+	if err != nil {
+		return nil, err
+	}
+	storedGame := StoredGame{
+		Event:      ev,
+		BoxScoreAt: game.BoxScoreAt.Ptr(),
+	}
+	if !game.BoxScoreAt.Valid {
+		return &storedGame, nil
+	}
+	storedGame.Teams = &GameTeamsStats{}
+	storedGame.Players = &GamePlayers{HomeTeam: []PlayerEntity{}, AwayTeam: []PlayerEntity{}}
+	for _, teamStat := range game.R.GameTeamStats {
+		stats := TeamStats{
+			Rebounds:           uint(teamStat.Rebounds),
+			Assists:            uint(teamStat.Assists),
+			Steals:             uint(teamStat.Steals),
+			Blocks:             uint(teamStat.Blocks),
+			Turnovers:          uint(teamStat.Turnovers),
+			Fouls:              uint(teamStat.Fouls),
+			FieldGoalsMade:     uint(teamStat.FieldGoalsMade),
+			FieldGoalAttempts:  uint(teamStat.FieldGoalAttempts),
+			ThreePointsMade:    uint(teamStat.ThreePointsMade),
+			ThreePointAttempts: uint(teamStat.ThreePointAttempts),
+			FreeThrowsMade:     uint(teamStat.FreeThrowsMade),
+			FreeThrowAttempts:  uint(teamStat.FreeThrowAttempts),
+		}
+		if teamStat.IsHome {
+			storedGame.Teams.HomeTeam = stats
+		} else {
+			storedGame.Teams.AwayTeam = stats
+		}
+	}
+	for _, playerStat := range game.R.GamePlayerStats {
+		player := PlayerEntity{
+			ID:    uint(playerStat.PlayerID),
+			Name:  playerStat.PlayerName,
+			Stats: playerStatsFromModel(playerStat),
+		}
+		if playerStat.IsHome {
+			storedGame.Players.HomeTeam = append(storedGame.Players.HomeTeam, player)
+		} else {
+			storedGame.Players.AwayTeam = append(storedGame.Players.AwayTeam, player)
+		}
+	}
+	return &storedGame, nil
+}
+
+// GameFilter narrows down the list of stored games, zero values are ignored
+type GameFilter struct {
+	TournamentID uint
+	// games started within [From, To)
+	From   time.Time
+	To     time.Time
+	TeamID uint
+	Status StatusType
+}
+
+// FindGames retrieves stored games matching the filter, ordered by start time
+func FindGames(ctx context.Context, exec boil.ContextExecutor, filter GameFilter) ([]Event, error) {
+	queryMods := []qm.QueryMod{
+		qm.OrderBy(models.GameColumns.StartAt + ", " + models.GameColumns.ID),
+	}
+	if filter.TournamentID != 0 {
+		queryMods = append(queryMods, models.GameWhere.TournamentID.EQ(null.IntFrom(int(filter.TournamentID))))
+	}
+	if !filter.From.IsZero() {
+		queryMods = append(queryMods, models.GameWhere.StartAt.GTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		queryMods = append(queryMods, models.GameWhere.StartAt.LT(filter.To))
+	}
+	if filter.TeamID != 0 {
+		queryMods = append(queryMods, qm.Expr(
+			models.GameWhere.HomeTeamID.EQ(int(filter.TeamID)),
+			qm.Or2(models.GameWhere.AwayTeamID.EQ(int(filter.TeamID))),
+		))
+	}
+	if filter.Status != "" {
+		queryMods = append(queryMods, models.GameWhere.StatusType.EQ(string(filter.Status)))
+	}
+	games, err := models.Games(queryMods...).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve games: %w", err)
+	}
+	events := make([]Event, 0, len(games))
+	for _, game := range games {
+		ev, err := EventFromModel(game)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+// ScheduleKnown reports if games of the current season of every league are stored (i.e. the crawler has stored
+// the season schedule), so a missing next game means none is scheduled
+func ScheduleKnown(ctx context.Context, exec boil.ContextExecutor, leagues []League) (bool, error) {
+	for _, league := range leagues {
+		found, err := models.Games(
+			models.GameWhere.TournamentID.EQ(null.IntFrom(int(league.TournamentID))),
+			models.GameWhere.SeasonID.EQ(null.IntFrom(int(league.SeasonID))),
+		).Exists(ctx, exec)
+		if err != nil {
+			return false, fmt.Errorf("unable to check %s schedule: %w", league.Name, err)
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// NextGameStart reports the earliest start of stored games of the tournaments which are not finished yet and
+// started since `from` (nil if none is scheduled)
+func NextGameStart(ctx context.Context, exec boil.ContextExecutor, tournamentIds []int, from time.Time) (*time.Time, error) {
+	if len(tournamentIds) == 0 {
+		return nil, nil
+	}
+	game, err := models.Games(
+		models.GameWhere.TournamentID.IN(tournamentIds),
+		models.GameWhere.StatusType.IN([]string{string(StatusType_Notstarted), string(StatusType_Inprogress)}),
+		models.GameWhere.StartAt.GTE(from),
+		qm.OrderBy(models.GameColumns.StartAt),
+	).One(ctx, exec)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve the next game: %w", err)
+	}
+	return &game.StartAt, nil
+}
+
+// gameDay truncates the time to the start of its day (UTC)
+func gameDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// CompleteGameDay reports if the day (UTC) of `day` is over at `now` and all the games of the tournament scheduled
+// for it are over (finished, postponed or canceled), `events` is expected to hold the complete schedule of the day
+func CompleteGameDay(events []Event, tournamentId uint, day time.Time, now time.Time) bool {
+	day = gameDay(day)
+	if now.Before(day.Add(24 * time.Hour)) {
+		return false
+	}
+	found := false
+	for _, ev := range events {
+		if ev.Tournament.ID() != tournamentId || !gameDay(time.Unix(ev.StartTimestamp, 0)).Equal(day) {
+			continue
+		}
+		if !ev.Status.Type.IsTerminal() {
+			return false
+		}
+		found = true
+	}
+	return found
+}
+
+// CompleteGameDays reports days (UTC) of the games of the tournament which are complete (see CompleteGameDay)
+func CompleteGameDays(events []Event, tournamentId uint, now time.Time) []time.Time {
+	days := []time.Time{}
+	seen := make(map[time.Time]bool)
+	for _, ev := range events {
+		day := gameDay(time.Unix(ev.StartTimestamp, 0))
+		if ev.Tournament.ID() != tournamentId || seen[day] {
+			continue
+		}
+		seen[day] = true
+		if CompleteGameDay(events, tournamentId, day, now) {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// StoreGameDays records days (UTC) whose complete schedule of the tournament is stored
+func StoreGameDays(ctx context.Context, exec boil.ContextExecutor, tournamentId uint, days []time.Time) error {
+	for _, day := range days {
+		record := models.GameDay{
+			TournamentID: int(tournamentId),
+			Day:          gameDay(day),
+			SyncedAt:     time.Now(),
+		}
+		if err := record.Upsert(
+			ctx,
+			exec,
+			true,
+			[]string{models.GameDayColumns.TournamentID, models.GameDayColumns.Day},
+			boil.Whitelist(models.GameDayColumns.SyncedAt),
+			boil.Infer(),
+		); err != nil {
+			return fmt.Errorf("unable to store game day %s of tournament %d: %w", record.Day.Format(time.DateOnly), tournamentId, err)
+		}
+	}
+	return nil
+}
+
+// GameDaysStored reports if complete schedule of the tournament is stored for every day (UTC) overlapping [from, to)
+func GameDaysStored(ctx context.Context, exec boil.ContextExecutor, tournamentId uint, from time.Time, to time.Time) (bool, error) {
+	days := int64(0)
+	for day := gameDay(from); day.Before(to); day = day.Add(24 * time.Hour) {
+		days++
+	}
+	if days == 0 {
+		return false, nil
+	}
+	count, err := models.GameDays(
+		models.GameDayWhere.TournamentID.EQ(int(tournamentId)),
+		models.GameDayWhere.Day.GTE(gameDay(from)),
+		models.GameDayWhere.Day.LT(to),
+	).Count(ctx, exec)
+	if err != nil {
+		return false, fmt.Errorf("unable to retrieve stored game days: %w", err)
+	}
+	return count == days, nil
+}
@@ -0,0 +1,41 @@
+package BasketAPI
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompleteGameDays(t *testing.T) {
+	day := time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+	game := func(tournamentId uint, start time.Time, status StatusType) Event {
+		return Event{
+			Tournament:     Tournament{UniqueTournament: &UniqueTournament{ID: tournamentId}},
+			Status:         Status{Type: status},
+			StartTimestamp: start.Unix(),
+		}
+	}
+	events := []Event{
+		game(132, day.Add(1*time.Hour), StatusType_Finished),
+		game(132, day.Add(23*time.Hour), StatusType_Finished),
+		// the next day has a game which never got its final status
+		game(132, day.Add(25*time.Hour), StatusType_Finished),
+		game(132, day.Add(26*time.Hour), StatusType_Notstarted),
+		// postponed and canceled games don't keep the day incomplete
+		game(132, day.Add(49*time.Hour), StatusType_Finished),
+		game(132, day.Add(50*time.Hour), StatusType_TypePostponed),
+		game(132, day.Add(51*time.Hour), StatusType_Canceled),
+		// other tournaments are ignored
+		game(486, day.Add(49*time.Hour), StatusType_Finished),
+	}
+	now := day.Add(72 * time.Hour)
+	assert.Equal(t, []time.Time{day, day.Add(48 * time.Hour)}, CompleteGameDays(events, 132, now))
+	// a day of postponed games only is complete as well
+	assert.True(t, CompleteGameDay([]Event{game(132, day.Add(1*time.Hour), StatusType_TypePostponed)}, 132, day, now))
+	assert.Equal(t, []time.Time{day.Add(48 * time.Hour)}, CompleteGameDays(events, 486, now))
+	// the day is not over yet
+	assert.False(t, CompleteGameDay(events, 132, day, day.Add(23*time.Hour)))
+	// days without games are not complete
+	assert.False(t, CompleteGameDay(events, 132, day.Add(-24*time.Hour), now))
+}
@@ -0,0 +1,133 @@
+package BasketAPI
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type TimelineEventType string
+
+const (
+	TimelineEvent_MadeShot     TimelineEventType = "made_shot"
+	TimelineEvent_Foul         TimelineEventType = "foul"
+	TimelineEvent_Timeout      TimelineEventType = "timeout"
+	TimelineEvent_PeriodEnd    TimelineEventType = "period_end"
+	TimelineEvent_Substitution TimelineEventType = "substitution"
+)
+
+type TimelinePlayer struct {
+	ID   uint   `json:"id"`
+	Name string `json:"name"`
+}
+
+// TimelineEvent is the incident of the game normalized into one of known types
+type TimelineEvent struct {
+	Key       string            `json:"key" doc:"stable identifier of the event within the game"`
+	Type      TimelineEventType `json:"type" enum:"made_shot,foul,timeout,period_end,substitution"`
+	Time      int               `json:"time" doc:"game time of the event (as reported by BasketAPI)"`
+	IsHome    *bool             `json:"isHome,omitempty" doc:"event of the home team (if applicable)"`
+	Points    uint              `json:"points,omitempty" doc:"points scored by the made shot"`
+	HomeScore *uint             `json:"homeScore,omitempty"`
+	AwayScore *uint             `json:"awayScore,omitempty"`
+	Player    *TimelinePlayer   `json:"player,omitempty"`
+	PlayerIn  *TimelinePlayer   `json:"playerIn,omitempty"`
+	PlayerOut *TimelinePlayer   `json:"playerOut,omitempty"`
+	Text      string            `json:"text,omitempty" doc:"e.g. period name of period_end events"`
+}
+
+// points scored by made shots per incident class
+var shotPoints = map[string]uint{
+	"onepoint":    1,
+	"freethrow":   1,
+	"twopoints":   2,
+	"threepoints": 3,
+}
+
+func timelineEventType(incident MI_Incident) (TimelineEventType, bool) {
+	switch strings.ToLower(incident.IncidentType) {
+	case "goal", "score", "point":
+		return TimelineEvent_MadeShot, true
+	case "foul", "card":
+		return TimelineEvent_Foul, true
+	case "timeout":
+		return TimelineEvent_Timeout, true
+	case "period":
+		return TimelineEvent_PeriodEnd, true
+	case "substitution":
+		return TimelineEvent_Substitution, true
+	}
+	return "", false
+}
+
+func timelinePlayer(player *ML_Player) *TimelinePlayer {
+	if player == nil {
+		return nil
+	}
+	return &TimelinePlayer{ID: player.ID, Name: player.Name}
+}
+
+func scoreKey(score *uint) string {
+	if score == nil {
+		return "-"
+	}
+	return fmt.Sprint(*score)
+}
+
+// timelineKey identifies the incident, the composite key is used for incidents reported without ID
+func timelineKey(eventType TimelineEventType, incident MI_Incident) string {
+	if incident.ID != 0 {
+		return fmt.Sprintf("%s:%d", eventType, incident.ID)
+	}
+	playerId := uint(0)
+	if incident.Player != nil {
+		playerId = incident.Player.ID
+	} else if incident.PlayerIn != nil {
+		playerId = incident.PlayerIn.ID
+	}
+	return fmt.Sprintf(
+		"%s:%d:%s:%d:%s:%s",
+		eventType,
+		incident.Time,
+		incident.Text,
+		playerId,
+		scoreKey(incident.HomeScore),
+		scoreKey(incident.AwayScore),
+	)
+}
+
+// ParseTimeline normalizes incidents of the match into timeline events ordered by game time,
+// incidents of unknown types are skipped
+func ParseTimeline(response *MI_Data) []TimelineEvent {
+	events := []TimelineEvent{}
+	for _, incident := range response.Incidents {
+		eventType, ok := timelineEventType(incident)
+		if !ok {
+			continue
+		}
+		event := TimelineEvent{
+			Key:       timelineKey(eventType, incident),
+			Type:      eventType,
+			Time:      incident.Time,
+			IsHome:    incident.IsHome,
+			HomeScore: incident.HomeScore,
+			AwayScore: incident.AwayScore,
+			Player:    timelinePlayer(incident.Player),
+			PlayerIn:  timelinePlayer(incident.PlayerIn),
+			PlayerOut: timelinePlayer(incident.PlayerOut),
+			Text:      incident.Text,
+		}
+		if eventType == TimelineEvent_MadeShot {
+			event.Points = shotPoints[strings.ToLower(incident.IncidentClass)]
+		}
+		events = append(events, event)
+	}
+	// BasketAPI reports the latest incidents first
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time < events[j].Time
+	})
+	return events
+}
@@ -0,0 +1,36 @@
+package BasketAPI
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeline(t *testing.T) {
+	var data MI_Data
+	assert.NoError(t, json.Unmarshal([]byte(`{"incidents": [
+		{"incidentType": "period", "text": "Q1", "time": 12},
+		{"id": 7, "incidentType": "goal", "incidentClass": "threePoints", "time": 11, "isHome": true, "homeScore": 5, "awayScore": 2, "player": {"id": 1, "name": "Shooter"}},
+		{"incidentType": "substitution", "time": 11, "isHome": false, "playerIn": {"id": 2, "name": "In"}, "playerOut": {"id": 3, "name": "Out"}},
+		{"incidentType": "injuryTime", "time": 10},
+		{"incidentType": "timeout", "time": 4, "isHome": false}
+	]}`), &data))
+
+	events := ParseTimeline(&data)
+	types := []TimelineEventType{}
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	assert.Equal(t, []TimelineEventType{
+		TimelineEvent_Timeout,
+		TimelineEvent_Substitution,
+		TimelineEvent_MadeShot,
+		TimelineEvent_PeriodEnd,
+	}, types)
+	assert.Equal(t, "made_shot:7", events[2].Key)
+	assert.Equal(t, uint(3), events[2].Points)
+	assert.Equal(t, &TimelinePlayer{ID: 1, Name: "Shooter"}, events[2].Player)
+	assert.Equal(t, "substitution:11::2:-:-", events[1].Key)
+	assert.Equal(t, "Q1", events[3].Text)
+}
@@ -0,0 +1,268 @@
+package BasketAPI
+
+type Event struct {
+	Tournament     Tournament `json:"tournament"`
+	Season         *Season    `json:"season,omitempty"`
+	Status         Status     `json:"status"`
+	HomeTeam       TeamId     `json:"homeTeam"`
+	AwayTeam       TeamId     `json:"awayTeam"`
+	HomeScore      Score      `json:"homeScore"`
+	AwayScore      Score      `json:"awayScore"`
+	Time           Time       `json:"time"`
+	ID             uint       `json:"id"`
+	StartTimestamp int64      `json:"startTimestamp"`
+}
+type TeamId struct {
+	ID uint `json:"id"`
+}
+type Score struct {
+	Current *uint `json:"current,omitempty"`
+	Display *uint `json:"display,omitempty"`
+}
+type Time struct {
+	Played                      *int `json:"played,omitempty"`
+	PeriodLength                *int `json:"periodLength,omitempty"`
+	OvertimeLength              *int `json:"overtimeLength,omitempty"`
+	TotalPeriodCount            *int `json:"totalPeriodCount,omitempty"`
+	CurrentPeriodStartTimestamp *int `json:"currentPeriodStartTimestamp,omitempty"`
+}
+type Tournament struct {
+	Name             string            `json:"name"`
+	UniqueTournament *UniqueTournament `json:"uniqueTournament,omitempty"`
+}
+
+// UniqueTournament is the competition across seasons, its ID is the one leagues are configured with (e.g. 132 for NBA)
+type UniqueTournament struct {
+	ID uint `json:"id"`
+}
+
+// ID reports ID of the (unique) tournament, 0 if unknown
+func (t Tournament) ID() uint {
+	if t.UniqueTournament == nil {
+		return 0
+	}
+	return t.UniqueTournament.ID
+}
+
+type Season struct {
+	ID   uint   `json:"id"`
+	Year string `json:"year,omitempty"`
+}
+type Status struct {
+	Code        uint       `json:"code"`
+	Description string     `json:"description"`
+	Type        StatusType `json:"type"`
+}
+
+type StatusType string
+
+const (
+	StatusType_Finished      StatusType = "finished"
+	StatusType_Inprogress    StatusType = "inprogress"
+	StatusType_Notstarted    StatusType = "notstarted"
+	StatusType_TypePostponed StatusType = "postponed"
+	StatusType_Canceled      StatusType = "canceled"
+)
+
+// IsTerminal reports if the game won't change anymore on the scheduled date (finished, postponed or canceled)
+func (t StatusType) IsTerminal() bool {
+	switch t {
+	case StatusType_Finished, StatusType_TypePostponed, StatusType_Canceled:
+		return true
+	}
+	return false
+}
+
+// -- Matches (MS) API
+
+type MS_Data struct {
+	Events []Event `json:"events"`
+}
+
+// -- SeasonMatches (SM) API
+
+type SM_Data struct {
+	Events      []Event `json:"events"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+// -- LiveMatches (LM) API
+
+type LM_Data struct {
+	Events []Event `json:"events"`
+}
+
+// -- MatchLineups (ML) API
+
+type ML_Data struct {
+	Home ML_Team `json:"home"`
+	Away ML_Team `json:"away"`
+}
+
+type ML_Team struct {
+	Players []ML_PlayerElement `json:"players"`
+}
+
+type ML_PlayerElement struct {
+	Player     ML_Player     `json:"player"`
+	Statistics ML_Statistics `json:"statistics"`
+}
+
+type ML_Player struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	ShortName    string `json:"shortName"`
+	Position     string `json:"position"`
+	JerseyNumber string `json:"jerseyNumber"`
+	ID           uint   `json:"id"`
+}
+
+type ML_Statistics struct {
+	SecondsPlayed      uint `json:"secondsPlayed"`
+	FieldGoalsMade     uint `json:"fieldGoalsMade"`
+	FieldGoalAttempts  uint `json:"fieldGoalAttempts"`
+	ThreePointsMade    uint `json:"threePointsMade"`
+	ThreePointAttempts uint `json:"threePointAttempts"`
+	FreeThrowsMade     uint `json:"freeThrowsMade"`
+	FreeThrowAttempts  uint `json:"freeThrowAttempts"`
+	OffensiveRebounds  uint `json:"offensiveRebounds"`
+	DefensiveRebounds  uint `json:"defensiveRebounds"`
+	Rebounds           uint `json:"rebounds"`
+	Assists            uint `json:"assists"`
+	Steals             uint `json:"steals"`
+	Blocks             uint `json:"blocks"`
+	Turnovers          uint `json:"turnovers"`
+	PersonalFouls      uint `json:"personalFouls"`
+	Points             uint `json:"points"`
+	// -- currently unused
+	// TwoPointsMade    uint `json:"twoPointsMade"`
+	// TwoPointAttempts uint `json:"twoPointAttempts"`
+	// PlusMinus        uint `json:"plusMinus"`
+}
+
+// -- Match (MD) API
+
+type MD_Data struct {
+	Event Event `json:"event"`
+}
+
+// -- MatchStatistics (MStat) API
+
+type MStat_Data struct {
+	Statistics []MStat_StatEntry `json:"statistics"`
+}
+
+type MStat_StatEntry struct {
+	Period string        `json:"period"`
+	Groups []MStat_Group `json:"groups"`
+}
+
+type MStat_Group struct {
+	GroupName       MStat_GroupName   `json:"groupName"`
+	StatisticsItems []MStat_GroupItem `json:"statisticsItems"`
+}
+
+type MStat_GroupName string
+
+const (
+	MStat_GroupName_Lead    MStat_GroupName = "Lead"
+	MStat_GroupName_Other   MStat_GroupName = "Other"
+	MStat_GroupName_Scoring MStat_GroupName = "Scoring"
+)
+
+type MStat_GroupItem struct {
+	Name      MStat_GroupItemName `json:"name"`
+	HomeValue uint                `json:"homeValue"`
+	AwayValue uint                `json:"awayValue"`
+	HomeTotal *uint               `json:"homeTotal,omitempty"`
+	AwayTotal *uint               `json:"awayTotal,omitempty"`
+}
+
+type MStat_GroupItemName string
+
+const (
+	// Other
+	MStat_GroupItemName_OtherRebounds  MStat_GroupItemName = "Rebounds"
+	MStat_GroupItemName_OtherAssists   MStat_GroupItemName = "Assists"
+	MStat_GroupItemName_OtherTurnovers MStat_GroupItemName = "Turnovers"
+	MStat_GroupItemName_OtherSteals    MStat_GroupItemName = "Steals"
+	MStat_GroupItemName_OtherBlocks    MStat_GroupItemName = "Blocks"
+	MStat_GroupItemName_OtherFouls     MStat_GroupItemName = "Fouls"
+	// Scoring
+	MStat_GroupItemName_ScoringFreeThrows  MStat_GroupItemName = "Free throws"
+	MStat_GroupItemName_ScoringTwoPoints   MStat_GroupItemName = "2 pointers"
+	MStat_GroupItemName_ScoringThreePoints MStat_GroupItemName = "3 pointers"
+	MStat_GroupItemName_ScoringFieldGoals  MStat_GroupItemName = "Field goals"
+)
+
+// -- MatchIncidents (MI) API
+
+type MI_Data struct {
+	Incidents []MI_Incident `json:"incidents"`
+}
+
+type MI_Incident struct {
+	ID            uint       `json:"id"`
+	IncidentType  string     `json:"incidentType"`
+	IncidentClass string     `json:"incidentClass"`
+	Text          string     `json:"text"`
+	Time          int        `json:"time"`
+	IsHome        *bool      `json:"isHome,omitempty"`
+	HomeScore     *uint      `json:"homeScore,omitempty"`
+	AwayScore     *uint      `json:"awayScore,omitempty"`
+	Player        *ML_Player `json:"player,omitempty"`
+	PlayerIn      *ML_Player `json:"playerIn,omitempty"`
+	PlayerOut     *ML_Player `json:"playerOut,omitempty"`
+}
+
+// -- Season standing API
+
+type Standings struct {
+	Standings []Standing `json:"standings"`
+}
+
+type Standing struct {
+	Type               string        `json:"type"`
+	Name               string        `json:"name"`
+	Rows               []StandingRow `json:"rows"`
+	UpdatedAtTimestamp uint          `json:"updatedAtTimestamp"`
+}
+
+type StandingRow struct {
+	Team     StandingTeam `json:"team"`
+	Position uint         `json:"position"`
+	Matches  uint         `json:"matches"`
+	Wins     uint         `json:"wins"`
+	Losses   uint         `json:"losses"`
+}
+
+type StandingTeam struct {
+	ID uint `json:"id"`
+}
+
+// -- Team details API
+
+type TeamDetailsData struct {
+	Team TeamDetails `json:"team"`
+}
+type TeamDetails struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Slug       string     `json:"slug"`
+	ShortName  string     `json:"shortName"`
+	NameCode   string     `json:"nameCode"`
+	Venue      Venue      `json:"venue"`
+	TeamColors TeamColors `json:"teamColors"`
+}
+type TeamColors struct {
+	Primary   string `json:"primary"`
+	Secondary string `json:"secondary"`
+}
+type Venue struct {
+	Stadium Stadium `json:"stadium"`
+}
+
+type Stadium struct {
+	Name     string `json:"name"`
+	Capacity int    `json:"capacity"`
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/danielgtaylor/huma/v2"
+	"github.com/rs/zerolog/log"
+)
+
+// MAX_IMAGE_SIZE is the size limit of uploaded images
+const MAX_IMAGE_SIZE = 1 * 1024 * 1024
+
+// IMAGE_CONTENT_SECURITY_POLICY is sent along with images served from the API origin, so the image cannot run scripts
+// even if the browser renders it as a document
+const IMAGE_CONTENT_SECURITY_POLICY = "default-src 'none'; sandbox"
+
+// imageContentTypes are accepted image formats (scriptable formats like SVG are not)
+var imageContentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
+// ImageData is the uploaded image, stored in DB as JSON
+type ImageData struct {
+	ContentType   string `json:"contentType"`
+	BinaryContent []byte `json:"data"`
+}
+
+// ParseImageUpload extracts image from multipart request body (the part named `image`). The returned image is nil
+// when the body is not multipart, the part is not found or it's not an image of accepted formats (both the declared
+// content type and the sniffed one have to match)
+func ParseImageUpload(contentType string, rawBody []byte) (imageData *ImageData, errs []error) {
+	// 1. Analyze content-type header
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		log.Error().Err(err).Send()
+		errs = append(errs, &huma.ErrorDetail{
+			Message:  err.Error(),
+			Location: "header.content-type",
+			Value:    contentType,
+		})
+		return
+	}
+	// 2. For multipart/* content...
+	if strings.HasPrefix(mediaType, "multipart/") {
+		mr := multipart.NewReader(bytes.NewReader(rawBody), params["boundary"])
+		// 3. Iterate over all identifiable body parts
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				errs = append(errs, &huma.ErrorDetail{
+					Message:  err.Error(),
+					Location: "body.part",
+					Value:    p,
+				})
+				return
+			}
+			// 3a. Read the associated data
+			slurp, err := io.ReadAll(p)
+			if err != nil {
+				errs = append(errs, &huma.ErrorDetail{
+					Message:  err.Error(),
+					Location: fmt.Sprintf("body.part.%s", p.FormName()),
+					Value:    p,
+				})
+				return
+			}
+			// 3b. Format resulting ImageData struct to include the parsed information
+			if p.FormName() == "image" {
+				contentType, ok := imageContentType(p.Header.Get("Content-Type"), slurp)
+				if !ok {
+					return nil, nil
+				}
+				return &ImageData{
+					ContentType:   contentType,
+					BinaryContent: slurp,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// imageContentType reports the content type of the image if it's of accepted formats and the declared type matches
+// the content
+func imageContentType(declared string, content []byte) (string, bool) {
+	mediaType, _, err := mime.ParseMediaType(declared)
+	if err != nil || !slices.Contains(imageContentTypes, mediaType) {
+		return "", false
+	}
+	if http.DetectContentType(content) != mediaType {
+		return "", false
+	}
+	return mediaType, true
+}
@@ -0,0 +1,52 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"mime/multipart"
+	"net/textproto"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// 1x1 transparent PNG
+var pngImage = []byte{
+	0x89, 0x50, 0x4e, 0x47, 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x48, 0x44, 0x52,
+	0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x01, 0x08, 0x06, 0x00, 0x00, 0x00, 0x1f, 0x15, 0xc4,
+	0x89, 0x00, 0x00, 0x00, 0x0d, 0x49, 0x44, 0x41, 0x54, 0x78, 0x9c, 0x63, 0x00, 0x01, 0x00, 0x00,
+	0x05, 0x00, 0x01, 0x0d, 0x0a, 0x2d, 0xb4, 0x00, 0x00, 0x00, 0x00, 0x49, 0x45, 0x4e, 0x44, 0xae,
+	0x42, 0x60, 0x82,
+}
+
+var svgImage = []byte(`<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script></svg>`)
+
+func multipartImage(t *testing.T, contentType string, content []byte) (string, []byte) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", `form-data; name="image"; filename="image"`)
+	h.Set("Content-Type", contentType)
+	part, err := writer.CreatePart(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	part.Write(content)
+	writer.Close()
+	return fmt.Sprintf("multipart/form-data; boundary=%s", writer.Boundary()), body.Bytes()
+}
+
+func TestParseImageUpload(t *testing.T) {
+	imageData, errs := ParseImageUpload(multipartImage(t, "image/png", pngImage))
+	assert.Empty(t, errs)
+	assert.Equal(t, &ImageData{ContentType: "image/png", BinaryContent: pngImage}, imageData)
+	// scriptable formats are not accepted
+	imageData, errs = ParseImageUpload(multipartImage(t, "image/svg+xml", svgImage))
+	assert.Empty(t, errs)
+	assert.Nil(t, imageData)
+	// the declared content type must match the content
+	imageData, _ = ParseImageUpload(multipartImage(t, "image/png", svgImage))
+	assert.Nil(t, imageData)
+	imageData, _ = ParseImageUpload(multipartImage(t, "image/jpeg", pngImage))
+	assert.Nil(t, imageData)
+}
@@ -0,0 +1,138 @@
+package api
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	DEFAULT_PAGE_LIMIT = 50
+	MAX_PAGE_LIMIT     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// PageParams are query params of listing endpoints (to be embedded into Huma input structs). Cursor of the next
+// page is expected to be returned in `Next-Cursor` response header, see `Paginate` and `EncodeCursor`
+type PageParams struct {
+	Limit int    `query:"limit" minimum:"1" maximum:"100" default:"50" doc:"max number of items in the response"`
+	After string `query:"after" doc:"cursor of the next page (value of Next-Cursor header of the previous response)"`
+}
+
+// SortKey is a tuple of strings defining position of an item in the sorted list. The last element is expected to be
+// unique (e.g. ID) to make the order stable
+type SortKey []string
+
+const timeSortKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+// TimeSortKey formats time so that lexicographic order of keys matches chronological order
+func TimeSortKey(t time.Time) string {
+	return t.UTC().Format(timeSortKeyLayout)
+}
+
+// ParseTimeSortKey reverts `TimeSortKey` (e.g. to pass the cursor to SQL query)
+func ParseTimeSortKey(key string) (time.Time, error) {
+	t, err := time.Parse(timeSortKeyLayout, key)
+	if err != nil {
+		return time.Time{}, errors.Join(ErrInvalidCursor, err)
+	}
+	return t, nil
+}
+
+// cursor is the position of the last returned item in the sorted list, the sort option it was minted for is kept
+// so that cursors of other orders are rejected
+type cursor struct {
+	Sort string  `json:"s"`
+	Key  SortKey `json:"k"`
+}
+
+// EncodeCursor returns the cursor of the item with `key` in the list sorted by `sort`
+func EncodeCursor(sort string, key SortKey) string {
+	b, _ := json.Marshal(cursor{Sort: sort, Key: key})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor returns the sort key held by the cursor, cursors minted for other sort options are invalid
+func DecodeCursor(after string, sort string) (SortKey, error) {
+	b, err := base64.RawURLEncoding.DecodeString(after)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidCursor, err)
+	}
+	var c cursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, errors.Join(ErrInvalidCursor, err)
+	}
+	if c.Sort != sort {
+		return nil, errors.Join(ErrInvalidCursor, fmt.Errorf("cursor of %q order is used for %q order", c.Sort, sort))
+	}
+	if len(c.Key) == 0 {
+		return nil, errors.Join(ErrInvalidCursor, errors.New("empty sort key"))
+	}
+	return c.Key, nil
+}
+
+// PageLimit returns the number of items of the requested page
+func PageLimit(params PageParams) int {
+	if params.Limit <= 0 {
+		return DEFAULT_PAGE_LIMIT
+	}
+	return min(params.Limit, MAX_PAGE_LIMIT)
+}
+
+// IsDescending reports if the sort option stands for descending order (prefixed by '-')
+func IsDescending(sort string) bool {
+	return strings.HasPrefix(sort, "-")
+}
+
+// Paginate sorts items by `sortKey` (in descending order if `sort` is prefixed by '-') and returns the page of items
+// following the cursor `params.After` along with the cursor of the next page (empty for the last page). Since the
+// cursor holds the sort key of the last returned item, pagination is not affected by insertion/removal of items
+// between requests. Items are sorted in memory, so it's meant for lists of bounded size, larger lists are expected
+// to be paginated by SQL (see EncodeCursor/DecodeCursor)
+func Paginate[T any](items []T, params PageParams, sort string, sortKey func(T) SortKey) ([]T, string, error) {
+	limit := PageLimit(params)
+	desc := IsDescending(sort)
+	compare := func(a, b SortKey) int {
+		if desc {
+			return slices.Compare(b, a)
+		}
+		return slices.Compare(a, b)
+	}
+	keys := make(map[int]SortKey, len(items))
+	indexes := make([]int, len(items))
+	for idx := range items {
+		indexes[idx] = idx
+		keys[idx] = sortKey(items[idx])
+	}
+	slices.SortStableFunc(indexes, func(a, b int) int {
+		return compare(keys[a], keys[b])
+	})
+	start := 0
+	if params.After != "" {
+		after, err := DecodeCursor(params.After, sort)
+		if err != nil {
+			return nil, "", err
+		}
+		start, _ = slices.BinarySearchFunc(indexes, after, func(idx int, target SortKey) int {
+			if compare(keys[idx], target) <= 0 {
+				return -1
+			}
+			return 1
+		})
+	}
+	end := min(start+limit, len(indexes))
+	page := make([]T, 0, end-start)
+	for _, idx := range indexes[start:end] {
+		page = append(page, items[idx])
+	}
+	nextCursor := ""
+	if end < len(indexes) {
+		nextCursor = EncodeCursor(sort, keys[indexes[end-1]])
+	}
+	return page, nextCursor, nil
+}
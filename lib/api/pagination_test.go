@@ -0,0 +1,58 @@
+package api
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginate(t *testing.T) {
+	items := []int{5, 3, 9, 1, 7, 3}
+	sortKey := func(item int) SortKey {
+		return SortKey{strconv.Itoa(item)}
+	}
+	// ascending order, page by page
+	page, cursor, err := Paginate(items, PageParams{Limit: 4}, "value", sortKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 3, 5}, page)
+	assert.NotEmpty(t, cursor)
+	page, cursor, err = Paginate(items, PageParams{Limit: 4, After: cursor}, "value", sortKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 9}, page)
+	assert.Empty(t, cursor)
+	// descending order
+	page, cursor, err = Paginate(items, PageParams{Limit: 2}, "-value", sortKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 7}, page)
+	// the cursor survives removal of the last returned item
+	page, _, err = Paginate([]int{9, 5, 3, 1}, PageParams{Limit: 2, After: cursor}, "-value", sortKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 3}, page)
+	// the cursor is bound to the order it was minted for
+	_, _, err = Paginate(items, PageParams{Limit: 2, After: cursor}, "value", sortKey)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	// malformed cursor
+	_, _, err = Paginate(items, PageParams{After: "%%%"}, "value", sortKey)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestCursor(t *testing.T) {
+	cursor := EncodeCursor("-created", SortKey{"2024-03-01T10:00:00.000000Z", "a"})
+	key, err := DecodeCursor(cursor, "-created")
+	assert.NoError(t, err)
+	assert.Equal(t, SortKey{"2024-03-01T10:00:00.000000Z", "a"}, key)
+	_, err = DecodeCursor(cursor, "created")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	_, err = DecodeCursor(EncodeCursor("title", nil), "title")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	at := time.Date(2024, time.March, 1, 10, 0, 0, 123456000, time.UTC)
+	parsed, err := ParseTimeSortKey(TimeSortKey(at))
+	assert.NoError(t, err)
+	assert.True(t, at.Equal(parsed))
+	_, err = ParseTimeSortKey("yesterday")
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+	assert.Equal(t, DEFAULT_PAGE_LIMIT, PageLimit(PageParams{}))
+	assert.Equal(t, MAX_PAGE_LIMIT, PageLimit(PageParams{Limit: 1000}))
+}
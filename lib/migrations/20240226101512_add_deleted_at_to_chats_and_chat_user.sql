@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats ADD deleted_at timestamptz null;
+ALTER TABLE chat_user ADD deleted_at timestamptz null;
+CREATE INDEX chats_deleted_at_idx ON chats (deleted_at) WHERE deleted_at IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS chats_deleted_at_idx;
+ALTER TABLE chat_user DROP COLUMN deleted_at;
+ALTER TABLE chats DROP COLUMN deleted_at;
+-- +goose StatementEnd
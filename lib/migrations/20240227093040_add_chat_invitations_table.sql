@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+create table chat_invitations (
+  id uuid primary key default gen_random_uuid (),
+  chat_id uuid not null references chats,
+  invitee_id uuid not null references users,
+  invitor_id uuid not null references users,
+  status text not null default 'pending',
+  token_id uuid not null default gen_random_uuid (),
+  invited_at timestamptz not null default now(),
+  sent_at timestamptz not null default now(),
+  expires_at timestamptz not null,
+  accepted_at timestamptz null,
+  revoked_at timestamptz null,
+  CONSTRAINT chat_invitations_chat_id_invitee_id_key UNIQUE (chat_id, invitee_id),
+  CONSTRAINT chat_invitations_status_check CHECK (status in ('pending', 'accepted', 'revoked'))
+);
+-- invitations sent before this migration are considered pending
+insert into chat_invitations (chat_id, invitee_id, invitor_id, expires_at)
+select cu.chat_id, cu.user_id, g.owner_id, now() + interval '24 hours'
+from chat_user cu
+join chats c on c.id = cu.chat_id
+join chats g on g.id = c.parent_id
+where cu.disabled and g.owner_id is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chat_invitations;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chat_invitations ALTER invitee_id DROP NOT NULL;
+ALTER TABLE chat_invitations ADD invitee_email text null;
+ALTER TABLE chat_invitations ADD CONSTRAINT chat_invitations_invitee_check CHECK (invitee_id is not null or invitee_email is not null);
+CREATE UNIQUE INDEX chat_invitations_chat_id_invitee_email_key ON chat_invitations (chat_id, lower(invitee_email)) WHERE invitee_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM chat_invitations WHERE invitee_id IS NULL;
+DROP INDEX IF EXISTS chat_invitations_chat_id_invitee_email_key;
+ALTER TABLE chat_invitations DROP CONSTRAINT chat_invitations_invitee_check;
+ALTER TABLE chat_invitations DROP COLUMN invitee_email;
+ALTER TABLE chat_invitations ALTER invitee_id SET NOT NULL;
+-- +goose StatementEnd
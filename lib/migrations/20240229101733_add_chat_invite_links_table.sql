@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+create table chat_invite_links (
+  id uuid primary key default gen_random_uuid (),
+  chat_id uuid not null references chats,
+  creator_id uuid not null references users,
+  code text not null unique,
+  is_ro boolean not null default false,
+  max_uses integer null,
+  uses integer not null default 0,
+  expires_at timestamptz null,
+  created_at timestamptz not null default now(),
+  revoked_at timestamptz null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists chat_invite_links;
+-- +goose StatementEnd
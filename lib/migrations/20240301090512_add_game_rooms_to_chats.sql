@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats ADD game_id bigint null unique;
+ALTER TABLE chats ADD archived_at timestamptz null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE chats DROP COLUMN archived_at;
+ALTER TABLE chats DROP COLUMN game_id;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats ADD name text null;
+ALTER TABLE chats ADD legacy_resource text null;
+-- user owned chat groups: `chat:<name>` becomes `chat:<id>`, the name and the old resource are retained
+UPDATE chats
+SET
+  name = substring(resource from length('chat:') + 1),
+  legacy_resource = resource,
+  resource = 'chat:' || id::text
+WHERE parent_id IS NULL AND owner_id IS NOT NULL;
+CREATE UNIQUE INDEX chats_group_resource_idx ON chats (resource) WHERE parent_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX chats_group_resource_idx;
+UPDATE chats
+SET resource = coalesce(legacy_resource, 'chat:' || name)
+WHERE parent_id IS NULL AND owner_id IS NOT NULL;
+ALTER TABLE chats DROP COLUMN legacy_resource;
+ALTER TABLE chats DROP COLUMN name;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- direct messages are stored as top-level chat records (no parent/owner) with participants in chat_user,
+-- `dm_key` is the sorted list of participant IDs making DM unique per participant set
+ALTER TABLE chats ADD dm_key text null unique;
+create table user_blocks (
+  user_id uuid not null references users,
+  blocked_user_id uuid not null references users,
+  created_at timestamptz not null default now(),
+  primary key (user_id, blocked_user_id),
+  check (user_id <> blocked_user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table user_blocks;
+DELETE FROM chat_user WHERE chat_id IN (SELECT id FROM chats WHERE dm_key IS NOT NULL);
+DELETE FROM chats WHERE dm_key IS NOT NULL;
+ALTER TABLE chats DROP COLUMN dm_key;
+-- +goose StatementEnd
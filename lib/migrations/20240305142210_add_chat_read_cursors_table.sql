@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+create table chat_read_cursors (
+  chat_id uuid not null references chats,
+  user_id uuid not null references users,
+  last_read_at timestamptz not null,
+  last_read_message_id text null,
+  updated_at timestamptz not null default now(),
+  primary key (chat_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table chat_read_cursors;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chat_user ADD muted_until timestamptz null;
+create table chat_moderation_reports (
+  id uuid primary key default gen_random_uuid (),
+  chat_id uuid not null references chats,
+  user_id uuid not null references users,
+  message_id text not null,
+  message_text text not null,
+  reason text not null check (reason in ('profanity', 'link', 'rate_limit')),
+  details text null,
+  created_at timestamptz not null default now(),
+  resolved_at timestamptz null,
+  resolved_by uuid null references users
+);
+CREATE INDEX chat_moderation_reports_chat_id_idx ON chat_moderation_reports (chat_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table chat_moderation_reports;
+ALTER TABLE chat_user DROP COLUMN muted_until;
+-- +goose StatementEnd
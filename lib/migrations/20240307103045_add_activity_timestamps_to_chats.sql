@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats ADD created_at timestamptz not null default now();
+ALTER TABLE chats ADD last_message_at timestamptz null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE chats DROP COLUMN last_message_at;
+ALTER TABLE chats DROP COLUMN created_at;
+-- +goose StatementEnd
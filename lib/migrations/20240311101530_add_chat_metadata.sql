@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE chats ADD updated_at timestamptz not null default now();
+ALTER TABLE chats ADD topic text null;
+ALTER TABLE chats ADD avatar_updated_at timestamptz null;
+UPDATE chats SET updated_at = created_at;
+CREATE TABLE IF NOT EXISTS chat_avatars(
+  chat_id uuid primary key references chats,
+  image bytea not null,
+  updated_at timestamptz not null default now()
+);
+CREATE TABLE IF NOT EXISTS chat_pins(
+  id uuid primary key default gen_random_uuid(),
+  chat_id uuid not null references chats,
+  kind text not null check (kind in ('link', 'message')),
+  url text null,
+  message_id text null,
+  title text null,
+  position int not null default 0,
+  pinned_by uuid not null references users,
+  pinned_at timestamptz not null default now()
+);
+CREATE INDEX idx_chat_pins_chat_id ON chat_pins(chat_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_pins;
+DROP TABLE IF EXISTS chat_avatars;
+ALTER TABLE chats DROP COLUMN avatar_updated_at;
+ALTER TABLE chats DROP COLUMN topic;
+ALTER TABLE chats DROP COLUMN updated_at;
+-- +goose StatementEnd
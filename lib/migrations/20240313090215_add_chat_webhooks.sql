@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS chat_webhooks(
+  id uuid primary key default gen_random_uuid(),
+  chat_id uuid not null references chats,
+  url text not null,
+  secret text not null,
+  events text not null,
+  created_by uuid not null references users,
+  created_at timestamptz not null default now()
+);
+CREATE INDEX idx_chat_webhooks_chat_id ON chat_webhooks(chat_id);
+CREATE TABLE IF NOT EXISTS chat_webhook_deliveries(
+  id uuid primary key default gen_random_uuid(),
+  webhook_id uuid not null references chat_webhooks,
+  event text not null,
+  payload text not null,
+  status text not null default 'pending' check (status in ('pending', 'succeeded', 'failed')),
+  attempts int not null default 0,
+  next_attempt_at timestamptz null,
+  last_status_code int null,
+  last_error text null,
+  created_at timestamptz not null default now(),
+  delivered_at timestamptz null
+);
+CREATE INDEX idx_chat_webhook_deliveries_webhook_id ON chat_webhook_deliveries(webhook_id, created_at);
+CREATE INDEX idx_chat_webhook_deliveries_due ON chat_webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_webhook_deliveries;
+DROP TABLE IF EXISTS chat_webhooks;
+-- +goose StatementEnd
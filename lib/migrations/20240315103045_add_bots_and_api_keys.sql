@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS bots(
+  user_id uuid primary key references users,
+  owner_id uuid not null references users,
+  name text not null,
+  created_at timestamptz not null default now(),
+  deleted_at timestamptz null
+);
+CREATE INDEX idx_bots_owner_id ON bots(owner_id);
+CREATE TABLE IF NOT EXISTS api_keys(
+  id uuid primary key default gen_random_uuid(),
+  bot_id uuid not null references bots,
+  name text not null,
+  prefix text unique not null,
+  hashed_key text not null,
+  scopes text not null,
+  created_at timestamptz not null default now(),
+  expires_at timestamptz null,
+  last_used_at timestamptz null,
+  revoked_at timestamptz null
+);
+CREATE INDEX idx_api_keys_bot_id ON api_keys(bot_id);
+CREATE TABLE IF NOT EXISTS chat_bots(
+  id uuid primary key default gen_random_uuid(),
+  chat_id uuid not null references chats,
+  bot_id uuid not null references bots,
+  added_by uuid not null references users,
+  added_at timestamptz not null default now(),
+  unique(chat_id, bot_id)
+);
+CREATE INDEX idx_chat_bots_bot_id ON chat_bots(bot_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS chat_bots;
+DROP TABLE IF EXISTS api_keys;
+DROP TABLE IF EXISTS bots;
+-- +goose StatementEnd
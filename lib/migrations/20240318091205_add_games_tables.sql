@@ -0,0 +1,73 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS games(
+  id integer primary key,
+  tournament text not null,
+  status_code integer not null,
+  status_description text not null,
+  status_type text not null,
+  home_team_id integer not null,
+  away_team_id integer not null,
+  home_score integer null,
+  away_score integer null,
+  start_at timestamptz not null,
+  event jsonb not null,
+  box_score_at timestamptz null,
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now()
+);
+CREATE INDEX idx_games_start_at ON games(start_at);
+CREATE TABLE IF NOT EXISTS game_team_stats(
+  id uuid primary key default gen_random_uuid(),
+  game_id integer not null references games,
+  team_id integer not null,
+  is_home boolean not null,
+  rebounds integer not null default 0,
+  assists integer not null default 0,
+  steals integer not null default 0,
+  blocks integer not null default 0,
+  turnovers integer not null default 0,
+  fouls integer not null default 0,
+  field_goals_made integer not null default 0,
+  field_goal_attempts integer not null default 0,
+  three_points_made integer not null default 0,
+  three_point_attempts integer not null default 0,
+  free_throws_made integer not null default 0,
+  free_throw_attempts integer not null default 0,
+  unique(game_id, team_id)
+);
+CREATE TABLE IF NOT EXISTS game_player_stats(
+  id uuid primary key default gen_random_uuid(),
+  game_id integer not null references games,
+  team_id integer not null,
+  player_id integer not null,
+  player_name text not null,
+  is_home boolean not null,
+  position integer not null default 0,
+  seconds_played integer not null default 0,
+  field_goals_made integer not null default 0,
+  field_goal_attempts integer not null default 0,
+  three_points_made integer not null default 0,
+  three_point_attempts integer not null default 0,
+  free_throws_made integer not null default 0,
+  free_throw_attempts integer not null default 0,
+  offensive_rebounds integer not null default 0,
+  defensive_rebounds integer not null default 0,
+  rebounds integer not null default 0,
+  assists integer not null default 0,
+  steals integer not null default 0,
+  blocks integer not null default 0,
+  turnovers integer not null default 0,
+  personal_fouls integer not null default 0,
+  points integer not null default 0,
+  unique(game_id, player_id)
+);
+CREATE INDEX idx_game_player_stats_player_id ON game_player_stats(player_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS game_player_stats;
+DROP TABLE IF EXISTS game_team_stats;
+DROP TABLE IF EXISTS games;
+-- +goose StatementEnd
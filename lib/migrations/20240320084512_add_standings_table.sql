@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS standings(
+  id uuid primary key default gen_random_uuid(),
+  tournament_id integer not null,
+  season_id integer not null,
+  team_id integer not null,
+  conference text not null,
+  division text null,
+  position integer not null,
+  wins integer not null,
+  losses integer not null,
+  win_percentage double precision not null,
+  games_back double precision not null,
+  streak text not null default '',
+  updated_at timestamptz not null default now(),
+  unique(tournament_id, season_id, team_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS standings;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS players(
+  id integer primary key,
+  team_id integer null,
+  name text not null,
+  slug text not null,
+  position text not null default '',
+  jersey_number text not null default '',
+  -- ESPN headshot (images are re-created by the espn crawler, hence no foreign key)
+  image_id text null,
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now()
+);
+CREATE INDEX idx_players_team_id ON players(team_id);
+CREATE INDEX idx_players_slug ON players(slug);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS players;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the last state of live games published by the leading live poller (taken over by the next leader)
+CREATE TABLE IF NOT EXISTS live_game_states(
+  game_id integer primary key,
+  state text not null,
+  event jsonb not null,
+  finished_at timestamptz null,
+  updated_at timestamptz not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS live_game_states;
+-- +goose StatementEnd
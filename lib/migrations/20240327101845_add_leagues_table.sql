@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS leagues(
+  -- slug of the league used by API (e.g. `league` query parameter)
+  id text primary key,
+  name text not null,
+  -- name of the tournament as reported by BasketAPI (events are matched to leagues by it)
+  tournament text not null unique,
+  tournament_id integer not null,
+  season_id integer not null,
+  enabled boolean not null default true,
+  created_at timestamptz not null default now(),
+  updated_at timestamptz not null default now()
+);
+INSERT INTO leagues(id, name, tournament, tournament_id, season_id) VALUES ('nba', 'NBA', 'NBA', 132, 54105);
+ALTER TABLE team_info ADD COLUMN league_id text not null default 'nba' references leagues(id) on delete cascade;
+ALTER TABLE team_info ALTER COLUMN league_id DROP DEFAULT;
+CREATE INDEX idx_team_info_league_id ON team_info(league_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE team_info DROP COLUMN IF EXISTS league_id;
+DROP TABLE IF EXISTS leagues;
+-- +goose StatementEnd
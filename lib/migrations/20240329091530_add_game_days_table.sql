@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- days (UTC) whose complete schedule of the tournament is stored and finished, i.e. they can be served from DB
+CREATE TABLE IF NOT EXISTS game_days(
+  tournament text not null,
+  day date not null,
+  synced_at timestamptz not null default now(),
+  primary key(tournament, day)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS game_days;
+-- +goose StatementEnd
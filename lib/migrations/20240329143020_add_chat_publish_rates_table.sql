@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- publish timestamps (unix milliseconds) of the user in the channel within the sliding window of the rate limit
+create table chat_publish_rates (
+  user_id uuid not null,
+  channel text not null,
+  published_at bigint[] not null,
+  updated_at timestamptz not null default now(),
+  primary key (user_id, channel)
+);
+CREATE INDEX chat_publish_rates_updated_at_idx ON chat_publish_rates (updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table chat_publish_rates;
+-- +goose StatementEnd
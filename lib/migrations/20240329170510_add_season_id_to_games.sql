@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE games ADD COLUMN season_id integer null;
+-- games stored so far belong to the configured season of their league (only the current season has been crawled)
+UPDATE games g SET season_id = l.season_id FROM leagues l WHERE l.tournament = g.tournament;
+CREATE INDEX idx_games_tournament_season_id ON games(tournament, season_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE games DROP COLUMN IF EXISTS season_id;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- status of the live poller reported by the leader (a single row), it is served by every replica
+CREATE TABLE IF NOT EXISTS live_status(
+  id integer primary key default 1 check (id = 1),
+  status jsonb not null,
+  updated_at timestamptz not null default now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS live_status;
+-- +goose StatementEnd
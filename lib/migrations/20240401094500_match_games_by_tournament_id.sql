@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- events are matched to leagues by ID of their tournament, names of tournaments are not unique in BasketAPI
+ALTER TABLE leagues DROP CONSTRAINT IF EXISTS leagues_tournament_key;
+ALTER TABLE leagues ADD CONSTRAINT leagues_tournament_id_key UNIQUE (tournament_id);
+ALTER TABLE games ADD COLUMN tournament_id integer null;
+-- games stored so far belong to the tournaments of configured leagues
+UPDATE games g SET tournament_id = l.tournament_id FROM leagues l WHERE l.tournament = g.tournament;
+DROP INDEX IF EXISTS idx_games_tournament_season_id;
+CREATE INDEX idx_games_tournament_id_season_id ON games(tournament_id, season_id);
+ALTER TABLE game_days ADD COLUMN tournament_id integer null;
+UPDATE game_days d SET tournament_id = l.tournament_id FROM leagues l WHERE l.tournament = d.tournament;
+-- days of unknown tournaments are never served, they are stored again once complete
+DELETE FROM game_days WHERE tournament_id IS NULL;
+ALTER TABLE game_days ALTER COLUMN tournament_id SET NOT NULL;
+ALTER TABLE game_days DROP CONSTRAINT game_days_pkey;
+ALTER TABLE game_days DROP COLUMN tournament;
+ALTER TABLE game_days ADD PRIMARY KEY (tournament_id, day);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE game_days ADD COLUMN tournament text null;
+UPDATE game_days d SET tournament = l.tournament FROM leagues l WHERE l.tournament_id = d.tournament_id;
+DELETE FROM game_days WHERE tournament IS NULL;
+ALTER TABLE game_days ALTER COLUMN tournament SET NOT NULL;
+ALTER TABLE game_days DROP CONSTRAINT game_days_pkey;
+ALTER TABLE game_days DROP COLUMN tournament_id;
+ALTER TABLE game_days ADD PRIMARY KEY (tournament, day);
+DROP INDEX IF EXISTS idx_games_tournament_id_season_id;
+CREATE INDEX idx_games_tournament_season_id ON games(tournament, season_id);
+ALTER TABLE games DROP COLUMN IF EXISTS tournament_id;
+ALTER TABLE leagues DROP CONSTRAINT IF EXISTS leagues_tournament_id_key;
+ALTER TABLE leagues ADD CONSTRAINT leagues_tournament_key UNIQUE (tournament);
+-- +goose StatementEnd
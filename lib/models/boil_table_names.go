@@ -4,19 +4,61 @@
 package models
 
 var TableNames = struct {
-	ChatUser       string
-	Chats          string
-	GooseDBVersion string
-	Images         string
-	TeamInfo       string
-	Teams          string
-	Users          string
+	APIKeys               string
+	Bots                  string
+	ChatAvatars           string
+	ChatBots              string
+	ChatInvitations       string
+	ChatInviteLinks       string
+	ChatModerationReports string
+	ChatPins              string
+	ChatPublishRates      string
+	ChatReadCursors       string
+	ChatUser              string
+	ChatWebhookDeliveries string
+	ChatWebhooks          string
+	Chats                 string
+	GameDays              string
+	GamePlayerStats       string
+	GameTeamStats         string
+	Games                 string
+	Images                string
+	Leagues               string
+	LiveGameStates        string
+	LiveStatus            string
+	Players               string
+	Standings             string
+	TeamInfo              string
+	Teams                 string
+	UserBlocks            string
+	Users                 string
 }{
-	ChatUser:       "chat_user",
-	Chats:          "chats",
-	GooseDBVersion: "goose_db_version",
-	Images:         "images",
-	TeamInfo:       "team_info",
-	Teams:          "teams",
-	Users:          "users",
+	APIKeys:               "api_keys",
+	Bots:                  "bots",
+	ChatAvatars:           "chat_avatars",
+	ChatBots:              "chat_bots",
+	ChatInvitations:       "chat_invitations",
+	ChatInviteLinks:       "chat_invite_links",
+	ChatModerationReports: "chat_moderation_reports",
+	ChatPins:              "chat_pins",
+	ChatPublishRates:      "chat_publish_rates",
+	ChatReadCursors:       "chat_read_cursors",
+	ChatUser:              "chat_user",
+	ChatWebhookDeliveries: "chat_webhook_deliveries",
+	ChatWebhooks:          "chat_webhooks",
+	Chats:                 "chats",
+	GameDays:              "game_days",
+	GamePlayerStats:       "game_player_stats",
+	GameTeamStats:         "game_team_stats",
+	Games:                 "games",
+	Images:                "images",
+	Leagues:               "leagues",
+	LiveGameStates:        "live_game_states",
+	LiveStatus:            "live_status",
+	Players:               "players",
+	Standings:             "standings",
+	TeamInfo:              "team_info",
+	Teams:                 "teams",
+	UserBlocks:            "user_blocks",
+	Users:                 "users",
 }
@@ -0,0 +1,1855 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Bot is an object representing the database table.
+type Bot struct {
+	UserID    string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	OwnerID   string    `boil:"owner_id" json:"owner_id" toml:"owner_id" yaml:"owner_id"`
+	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeletedAt null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+
+	R *botR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L botL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BotColumns = struct {
+	UserID    string
+	OwnerID   string
+	Name      string
+	CreatedAt string
+	DeletedAt string
+}{
+	UserID:    "user_id",
+	OwnerID:   "owner_id",
+	Name:      "name",
+	CreatedAt: "created_at",
+	DeletedAt: "deleted_at",
+}
+
+var BotTableColumns = struct {
+	UserID    string
+	OwnerID   string
+	Name      string
+	CreatedAt string
+	DeletedAt string
+}{
+	UserID:    "bots.user_id",
+	OwnerID:   "bots.owner_id",
+	Name:      "bots.name",
+	CreatedAt: "bots.created_at",
+	DeletedAt: "bots.deleted_at",
+}
+
+// Generated where
+
+var BotWhere = struct {
+	UserID    whereHelperstring
+	OwnerID   whereHelperstring
+	Name      whereHelperstring
+	CreatedAt whereHelpertime_Time
+	DeletedAt whereHelpernull_Time
+}{
+	UserID:    whereHelperstring{field: "\"bots\".\"user_id\""},
+	OwnerID:   whereHelperstring{field: "\"bots\".\"owner_id\""},
+	Name:      whereHelperstring{field: "\"bots\".\"name\""},
+	CreatedAt: whereHelpertime_Time{field: "\"bots\".\"created_at\""},
+	DeletedAt: whereHelpernull_Time{field: "\"bots\".\"deleted_at\""},
+}
+
+// BotRels is where relationship names are stored.
+var BotRels = struct {
+	User     string
+	Owner    string
+	APIKeys  string
+	ChatBots string
+}{
+	User:     "User",
+	Owner:    "Owner",
+	APIKeys:  "APIKeys",
+	ChatBots: "ChatBots",
+}
+
+// botR is where relationships are stored.
+type botR struct {
+	User     *User        `boil:"User" json:"User" toml:"User" yaml:"User"`
+	Owner    *User        `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	APIKeys  APIKeySlice  `boil:"APIKeys" json:"APIKeys" toml:"APIKeys" yaml:"APIKeys"`
+	ChatBots ChatBotSlice `boil:"ChatBots" json:"ChatBots" toml:"ChatBots" yaml:"ChatBots"`
+}
+
+// NewStruct creates a new relationship struct
+func (*botR) NewStruct() *botR {
+	return &botR{}
+}
+
+func (r *botR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *botR) GetOwner() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Owner
+}
+
+func (r *botR) GetAPIKeys() APIKeySlice {
+	if r == nil {
+		return nil
+	}
+	return r.APIKeys
+}
+
+func (r *botR) GetChatBots() ChatBotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatBots
+}
+
+// botL is where Load methods for each relationship are stored.
+type botL struct{}
+
+var (
+	botAllColumns            = []string{"user_id", "owner_id", "name", "created_at", "deleted_at"}
+	botColumnsWithoutDefault = []string{"user_id", "owner_id", "name"}
+	botColumnsWithDefault    = []string{"created_at", "deleted_at"}
+	botPrimaryKeyColumns     = []string{"user_id"}
+	botGeneratedColumns      = []string{}
+)
+
+type (
+	// BotSlice is an alias for a slice of pointers to Bot.
+	// This should almost always be used instead of []Bot.
+	BotSlice []*Bot
+	// BotHook is the signature for custom Bot hook methods
+	BotHook func(context.Context, boil.ContextExecutor, *Bot) error
+
+	botQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	botType                 = reflect.TypeOf(&Bot{})
+	botMapping              = queries.MakeStructMapping(botType)
+	botPrimaryKeyMapping, _ = queries.BindMapping(botType, botMapping, botPrimaryKeyColumns)
+	botInsertCacheMut       sync.RWMutex
+	botInsertCache          = make(map[string]insertCache)
+	botUpdateCacheMut       sync.RWMutex
+	botUpdateCache          = make(map[string]updateCache)
+	botUpsertCacheMut       sync.RWMutex
+	botUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var botAfterSelectHooks []BotHook
+
+var botBeforeInsertHooks []BotHook
+var botAfterInsertHooks []BotHook
+
+var botBeforeUpdateHooks []BotHook
+var botAfterUpdateHooks []BotHook
+
+var botBeforeDeleteHooks []BotHook
+var botAfterDeleteHooks []BotHook
+
+var botBeforeUpsertHooks []BotHook
+var botAfterUpsertHooks []BotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Bot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Bot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Bot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Bot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Bot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Bot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Bot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Bot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Bot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range botAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBotHook registers your hook function for all future operations.
+func AddBotHook(hookPoint boil.HookPoint, botHook BotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		botAfterSelectHooks = append(botAfterSelectHooks, botHook)
+	case boil.BeforeInsertHook:
+		botBeforeInsertHooks = append(botBeforeInsertHooks, botHook)
+	case boil.AfterInsertHook:
+		botAfterInsertHooks = append(botAfterInsertHooks, botHook)
+	case boil.BeforeUpdateHook:
+		botBeforeUpdateHooks = append(botBeforeUpdateHooks, botHook)
+	case boil.AfterUpdateHook:
+		botAfterUpdateHooks = append(botAfterUpdateHooks, botHook)
+	case boil.BeforeDeleteHook:
+		botBeforeDeleteHooks = append(botBeforeDeleteHooks, botHook)
+	case boil.AfterDeleteHook:
+		botAfterDeleteHooks = append(botAfterDeleteHooks, botHook)
+	case boil.BeforeUpsertHook:
+		botBeforeUpsertHooks = append(botBeforeUpsertHooks, botHook)
+	case boil.AfterUpsertHook:
+		botAfterUpsertHooks = append(botAfterUpsertHooks, botHook)
+	}
+}
+
+// OneG returns a single bot record from the query using the global executor.
+func (q botQuery) OneG(ctx context.Context) (*Bot, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single bot record from the query.
+func (q botQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Bot, error) {
+	o := &Bot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for bots")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Bot records from the query using the global executor.
+func (q botQuery) AllG(ctx context.Context) (BotSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Bot records from the query.
+func (q botQuery) All(ctx context.Context, exec boil.ContextExecutor) (BotSlice, error) {
+	var o []*Bot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Bot slice")
+	}
+
+	if len(botAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Bot records in the query using the global executor
+func (q botQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Bot records in the query.
+func (q botQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count bots rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q botQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q botQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if bots exists")
+	}
+
+	return count > 0, nil
+}
+
+// User pointed to by the foreign key.
+func (o *Bot) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Owner pointed to by the foreign key.
+func (o *Bot) Owner(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.OwnerID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// APIKeys retrieves all the api_key's APIKeys with an executor.
+func (o *Bot) APIKeys(mods ...qm.QueryMod) apiKeyQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"api_keys\".\"bot_id\"=?", o.UserID),
+	)
+
+	return APIKeys(queryMods...)
+}
+
+// ChatBots retrieves all the chat_bot's ChatBots with an executor.
+func (o *Bot) ChatBots(mods ...qm.QueryMod) chatBotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_bots\".\"bot_id\"=?", o.UserID),
+	)
+
+	return ChatBots(queryMods...)
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (botL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBot interface{}, mods queries.Applicator) error {
+	var slice []*Bot
+	var object *Bot
+
+	if singular {
+		var ok bool
+		object, ok = maybeBot.(*Bot)
+		if !ok {
+			object = new(Bot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBot))
+			}
+		}
+	} else {
+		s, ok := maybeBot.(*[]*Bot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &botR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &botR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.Bot = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.Bot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwner allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (botL) LoadOwner(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBot interface{}, mods queries.Applicator) error {
+	var slice []*Bot
+	var object *Bot
+
+	if singular {
+		var ok bool
+		object, ok = maybeBot.(*Bot)
+		if !ok {
+			object = new(Bot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBot))
+			}
+		}
+	} else {
+		s, ok := maybeBot.(*[]*Bot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &botR{}
+		}
+		args = append(args, object.OwnerID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &botR{}
+			}
+
+			for _, a := range args {
+				if a == obj.OwnerID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.OwnerID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Owner = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.OwnerBots = append(foreign.R.OwnerBots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.OwnerID == foreign.ID {
+				local.R.Owner = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.OwnerBots = append(foreign.R.OwnerBots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAPIKeys allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (botL) LoadAPIKeys(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBot interface{}, mods queries.Applicator) error {
+	var slice []*Bot
+	var object *Bot
+
+	if singular {
+		var ok bool
+		object, ok = maybeBot.(*Bot)
+		if !ok {
+			object = new(Bot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBot))
+			}
+		}
+	} else {
+		s, ok := maybeBot.(*[]*Bot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &botR{}
+		}
+		args = append(args, object.UserID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &botR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`api_keys`),
+		qm.WhereIn(`api_keys.bot_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load api_keys")
+	}
+
+	var resultSlice []*APIKey
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice api_keys")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on api_keys")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for api_keys")
+	}
+
+	if len(apiKeyAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.APIKeys = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &apiKeyR{}
+			}
+			foreign.R.Bot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.UserID == foreign.BotID {
+				local.R.APIKeys = append(local.R.APIKeys, foreign)
+				if foreign.R == nil {
+					foreign.R = &apiKeyR{}
+				}
+				foreign.R.Bot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatBots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (botL) LoadChatBots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBot interface{}, mods queries.Applicator) error {
+	var slice []*Bot
+	var object *Bot
+
+	if singular {
+		var ok bool
+		object, ok = maybeBot.(*Bot)
+		if !ok {
+			object = new(Bot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeBot))
+			}
+		}
+	} else {
+		s, ok := maybeBot.(*[]*Bot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &botR{}
+		}
+		args = append(args, object.UserID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &botR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_bots`),
+		qm.WhereIn(`chat_bots.bot_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_bots")
+	}
+
+	var resultSlice []*ChatBot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_bots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_bots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_bots")
+	}
+
+	if len(chatBotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatBots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatBotR{}
+			}
+			foreign.R.Bot = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.UserID == foreign.BotID {
+				local.R.ChatBots = append(local.R.ChatBots, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatBotR{}
+				}
+				foreign.R.Bot = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetUserG of the bot to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Bot.
+// Uses the global database handle.
+func (o *Bot) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the bot to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.Bot.
+func (o *Bot) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, botPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &botR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			Bot: o,
+		}
+	} else {
+		related.R.Bot = o
+	}
+
+	return nil
+}
+
+// SetOwnerG of the bot to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerBots.
+// Uses the global database handle.
+func (o *Bot) SetOwnerG(ctx context.Context, insert bool, related *User) error {
+	return o.SetOwner(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetOwner of the bot to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerBots.
+func (o *Bot) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, botPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.OwnerID = related.ID
+	if o.R == nil {
+		o.R = &botR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerBots: BotSlice{o},
+		}
+	} else {
+		related.R.OwnerBots = append(related.R.OwnerBots, o)
+	}
+
+	return nil
+}
+
+// AddAPIKeysG adds the given related objects to the existing relationships
+// of the bot, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.Bot appropriately.
+// Uses the global database handle.
+func (o *Bot) AddAPIKeysG(ctx context.Context, insert bool, related ...*APIKey) error {
+	return o.AddAPIKeys(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAPIKeys adds the given related objects to the existing relationships
+// of the bot, optionally inserting them as new records.
+// Appends related to o.R.APIKeys.
+// Sets related.R.Bot appropriately.
+func (o *Bot) AddAPIKeys(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*APIKey) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BotID = o.UserID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"api_keys\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"bot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, apiKeyPrimaryKeyColumns),
+			)
+			values := []interface{}{o.UserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BotID = o.UserID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &botR{
+			APIKeys: related,
+		}
+	} else {
+		o.R.APIKeys = append(o.R.APIKeys, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &apiKeyR{
+				Bot: o,
+			}
+		} else {
+			rel.R.Bot = o
+		}
+	}
+	return nil
+}
+
+// AddChatBotsG adds the given related objects to the existing relationships
+// of the bot, optionally inserting them as new records.
+// Appends related to o.R.ChatBots.
+// Sets related.R.Bot appropriately.
+// Uses the global database handle.
+func (o *Bot) AddChatBotsG(ctx context.Context, insert bool, related ...*ChatBot) error {
+	return o.AddChatBots(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatBots adds the given related objects to the existing relationships
+// of the bot, optionally inserting them as new records.
+// Appends related to o.R.ChatBots.
+// Sets related.R.Bot appropriately.
+func (o *Bot) AddChatBots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatBot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BotID = o.UserID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_bots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"bot_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.UserID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BotID = o.UserID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &botR{
+			ChatBots: related,
+		}
+	} else {
+		o.R.ChatBots = append(o.R.ChatBots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatBotR{
+				Bot: o,
+			}
+		} else {
+			rel.R.Bot = o
+		}
+	}
+	return nil
+}
+
+// Bots retrieves all the records using an executor.
+func Bots(mods ...qm.QueryMod) botQuery {
+	mods = append(mods, qm.From("\"bots\""), qmhelper.WhereIsNull("\"bots\".\"deleted_at\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"bots\".*"})
+	}
+
+	return botQuery{q}
+}
+
+// FindBotG retrieves a single record by ID.
+func FindBotG(ctx context.Context, userID string, selectCols ...string) (*Bot, error) {
+	return FindBot(ctx, boil.GetContextDB(), userID, selectCols...)
+}
+
+// FindBot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBot(ctx context.Context, exec boil.ContextExecutor, userID string, selectCols ...string) (*Bot, error) {
+	botObj := &Bot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"bots\" where \"user_id\"=$1 and \"deleted_at\" is null", sel,
+	)
+
+	q := queries.Raw(query, userID)
+
+	err := q.Bind(ctx, exec, botObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from bots")
+	}
+
+	if err = botObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return botObj, err
+	}
+
+	return botObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Bot) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Bot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no bots provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(botColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	botInsertCacheMut.RLock()
+	cache, cached := botInsertCache[key]
+	botInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			botAllColumns,
+			botColumnsWithDefault,
+			botColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(botType, botMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(botType, botMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"bots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"bots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into bots")
+	}
+
+	if !cached {
+		botInsertCacheMut.Lock()
+		botInsertCache[key] = cache
+		botInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Bot record using the global executor.
+// See Update for more documentation.
+func (o *Bot) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Bot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Bot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	botUpdateCacheMut.RLock()
+	cache, cached := botUpdateCache[key]
+	botUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			botAllColumns,
+			botPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update bots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"bots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, botPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(botType, botMapping, append(wl, botPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update bots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for bots")
+	}
+
+	if !cached {
+		botUpdateCacheMut.Lock()
+		botUpdateCache[key] = cache
+		botUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q botQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q botQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for bots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o BotSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), botPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, botPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in bot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all bot")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Bot) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Bot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no bots provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(botColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	botUpsertCacheMut.RLock()
+	cache, cached := botUpsertCache[key]
+	botUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			botAllColumns,
+			botColumnsWithDefault,
+			botColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			botAllColumns,
+			botPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert bots, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(botPrimaryKeyColumns))
+			copy(conflict, botPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"bots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(botType, botMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(botType, botMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert bots")
+	}
+
+	if !cached {
+		botUpsertCacheMut.Lock()
+		botUpsertCache[key] = cache
+		botUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Bot record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Bot) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// Delete deletes a single Bot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Bot) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Bot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), botPrimaryKeyMapping)
+		sql = "DELETE FROM \"bots\" WHERE \"user_id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"bots\" SET %s WHERE \"user_id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(botType, botMapping, append(wl, botPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for bots")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q botQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all matching rows.
+func (q botQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no botQuery provided for delete all")
+	}
+
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for bots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o BotSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(botBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), botPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"bots\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, botPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), botPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"bots\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, botPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from bot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for bots")
+	}
+
+	if len(botAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Bot) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Bot provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Bot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBot(ctx, exec, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BotSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty BotSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), botPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"bots\".* FROM \"bots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, botPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in BotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BotExistsG checks if the Bot row exists.
+func BotExistsG(ctx context.Context, userID string) (bool, error) {
+	return BotExists(ctx, boil.GetContextDB(), userID)
+}
+
+// BotExists checks if the Bot row exists.
+func BotExists(ctx context.Context, exec boil.ContextExecutor, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"bots\" where \"user_id\"=$1 and \"deleted_at\" is null limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if bots exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Bot row exists.
+func (o *Bot) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return BotExists(ctx, exec, o.UserID)
+}
@@ -0,0 +1,1217 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatAvatar is an object representing the database table.
+type ChatAvatar struct {
+	ChatID    string    `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	Image     []byte    `boil:"image" json:"image" toml:"image" yaml:"image"`
+	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *chatAvatarR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatAvatarL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatAvatarColumns = struct {
+	ChatID    string
+	Image     string
+	UpdatedAt string
+}{
+	ChatID:    "chat_id",
+	Image:     "image",
+	UpdatedAt: "updated_at",
+}
+
+var ChatAvatarTableColumns = struct {
+	ChatID    string
+	Image     string
+	UpdatedAt string
+}{
+	ChatID:    "chat_avatars.chat_id",
+	Image:     "chat_avatars.image",
+	UpdatedAt: "chat_avatars.updated_at",
+}
+
+// Generated where
+
+type whereHelper__byte struct{ field string }
+
+func (w whereHelper__byte) EQ(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelper__byte) NEQ(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelper__byte) LT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelper__byte) LTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelper__byte) GT(x []byte) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelper__byte) GTE(x []byte) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var ChatAvatarWhere = struct {
+	ChatID    whereHelperstring
+	Image     whereHelper__byte
+	UpdatedAt whereHelpertime_Time
+}{
+	ChatID:    whereHelperstring{field: "\"chat_avatars\".\"chat_id\""},
+	Image:     whereHelper__byte{field: "\"chat_avatars\".\"image\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"chat_avatars\".\"updated_at\""},
+}
+
+// ChatAvatarRels is where relationship names are stored.
+var ChatAvatarRels = struct {
+	Chat string
+}{
+	Chat: "Chat",
+}
+
+// chatAvatarR is where relationships are stored.
+type chatAvatarR struct {
+	Chat *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatAvatarR) NewStruct() *chatAvatarR {
+	return &chatAvatarR{}
+}
+
+func (r *chatAvatarR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+// chatAvatarL is where Load methods for each relationship are stored.
+type chatAvatarL struct{}
+
+var (
+	chatAvatarAllColumns            = []string{"chat_id", "image", "updated_at"}
+	chatAvatarColumnsWithoutDefault = []string{"chat_id", "image"}
+	chatAvatarColumnsWithDefault    = []string{"updated_at"}
+	chatAvatarPrimaryKeyColumns     = []string{"chat_id"}
+	chatAvatarGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatAvatarSlice is an alias for a slice of pointers to ChatAvatar.
+	// This should almost always be used instead of []ChatAvatar.
+	ChatAvatarSlice []*ChatAvatar
+	// ChatAvatarHook is the signature for custom ChatAvatar hook methods
+	ChatAvatarHook func(context.Context, boil.ContextExecutor, *ChatAvatar) error
+
+	chatAvatarQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatAvatarType                 = reflect.TypeOf(&ChatAvatar{})
+	chatAvatarMapping              = queries.MakeStructMapping(chatAvatarType)
+	chatAvatarPrimaryKeyMapping, _ = queries.BindMapping(chatAvatarType, chatAvatarMapping, chatAvatarPrimaryKeyColumns)
+	chatAvatarInsertCacheMut       sync.RWMutex
+	chatAvatarInsertCache          = make(map[string]insertCache)
+	chatAvatarUpdateCacheMut       sync.RWMutex
+	chatAvatarUpdateCache          = make(map[string]updateCache)
+	chatAvatarUpsertCacheMut       sync.RWMutex
+	chatAvatarUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatAvatarAfterSelectHooks []ChatAvatarHook
+
+var chatAvatarBeforeInsertHooks []ChatAvatarHook
+var chatAvatarAfterInsertHooks []ChatAvatarHook
+
+var chatAvatarBeforeUpdateHooks []ChatAvatarHook
+var chatAvatarAfterUpdateHooks []ChatAvatarHook
+
+var chatAvatarBeforeDeleteHooks []ChatAvatarHook
+var chatAvatarAfterDeleteHooks []ChatAvatarHook
+
+var chatAvatarBeforeUpsertHooks []ChatAvatarHook
+var chatAvatarAfterUpsertHooks []ChatAvatarHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatAvatar) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatAvatar) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatAvatar) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatAvatar) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatAvatar) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatAvatar) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatAvatar) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatAvatar) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatAvatar) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatAvatarAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatAvatarHook registers your hook function for all future operations.
+func AddChatAvatarHook(hookPoint boil.HookPoint, chatAvatarHook ChatAvatarHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatAvatarAfterSelectHooks = append(chatAvatarAfterSelectHooks, chatAvatarHook)
+	case boil.BeforeInsertHook:
+		chatAvatarBeforeInsertHooks = append(chatAvatarBeforeInsertHooks, chatAvatarHook)
+	case boil.AfterInsertHook:
+		chatAvatarAfterInsertHooks = append(chatAvatarAfterInsertHooks, chatAvatarHook)
+	case boil.BeforeUpdateHook:
+		chatAvatarBeforeUpdateHooks = append(chatAvatarBeforeUpdateHooks, chatAvatarHook)
+	case boil.AfterUpdateHook:
+		chatAvatarAfterUpdateHooks = append(chatAvatarAfterUpdateHooks, chatAvatarHook)
+	case boil.BeforeDeleteHook:
+		chatAvatarBeforeDeleteHooks = append(chatAvatarBeforeDeleteHooks, chatAvatarHook)
+	case boil.AfterDeleteHook:
+		chatAvatarAfterDeleteHooks = append(chatAvatarAfterDeleteHooks, chatAvatarHook)
+	case boil.BeforeUpsertHook:
+		chatAvatarBeforeUpsertHooks = append(chatAvatarBeforeUpsertHooks, chatAvatarHook)
+	case boil.AfterUpsertHook:
+		chatAvatarAfterUpsertHooks = append(chatAvatarAfterUpsertHooks, chatAvatarHook)
+	}
+}
+
+// OneG returns a single chatAvatar record from the query using the global executor.
+func (q chatAvatarQuery) OneG(ctx context.Context) (*ChatAvatar, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatAvatar record from the query.
+func (q chatAvatarQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatAvatar, error) {
+	o := &ChatAvatar{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_avatars")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatAvatar records from the query using the global executor.
+func (q chatAvatarQuery) AllG(ctx context.Context) (ChatAvatarSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatAvatar records from the query.
+func (q chatAvatarQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatAvatarSlice, error) {
+	var o []*ChatAvatar
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatAvatar slice")
+	}
+
+	if len(chatAvatarAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatAvatar records in the query using the global executor
+func (q chatAvatarQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatAvatar records in the query.
+func (q chatAvatarQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_avatars rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatAvatarQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatAvatarQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_avatars exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatAvatar) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatAvatarL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatAvatar interface{}, mods queries.Applicator) error {
+	var slice []*ChatAvatar
+	var object *ChatAvatar
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatAvatar.(*ChatAvatar)
+		if !ok {
+			object = new(ChatAvatar)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatAvatar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatAvatar))
+			}
+		}
+	} else {
+		s, ok := maybeChatAvatar.(*[]*ChatAvatar)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatAvatar)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatAvatar))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatAvatarR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatAvatarR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatAvatar = object
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatAvatar = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatAvatar to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatAvatar.
+// Uses the global database handle.
+func (o *ChatAvatar) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatAvatar to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatAvatar.
+func (o *ChatAvatar) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_avatars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatAvatarPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChatID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatAvatarR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatAvatar: o,
+		}
+	} else {
+		related.R.ChatAvatar = o
+	}
+
+	return nil
+}
+
+// ChatAvatars retrieves all the records using an executor.
+func ChatAvatars(mods ...qm.QueryMod) chatAvatarQuery {
+	mods = append(mods, qm.From("\"chat_avatars\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_avatars\".*"})
+	}
+
+	return chatAvatarQuery{q}
+}
+
+// FindChatAvatarG retrieves a single record by ID.
+func FindChatAvatarG(ctx context.Context, chatID string, selectCols ...string) (*ChatAvatar, error) {
+	return FindChatAvatar(ctx, boil.GetContextDB(), chatID, selectCols...)
+}
+
+// FindChatAvatar retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatAvatar(ctx context.Context, exec boil.ContextExecutor, chatID string, selectCols ...string) (*ChatAvatar, error) {
+	chatAvatarObj := &ChatAvatar{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_avatars\" where \"chat_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, chatID)
+
+	err := q.Bind(ctx, exec, chatAvatarObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_avatars")
+	}
+
+	if err = chatAvatarObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatAvatarObj, err
+	}
+
+	return chatAvatarObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatAvatar) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatAvatar) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_avatars provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatAvatarColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatAvatarInsertCacheMut.RLock()
+	cache, cached := chatAvatarInsertCache[key]
+	chatAvatarInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatAvatarAllColumns,
+			chatAvatarColumnsWithDefault,
+			chatAvatarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatAvatarType, chatAvatarMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatAvatarType, chatAvatarMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_avatars\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_avatars\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_avatars")
+	}
+
+	if !cached {
+		chatAvatarInsertCacheMut.Lock()
+		chatAvatarInsertCache[key] = cache
+		chatAvatarInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatAvatar record using the global executor.
+// See Update for more documentation.
+func (o *ChatAvatar) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatAvatar.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatAvatar) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatAvatarUpdateCacheMut.RLock()
+	cache, cached := chatAvatarUpdateCache[key]
+	chatAvatarUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatAvatarAllColumns,
+			chatAvatarPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_avatars, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_avatars\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatAvatarPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatAvatarType, chatAvatarMapping, append(wl, chatAvatarPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_avatars row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_avatars")
+	}
+
+	if !cached {
+		chatAvatarUpdateCacheMut.Lock()
+		chatAvatarUpdateCache[key] = cache
+		chatAvatarUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatAvatarQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatAvatarQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_avatars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_avatars")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatAvatarSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatAvatarSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatAvatarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_avatars\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatAvatarPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatAvatar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatAvatar")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatAvatar) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatAvatar) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_avatars provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatAvatarColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatAvatarUpsertCacheMut.RLock()
+	cache, cached := chatAvatarUpsertCache[key]
+	chatAvatarUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatAvatarAllColumns,
+			chatAvatarColumnsWithDefault,
+			chatAvatarColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatAvatarAllColumns,
+			chatAvatarPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_avatars, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatAvatarPrimaryKeyColumns))
+			copy(conflict, chatAvatarPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_avatars\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatAvatarType, chatAvatarMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatAvatarType, chatAvatarMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_avatars")
+	}
+
+	if !cached {
+		chatAvatarUpsertCacheMut.Lock()
+		chatAvatarUpsertCache[key] = cache
+		chatAvatarUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatAvatar record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatAvatar) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatAvatar record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatAvatar) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatAvatar provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatAvatarPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_avatars\" WHERE \"chat_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_avatars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_avatars")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatAvatarQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatAvatarQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatAvatarQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_avatars")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_avatars")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatAvatarSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatAvatarSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatAvatarBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatAvatarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_avatars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatAvatarPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatAvatar slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_avatars")
+	}
+
+	if len(chatAvatarAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatAvatar) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatAvatar provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatAvatar) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatAvatar(ctx, exec, o.ChatID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatAvatarSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatAvatarSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatAvatarSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatAvatarSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatAvatarPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_avatars\".* FROM \"chat_avatars\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatAvatarPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatAvatarSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatAvatarExistsG checks if the ChatAvatar row exists.
+func ChatAvatarExistsG(ctx context.Context, chatID string) (bool, error) {
+	return ChatAvatarExists(ctx, boil.GetContextDB(), chatID)
+}
+
+// ChatAvatarExists checks if the ChatAvatar row exists.
+func ChatAvatarExists(ctx context.Context, exec boil.ContextExecutor, chatID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_avatars\" where \"chat_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chatID)
+	}
+	row := exec.QueryRowContext(ctx, sql, chatID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_avatars exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatAvatar row exists.
+func (o *ChatAvatar) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatAvatarExists(ctx, exec, o.ChatID)
+}
@@ -0,0 +1,1597 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatBot is an object representing the database table.
+type ChatBot struct {
+	ID      string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID  string    `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	BotID   string    `boil:"bot_id" json:"bot_id" toml:"bot_id" yaml:"bot_id"`
+	AddedBy string    `boil:"added_by" json:"added_by" toml:"added_by" yaml:"added_by"`
+	AddedAt time.Time `boil:"added_at" json:"added_at" toml:"added_at" yaml:"added_at"`
+
+	R *chatBotR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatBotL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatBotColumns = struct {
+	ID      string
+	ChatID  string
+	BotID   string
+	AddedBy string
+	AddedAt string
+}{
+	ID:      "id",
+	ChatID:  "chat_id",
+	BotID:   "bot_id",
+	AddedBy: "added_by",
+	AddedAt: "added_at",
+}
+
+var ChatBotTableColumns = struct {
+	ID      string
+	ChatID  string
+	BotID   string
+	AddedBy string
+	AddedAt string
+}{
+	ID:      "chat_bots.id",
+	ChatID:  "chat_bots.chat_id",
+	BotID:   "chat_bots.bot_id",
+	AddedBy: "chat_bots.added_by",
+	AddedAt: "chat_bots.added_at",
+}
+
+// Generated where
+
+var ChatBotWhere = struct {
+	ID      whereHelperstring
+	ChatID  whereHelperstring
+	BotID   whereHelperstring
+	AddedBy whereHelperstring
+	AddedAt whereHelpertime_Time
+}{
+	ID:      whereHelperstring{field: "\"chat_bots\".\"id\""},
+	ChatID:  whereHelperstring{field: "\"chat_bots\".\"chat_id\""},
+	BotID:   whereHelperstring{field: "\"chat_bots\".\"bot_id\""},
+	AddedBy: whereHelperstring{field: "\"chat_bots\".\"added_by\""},
+	AddedAt: whereHelpertime_Time{field: "\"chat_bots\".\"added_at\""},
+}
+
+// ChatBotRels is where relationship names are stored.
+var ChatBotRels = struct {
+	Chat        string
+	Bot         string
+	AddedByUser string
+}{
+	Chat:        "Chat",
+	Bot:         "Bot",
+	AddedByUser: "AddedByUser",
+}
+
+// chatBotR is where relationships are stored.
+type chatBotR struct {
+	Chat        *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	Bot         *Bot  `boil:"Bot" json:"Bot" toml:"Bot" yaml:"Bot"`
+	AddedByUser *User `boil:"AddedByUser" json:"AddedByUser" toml:"AddedByUser" yaml:"AddedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatBotR) NewStruct() *chatBotR {
+	return &chatBotR{}
+}
+
+func (r *chatBotR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatBotR) GetBot() *Bot {
+	if r == nil {
+		return nil
+	}
+	return r.Bot
+}
+
+func (r *chatBotR) GetAddedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.AddedByUser
+}
+
+// chatBotL is where Load methods for each relationship are stored.
+type chatBotL struct{}
+
+var (
+	chatBotAllColumns            = []string{"id", "chat_id", "bot_id", "added_by", "added_at"}
+	chatBotColumnsWithoutDefault = []string{"chat_id", "bot_id", "added_by"}
+	chatBotColumnsWithDefault    = []string{"id", "added_at"}
+	chatBotPrimaryKeyColumns     = []string{"id"}
+	chatBotGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatBotSlice is an alias for a slice of pointers to ChatBot.
+	// This should almost always be used instead of []ChatBot.
+	ChatBotSlice []*ChatBot
+	// ChatBotHook is the signature for custom ChatBot hook methods
+	ChatBotHook func(context.Context, boil.ContextExecutor, *ChatBot) error
+
+	chatBotQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatBotType                 = reflect.TypeOf(&ChatBot{})
+	chatBotMapping              = queries.MakeStructMapping(chatBotType)
+	chatBotPrimaryKeyMapping, _ = queries.BindMapping(chatBotType, chatBotMapping, chatBotPrimaryKeyColumns)
+	chatBotInsertCacheMut       sync.RWMutex
+	chatBotInsertCache          = make(map[string]insertCache)
+	chatBotUpdateCacheMut       sync.RWMutex
+	chatBotUpdateCache          = make(map[string]updateCache)
+	chatBotUpsertCacheMut       sync.RWMutex
+	chatBotUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatBotAfterSelectHooks []ChatBotHook
+
+var chatBotBeforeInsertHooks []ChatBotHook
+var chatBotAfterInsertHooks []ChatBotHook
+
+var chatBotBeforeUpdateHooks []ChatBotHook
+var chatBotAfterUpdateHooks []ChatBotHook
+
+var chatBotBeforeDeleteHooks []ChatBotHook
+var chatBotAfterDeleteHooks []ChatBotHook
+
+var chatBotBeforeUpsertHooks []ChatBotHook
+var chatBotAfterUpsertHooks []ChatBotHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatBot) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatBot) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatBot) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatBot) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatBot) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatBot) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatBot) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatBot) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatBot) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatBotAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatBotHook registers your hook function for all future operations.
+func AddChatBotHook(hookPoint boil.HookPoint, chatBotHook ChatBotHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatBotAfterSelectHooks = append(chatBotAfterSelectHooks, chatBotHook)
+	case boil.BeforeInsertHook:
+		chatBotBeforeInsertHooks = append(chatBotBeforeInsertHooks, chatBotHook)
+	case boil.AfterInsertHook:
+		chatBotAfterInsertHooks = append(chatBotAfterInsertHooks, chatBotHook)
+	case boil.BeforeUpdateHook:
+		chatBotBeforeUpdateHooks = append(chatBotBeforeUpdateHooks, chatBotHook)
+	case boil.AfterUpdateHook:
+		chatBotAfterUpdateHooks = append(chatBotAfterUpdateHooks, chatBotHook)
+	case boil.BeforeDeleteHook:
+		chatBotBeforeDeleteHooks = append(chatBotBeforeDeleteHooks, chatBotHook)
+	case boil.AfterDeleteHook:
+		chatBotAfterDeleteHooks = append(chatBotAfterDeleteHooks, chatBotHook)
+	case boil.BeforeUpsertHook:
+		chatBotBeforeUpsertHooks = append(chatBotBeforeUpsertHooks, chatBotHook)
+	case boil.AfterUpsertHook:
+		chatBotAfterUpsertHooks = append(chatBotAfterUpsertHooks, chatBotHook)
+	}
+}
+
+// OneG returns a single chatBot record from the query using the global executor.
+func (q chatBotQuery) OneG(ctx context.Context) (*ChatBot, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatBot record from the query.
+func (q chatBotQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatBot, error) {
+	o := &ChatBot{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_bots")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatBot records from the query using the global executor.
+func (q chatBotQuery) AllG(ctx context.Context) (ChatBotSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatBot records from the query.
+func (q chatBotQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatBotSlice, error) {
+	var o []*ChatBot
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatBot slice")
+	}
+
+	if len(chatBotAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatBot records in the query using the global executor
+func (q chatBotQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatBot records in the query.
+func (q chatBotQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_bots rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatBotQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatBotQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_bots exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatBot) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// Bot pointed to by the foreign key.
+func (o *ChatBot) Bot(mods ...qm.QueryMod) botQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.BotID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Bots(queryMods...)
+}
+
+// AddedByUser pointed to by the foreign key.
+func (o *ChatBot) AddedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.AddedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatBotL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatBot interface{}, mods queries.Applicator) error {
+	var slice []*ChatBot
+	var object *ChatBot
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatBot.(*ChatBot)
+		if !ok {
+			object = new(ChatBot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatBot))
+			}
+		}
+	} else {
+		s, ok := maybeChatBot.(*[]*ChatBot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatBotR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatBotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatBots = append(foreign.R.ChatBots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatBots = append(foreign.R.ChatBots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatBotL) LoadBot(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatBot interface{}, mods queries.Applicator) error {
+	var slice []*ChatBot
+	var object *ChatBot
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatBot.(*ChatBot)
+		if !ok {
+			object = new(ChatBot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatBot))
+			}
+		}
+	} else {
+		s, ok := maybeChatBot.(*[]*ChatBot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatBotR{}
+		}
+		args = append(args, object.BotID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatBotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.BotID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.BotID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`bots`),
+		qm.WhereIn(`bots.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`bots.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Bot")
+	}
+
+	var resultSlice []*Bot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Bot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for bots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bots")
+	}
+
+	if len(botAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Bot = foreign
+		if foreign.R == nil {
+			foreign.R = &botR{}
+		}
+		foreign.R.ChatBots = append(foreign.R.ChatBots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BotID == foreign.UserID {
+				local.R.Bot = foreign
+				if foreign.R == nil {
+					foreign.R = &botR{}
+				}
+				foreign.R.ChatBots = append(foreign.R.ChatBots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadAddedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatBotL) LoadAddedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatBot interface{}, mods queries.Applicator) error {
+	var slice []*ChatBot
+	var object *ChatBot
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatBot.(*ChatBot)
+		if !ok {
+			object = new(ChatBot)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatBot))
+			}
+		}
+	} else {
+		s, ok := maybeChatBot.(*[]*ChatBot)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatBot)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatBot))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatBotR{}
+		}
+		args = append(args, object.AddedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatBotR{}
+			}
+
+			for _, a := range args {
+				if a == obj.AddedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.AddedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.AddedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.AddedByChatBots = append(foreign.R.AddedByChatBots, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.AddedBy == foreign.ID {
+				local.R.AddedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.AddedByChatBots = append(foreign.R.AddedByChatBots, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatBot to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatBots.
+// Uses the global database handle.
+func (o *ChatBot) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatBot to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatBots.
+func (o *ChatBot) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatBotR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatBots: ChatBotSlice{o},
+		}
+	} else {
+		related.R.ChatBots = append(related.R.ChatBots, o)
+	}
+
+	return nil
+}
+
+// SetBotG of the chatBot to the related item.
+// Sets o.R.Bot to related.
+// Adds o to related.R.ChatBots.
+// Uses the global database handle.
+func (o *ChatBot) SetBotG(ctx context.Context, insert bool, related *Bot) error {
+	return o.SetBot(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetBot of the chatBot to the related item.
+// Sets o.R.Bot to related.
+// Adds o to related.R.ChatBots.
+func (o *ChatBot) SetBot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Bot) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"bot_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.UserID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BotID = related.UserID
+	if o.R == nil {
+		o.R = &chatBotR{
+			Bot: related,
+		}
+	} else {
+		o.R.Bot = related
+	}
+
+	if related.R == nil {
+		related.R = &botR{
+			ChatBots: ChatBotSlice{o},
+		}
+	} else {
+		related.R.ChatBots = append(related.R.ChatBots, o)
+	}
+
+	return nil
+}
+
+// SetAddedByUserG of the chatBot to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByChatBots.
+// Uses the global database handle.
+func (o *ChatBot) SetAddedByUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetAddedByUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetAddedByUser of the chatBot to the related item.
+// Sets o.R.AddedByUser to related.
+// Adds o to related.R.AddedByChatBots.
+func (o *ChatBot) SetAddedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+		strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.AddedBy = related.ID
+	if o.R == nil {
+		o.R = &chatBotR{
+			AddedByUser: related,
+		}
+	} else {
+		o.R.AddedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			AddedByChatBots: ChatBotSlice{o},
+		}
+	} else {
+		related.R.AddedByChatBots = append(related.R.AddedByChatBots, o)
+	}
+
+	return nil
+}
+
+// ChatBots retrieves all the records using an executor.
+func ChatBots(mods ...qm.QueryMod) chatBotQuery {
+	mods = append(mods, qm.From("\"chat_bots\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_bots\".*"})
+	}
+
+	return chatBotQuery{q}
+}
+
+// FindChatBotG retrieves a single record by ID.
+func FindChatBotG(ctx context.Context, iD string, selectCols ...string) (*ChatBot, error) {
+	return FindChatBot(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatBot retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatBot(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatBot, error) {
+	chatBotObj := &ChatBot{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_bots\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatBotObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_bots")
+	}
+
+	if err = chatBotObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatBotObj, err
+	}
+
+	return chatBotObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatBot) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatBot) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_bots provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatBotColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatBotInsertCacheMut.RLock()
+	cache, cached := chatBotInsertCache[key]
+	chatBotInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatBotAllColumns,
+			chatBotColumnsWithDefault,
+			chatBotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatBotType, chatBotMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatBotType, chatBotMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_bots\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_bots\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_bots")
+	}
+
+	if !cached {
+		chatBotInsertCacheMut.Lock()
+		chatBotInsertCache[key] = cache
+		chatBotInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatBot record using the global executor.
+// See Update for more documentation.
+func (o *ChatBot) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatBot.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatBot) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatBotUpdateCacheMut.RLock()
+	cache, cached := chatBotUpdateCache[key]
+	chatBotUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatBotAllColumns,
+			chatBotPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_bots, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_bots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatBotPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatBotType, chatBotMapping, append(wl, chatBotPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_bots row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_bots")
+	}
+
+	if !cached {
+		chatBotUpdateCacheMut.Lock()
+		chatBotUpdateCache[key] = cache
+		chatBotUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatBotQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatBotQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_bots")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatBotSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatBotSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatBotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_bots\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatBotPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatBot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatBot")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatBot) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatBot) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_bots provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatBotColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatBotUpsertCacheMut.RLock()
+	cache, cached := chatBotUpsertCache[key]
+	chatBotUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatBotAllColumns,
+			chatBotColumnsWithDefault,
+			chatBotColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatBotAllColumns,
+			chatBotPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_bots, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatBotPrimaryKeyColumns))
+			copy(conflict, chatBotPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_bots\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatBotType, chatBotMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatBotType, chatBotMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_bots")
+	}
+
+	if !cached {
+		chatBotUpsertCacheMut.Lock()
+		chatBotUpsertCache[key] = cache
+		chatBotUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatBot record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatBot) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatBot record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatBot) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatBot provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatBotPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_bots\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_bots")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatBotQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatBotQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatBotQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_bots")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_bots")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatBotSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatBotSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatBotBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatBotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_bots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatBotPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatBot slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_bots")
+	}
+
+	if len(chatBotAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatBot) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatBot provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatBot) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatBot(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatBotSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatBotSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatBotSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatBotSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatBotPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_bots\".* FROM \"chat_bots\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatBotPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatBotSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatBotExistsG checks if the ChatBot row exists.
+func ChatBotExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatBotExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatBotExists checks if the ChatBot row exists.
+func ChatBotExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_bots\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_bots exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatBot row exists.
+func (o *ChatBot) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatBotExists(ctx, exec, o.ID)
+}
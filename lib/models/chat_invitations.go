@@ -0,0 +1,1741 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatInvitation is an object representing the database table.
+type ChatInvitation struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID       string      `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	InviteeID    null.String `boil:"invitee_id" json:"invitee_id,omitempty" toml:"invitee_id" yaml:"invitee_id,omitempty"`
+	InviteeEmail null.String `boil:"invitee_email" json:"invitee_email,omitempty" toml:"invitee_email" yaml:"invitee_email,omitempty"`
+	InvitorID    string      `boil:"invitor_id" json:"invitor_id" toml:"invitor_id" yaml:"invitor_id"`
+	Status       string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	TokenID      string      `boil:"token_id" json:"token_id" toml:"token_id" yaml:"token_id"`
+	InvitedAt    time.Time   `boil:"invited_at" json:"invited_at" toml:"invited_at" yaml:"invited_at"`
+	SentAt       time.Time   `boil:"sent_at" json:"sent_at" toml:"sent_at" yaml:"sent_at"`
+	ExpiresAt    time.Time   `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	AcceptedAt   null.Time   `boil:"accepted_at" json:"accepted_at,omitempty" toml:"accepted_at" yaml:"accepted_at,omitempty"`
+	RevokedAt    null.Time   `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+
+	R *chatInvitationR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatInvitationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatInvitationColumns = struct {
+	ID           string
+	ChatID       string
+	InviteeID    string
+	InviteeEmail string
+	InvitorID    string
+	Status       string
+	TokenID      string
+	InvitedAt    string
+	SentAt       string
+	ExpiresAt    string
+	AcceptedAt   string
+	RevokedAt    string
+}{
+	ID:           "id",
+	ChatID:       "chat_id",
+	InviteeID:    "invitee_id",
+	InviteeEmail: "invitee_email",
+	InvitorID:    "invitor_id",
+	Status:       "status",
+	TokenID:      "token_id",
+	InvitedAt:    "invited_at",
+	SentAt:       "sent_at",
+	ExpiresAt:    "expires_at",
+	AcceptedAt:   "accepted_at",
+	RevokedAt:    "revoked_at",
+}
+
+var ChatInvitationTableColumns = struct {
+	ID           string
+	ChatID       string
+	InviteeID    string
+	InviteeEmail string
+	InvitorID    string
+	Status       string
+	TokenID      string
+	InvitedAt    string
+	SentAt       string
+	ExpiresAt    string
+	AcceptedAt   string
+	RevokedAt    string
+}{
+	ID:           "chat_invitations.id",
+	ChatID:       "chat_invitations.chat_id",
+	InviteeID:    "chat_invitations.invitee_id",
+	InviteeEmail: "chat_invitations.invitee_email",
+	InvitorID:    "chat_invitations.invitor_id",
+	Status:       "chat_invitations.status",
+	TokenID:      "chat_invitations.token_id",
+	InvitedAt:    "chat_invitations.invited_at",
+	SentAt:       "chat_invitations.sent_at",
+	ExpiresAt:    "chat_invitations.expires_at",
+	AcceptedAt:   "chat_invitations.accepted_at",
+	RevokedAt:    "chat_invitations.revoked_at",
+}
+
+// Generated where
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" LIKE ?", x)
+}
+func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT LIKE ?", x)
+}
+func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" ILIKE ?", x)
+}
+func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
+	return qm.Where(w.field+" NOT ILIKE ?", x)
+}
+func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+var ChatInvitationWhere = struct {
+	ID           whereHelperstring
+	ChatID       whereHelperstring
+	InviteeID    whereHelpernull_String
+	InviteeEmail whereHelpernull_String
+	InvitorID    whereHelperstring
+	Status       whereHelperstring
+	TokenID      whereHelperstring
+	InvitedAt    whereHelpertime_Time
+	SentAt       whereHelpertime_Time
+	ExpiresAt    whereHelpertime_Time
+	AcceptedAt   whereHelpernull_Time
+	RevokedAt    whereHelpernull_Time
+}{
+	ID:           whereHelperstring{field: "\"chat_invitations\".\"id\""},
+	ChatID:       whereHelperstring{field: "\"chat_invitations\".\"chat_id\""},
+	InviteeID:    whereHelpernull_String{field: "\"chat_invitations\".\"invitee_id\""},
+	InviteeEmail: whereHelpernull_String{field: "\"chat_invitations\".\"invitee_email\""},
+	InvitorID:    whereHelperstring{field: "\"chat_invitations\".\"invitor_id\""},
+	Status:       whereHelperstring{field: "\"chat_invitations\".\"status\""},
+	TokenID:      whereHelperstring{field: "\"chat_invitations\".\"token_id\""},
+	InvitedAt:    whereHelpertime_Time{field: "\"chat_invitations\".\"invited_at\""},
+	SentAt:       whereHelpertime_Time{field: "\"chat_invitations\".\"sent_at\""},
+	ExpiresAt:    whereHelpertime_Time{field: "\"chat_invitations\".\"expires_at\""},
+	AcceptedAt:   whereHelpernull_Time{field: "\"chat_invitations\".\"accepted_at\""},
+	RevokedAt:    whereHelpernull_Time{field: "\"chat_invitations\".\"revoked_at\""},
+}
+
+// ChatInvitationRels is where relationship names are stored.
+var ChatInvitationRels = struct {
+	Chat    string
+	Invitee string
+	Invitor string
+}{
+	Chat:    "Chat",
+	Invitee: "Invitee",
+	Invitor: "Invitor",
+}
+
+// chatInvitationR is where relationships are stored.
+type chatInvitationR struct {
+	Chat    *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	Invitee *User `boil:"Invitee" json:"Invitee" toml:"Invitee" yaml:"Invitee"`
+	Invitor *User `boil:"Invitor" json:"Invitor" toml:"Invitor" yaml:"Invitor"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatInvitationR) NewStruct() *chatInvitationR {
+	return &chatInvitationR{}
+}
+
+func (r *chatInvitationR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatInvitationR) GetInvitee() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Invitee
+}
+
+func (r *chatInvitationR) GetInvitor() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Invitor
+}
+
+// chatInvitationL is where Load methods for each relationship are stored.
+type chatInvitationL struct{}
+
+var (
+	chatInvitationAllColumns            = []string{"id", "chat_id", "invitee_id", "invitee_email", "invitor_id", "status", "token_id", "invited_at", "sent_at", "expires_at", "accepted_at", "revoked_at"}
+	chatInvitationColumnsWithoutDefault = []string{"chat_id", "invitor_id", "expires_at"}
+	chatInvitationColumnsWithDefault    = []string{"id", "invitee_id", "invitee_email", "status", "token_id", "invited_at", "sent_at", "accepted_at", "revoked_at"}
+	chatInvitationPrimaryKeyColumns     = []string{"id"}
+	chatInvitationGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatInvitationSlice is an alias for a slice of pointers to ChatInvitation.
+	// This should almost always be used instead of []ChatInvitation.
+	ChatInvitationSlice []*ChatInvitation
+	// ChatInvitationHook is the signature for custom ChatInvitation hook methods
+	ChatInvitationHook func(context.Context, boil.ContextExecutor, *ChatInvitation) error
+
+	chatInvitationQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatInvitationType                 = reflect.TypeOf(&ChatInvitation{})
+	chatInvitationMapping              = queries.MakeStructMapping(chatInvitationType)
+	chatInvitationPrimaryKeyMapping, _ = queries.BindMapping(chatInvitationType, chatInvitationMapping, chatInvitationPrimaryKeyColumns)
+	chatInvitationInsertCacheMut       sync.RWMutex
+	chatInvitationInsertCache          = make(map[string]insertCache)
+	chatInvitationUpdateCacheMut       sync.RWMutex
+	chatInvitationUpdateCache          = make(map[string]updateCache)
+	chatInvitationUpsertCacheMut       sync.RWMutex
+	chatInvitationUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatInvitationAfterSelectHooks []ChatInvitationHook
+
+var chatInvitationBeforeInsertHooks []ChatInvitationHook
+var chatInvitationAfterInsertHooks []ChatInvitationHook
+
+var chatInvitationBeforeUpdateHooks []ChatInvitationHook
+var chatInvitationAfterUpdateHooks []ChatInvitationHook
+
+var chatInvitationBeforeDeleteHooks []ChatInvitationHook
+var chatInvitationAfterDeleteHooks []ChatInvitationHook
+
+var chatInvitationBeforeUpsertHooks []ChatInvitationHook
+var chatInvitationAfterUpsertHooks []ChatInvitationHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatInvitation) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatInvitation) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatInvitation) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatInvitation) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatInvitation) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatInvitation) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatInvitation) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatInvitation) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatInvitation) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInvitationAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatInvitationHook registers your hook function for all future operations.
+func AddChatInvitationHook(hookPoint boil.HookPoint, chatInvitationHook ChatInvitationHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatInvitationAfterSelectHooks = append(chatInvitationAfterSelectHooks, chatInvitationHook)
+	case boil.BeforeInsertHook:
+		chatInvitationBeforeInsertHooks = append(chatInvitationBeforeInsertHooks, chatInvitationHook)
+	case boil.AfterInsertHook:
+		chatInvitationAfterInsertHooks = append(chatInvitationAfterInsertHooks, chatInvitationHook)
+	case boil.BeforeUpdateHook:
+		chatInvitationBeforeUpdateHooks = append(chatInvitationBeforeUpdateHooks, chatInvitationHook)
+	case boil.AfterUpdateHook:
+		chatInvitationAfterUpdateHooks = append(chatInvitationAfterUpdateHooks, chatInvitationHook)
+	case boil.BeforeDeleteHook:
+		chatInvitationBeforeDeleteHooks = append(chatInvitationBeforeDeleteHooks, chatInvitationHook)
+	case boil.AfterDeleteHook:
+		chatInvitationAfterDeleteHooks = append(chatInvitationAfterDeleteHooks, chatInvitationHook)
+	case boil.BeforeUpsertHook:
+		chatInvitationBeforeUpsertHooks = append(chatInvitationBeforeUpsertHooks, chatInvitationHook)
+	case boil.AfterUpsertHook:
+		chatInvitationAfterUpsertHooks = append(chatInvitationAfterUpsertHooks, chatInvitationHook)
+	}
+}
+
+// OneG returns a single chatInvitation record from the query using the global executor.
+func (q chatInvitationQuery) OneG(ctx context.Context) (*ChatInvitation, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatInvitation record from the query.
+func (q chatInvitationQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatInvitation, error) {
+	o := &ChatInvitation{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_invitations")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatInvitation records from the query using the global executor.
+func (q chatInvitationQuery) AllG(ctx context.Context) (ChatInvitationSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatInvitation records from the query.
+func (q chatInvitationQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatInvitationSlice, error) {
+	var o []*ChatInvitation
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatInvitation slice")
+	}
+
+	if len(chatInvitationAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatInvitation records in the query using the global executor
+func (q chatInvitationQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatInvitation records in the query.
+func (q chatInvitationQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_invitations rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatInvitationQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatInvitationQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_invitations exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatInvitation) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// Invitee pointed to by the foreign key.
+func (o *ChatInvitation) Invitee(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InviteeID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// Invitor pointed to by the foreign key.
+func (o *ChatInvitation) Invitor(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.InvitorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatInvitationL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatInvitation interface{}, mods queries.Applicator) error {
+	var slice []*ChatInvitation
+	var object *ChatInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatInvitation.(*ChatInvitation)
+		if !ok {
+			object = new(ChatInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeChatInvitation.(*[]*ChatInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatInvitationR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatInvitations = append(foreign.R.ChatInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatInvitations = append(foreign.R.ChatInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitee allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatInvitationL) LoadInvitee(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatInvitation interface{}, mods queries.Applicator) error {
+	var slice []*ChatInvitation
+	var object *ChatInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatInvitation.(*ChatInvitation)
+		if !ok {
+			object = new(ChatInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeChatInvitation.(*[]*ChatInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatInvitationR{}
+		}
+		if !queries.IsNil(object.InviteeID) {
+			args = append(args, object.InviteeID)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatInvitationR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.InviteeID) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.InviteeID) {
+				args = append(args, obj.InviteeID)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Invitee = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InviteeChatInvitations = append(foreign.R.InviteeChatInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.InviteeID, foreign.ID) {
+				local.R.Invitee = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InviteeChatInvitations = append(foreign.R.InviteeChatInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitor allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatInvitationL) LoadInvitor(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatInvitation interface{}, mods queries.Applicator) error {
+	var slice []*ChatInvitation
+	var object *ChatInvitation
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatInvitation.(*ChatInvitation)
+		if !ok {
+			object = new(ChatInvitation)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatInvitation))
+			}
+		}
+	} else {
+		s, ok := maybeChatInvitation.(*[]*ChatInvitation)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatInvitation)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatInvitation))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatInvitationR{}
+		}
+		args = append(args, object.InvitorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatInvitationR{}
+			}
+
+			for _, a := range args {
+				if a == obj.InvitorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.InvitorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Invitor = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.InvitorChatInvitations = append(foreign.R.InvitorChatInvitations, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.InvitorID == foreign.ID {
+				local.R.Invitor = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.InvitorChatInvitations = append(foreign.R.InvitorChatInvitations, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatInvitation to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatInvitations.
+// Uses the global database handle.
+func (o *ChatInvitation) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatInvitation to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatInvitations.
+func (o *ChatInvitation) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatInvitationR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatInvitations: ChatInvitationSlice{o},
+		}
+	} else {
+		related.R.ChatInvitations = append(related.R.ChatInvitations, o)
+	}
+
+	return nil
+}
+
+// SetInviteeG of the chatInvitation to the related item.
+// Sets o.R.Invitee to related.
+// Adds o to related.R.InviteeChatInvitations.
+// Uses the global database handle.
+func (o *ChatInvitation) SetInviteeG(ctx context.Context, insert bool, related *User) error {
+	return o.SetInvitee(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetInvitee of the chatInvitation to the related item.
+// Sets o.R.Invitee to related.
+// Adds o to related.R.InviteeChatInvitations.
+func (o *ChatInvitation) SetInvitee(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"invitee_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.InviteeID, related.ID)
+	if o.R == nil {
+		o.R = &chatInvitationR{
+			Invitee: related,
+		}
+	} else {
+		o.R.Invitee = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InviteeChatInvitations: ChatInvitationSlice{o},
+		}
+	} else {
+		related.R.InviteeChatInvitations = append(related.R.InviteeChatInvitations, o)
+	}
+
+	return nil
+}
+
+// RemoveInviteeG relationship.
+// Sets o.R.Invitee to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *ChatInvitation) RemoveInviteeG(ctx context.Context, related *User) error {
+	return o.RemoveInvitee(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveInvitee relationship.
+// Sets o.R.Invitee to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ChatInvitation) RemoveInvitee(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.InviteeID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("invitee_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Invitee = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.InviteeChatInvitations {
+		if queries.Equal(o.InviteeID, ri.InviteeID) {
+			continue
+		}
+
+		ln := len(related.R.InviteeChatInvitations)
+		if ln > 1 && i < ln-1 {
+			related.R.InviteeChatInvitations[i] = related.R.InviteeChatInvitations[ln-1]
+		}
+		related.R.InviteeChatInvitations = related.R.InviteeChatInvitations[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetInvitorG of the chatInvitation to the related item.
+// Sets o.R.Invitor to related.
+// Adds o to related.R.InvitorChatInvitations.
+// Uses the global database handle.
+func (o *ChatInvitation) SetInvitorG(ctx context.Context, insert bool, related *User) error {
+	return o.SetInvitor(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetInvitor of the chatInvitation to the related item.
+// Sets o.R.Invitor to related.
+// Adds o to related.R.InvitorChatInvitations.
+func (o *ChatInvitation) SetInvitor(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"invitor_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.InvitorID = related.ID
+	if o.R == nil {
+		o.R = &chatInvitationR{
+			Invitor: related,
+		}
+	} else {
+		o.R.Invitor = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			InvitorChatInvitations: ChatInvitationSlice{o},
+		}
+	} else {
+		related.R.InvitorChatInvitations = append(related.R.InvitorChatInvitations, o)
+	}
+
+	return nil
+}
+
+// ChatInvitations retrieves all the records using an executor.
+func ChatInvitations(mods ...qm.QueryMod) chatInvitationQuery {
+	mods = append(mods, qm.From("\"chat_invitations\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_invitations\".*"})
+	}
+
+	return chatInvitationQuery{q}
+}
+
+// FindChatInvitationG retrieves a single record by ID.
+func FindChatInvitationG(ctx context.Context, iD string, selectCols ...string) (*ChatInvitation, error) {
+	return FindChatInvitation(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatInvitation retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatInvitation(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatInvitation, error) {
+	chatInvitationObj := &ChatInvitation{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_invitations\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatInvitationObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_invitations")
+	}
+
+	if err = chatInvitationObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatInvitationObj, err
+	}
+
+	return chatInvitationObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatInvitation) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatInvitation) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_invitations provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatInvitationColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatInvitationInsertCacheMut.RLock()
+	cache, cached := chatInvitationInsertCache[key]
+	chatInvitationInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatInvitationAllColumns,
+			chatInvitationColumnsWithDefault,
+			chatInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatInvitationType, chatInvitationMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatInvitationType, chatInvitationMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_invitations\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_invitations\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_invitations")
+	}
+
+	if !cached {
+		chatInvitationInsertCacheMut.Lock()
+		chatInvitationInsertCache[key] = cache
+		chatInvitationInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatInvitation record using the global executor.
+// See Update for more documentation.
+func (o *ChatInvitation) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatInvitation.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatInvitation) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatInvitationUpdateCacheMut.RLock()
+	cache, cached := chatInvitationUpdateCache[key]
+	chatInvitationUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatInvitationAllColumns,
+			chatInvitationPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_invitations, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_invitations\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatInvitationPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatInvitationType, chatInvitationMapping, append(wl, chatInvitationPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_invitations row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_invitations")
+	}
+
+	if !cached {
+		chatInvitationUpdateCacheMut.Lock()
+		chatInvitationUpdateCache[key] = cache
+		chatInvitationUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatInvitationQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatInvitationQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatInvitationSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatInvitationSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_invitations\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatInvitationPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatInvitation")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatInvitation) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatInvitation) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_invitations provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatInvitationColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatInvitationUpsertCacheMut.RLock()
+	cache, cached := chatInvitationUpsertCache[key]
+	chatInvitationUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatInvitationAllColumns,
+			chatInvitationColumnsWithDefault,
+			chatInvitationColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatInvitationAllColumns,
+			chatInvitationPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_invitations, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatInvitationPrimaryKeyColumns))
+			copy(conflict, chatInvitationPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_invitations\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatInvitationType, chatInvitationMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatInvitationType, chatInvitationMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_invitations")
+	}
+
+	if !cached {
+		chatInvitationUpsertCacheMut.Lock()
+		chatInvitationUpsertCache[key] = cache
+		chatInvitationUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatInvitation record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatInvitation) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatInvitation record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatInvitation) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatInvitation provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatInvitationPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_invitations\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_invitations")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatInvitationQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatInvitationQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatInvitationQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_invitations")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_invitations")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatInvitationSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatInvitationSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatInvitationBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatInvitationPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatInvitation slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_invitations")
+	}
+
+	if len(chatInvitationAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatInvitation) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatInvitation provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatInvitation) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatInvitation(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatInvitationSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatInvitationSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatInvitationSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatInvitationSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInvitationPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_invitations\".* FROM \"chat_invitations\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatInvitationPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatInvitationSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatInvitationExistsG checks if the ChatInvitation row exists.
+func ChatInvitationExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatInvitationExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatInvitationExists checks if the ChatInvitation row exists.
+func ChatInvitationExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_invitations\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_invitations exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatInvitation row exists.
+func (o *ChatInvitation) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatInvitationExists(ctx, exec, o.ID)
+}
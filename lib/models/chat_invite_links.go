@@ -0,0 +1,1520 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatInviteLink is an object representing the database table.
+type ChatInviteLink struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID    string    `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	CreatorID string    `boil:"creator_id" json:"creator_id" toml:"creator_id" yaml:"creator_id"`
+	Code      string    `boil:"code" json:"code" toml:"code" yaml:"code"`
+	IsRo      bool      `boil:"is_ro" json:"is_ro" toml:"is_ro" yaml:"is_ro"`
+	MaxUses   null.Int  `boil:"max_uses" json:"max_uses,omitempty" toml:"max_uses" yaml:"max_uses,omitempty"`
+	Uses      int       `boil:"uses" json:"uses" toml:"uses" yaml:"uses"`
+	ExpiresAt null.Time `boil:"expires_at" json:"expires_at,omitempty" toml:"expires_at" yaml:"expires_at,omitempty"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	RevokedAt null.Time `boil:"revoked_at" json:"revoked_at,omitempty" toml:"revoked_at" yaml:"revoked_at,omitempty"`
+
+	R *chatInviteLinkR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatInviteLinkL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatInviteLinkColumns = struct {
+	ID        string
+	ChatID    string
+	CreatorID string
+	Code      string
+	IsRo      string
+	MaxUses   string
+	Uses      string
+	ExpiresAt string
+	CreatedAt string
+	RevokedAt string
+}{
+	ID:        "id",
+	ChatID:    "chat_id",
+	CreatorID: "creator_id",
+	Code:      "code",
+	IsRo:      "is_ro",
+	MaxUses:   "max_uses",
+	Uses:      "uses",
+	ExpiresAt: "expires_at",
+	CreatedAt: "created_at",
+	RevokedAt: "revoked_at",
+}
+
+var ChatInviteLinkTableColumns = struct {
+	ID        string
+	ChatID    string
+	CreatorID string
+	Code      string
+	IsRo      string
+	MaxUses   string
+	Uses      string
+	ExpiresAt string
+	CreatedAt string
+	RevokedAt string
+}{
+	ID:        "chat_invite_links.id",
+	ChatID:    "chat_invite_links.chat_id",
+	CreatorID: "chat_invite_links.creator_id",
+	Code:      "chat_invite_links.code",
+	IsRo:      "chat_invite_links.is_ro",
+	MaxUses:   "chat_invite_links.max_uses",
+	Uses:      "chat_invite_links.uses",
+	ExpiresAt: "chat_invite_links.expires_at",
+	CreatedAt: "chat_invite_links.created_at",
+	RevokedAt: "chat_invite_links.revoked_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+type whereHelpernull_Int struct{ field string }
+
+func (w whereHelpernull_Int) EQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int) NEQ(x null.Int) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int) LT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int) LTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int) GT(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int) GTE(x null.Int) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Int) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Int) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Int) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelperint struct{ field string }
+
+func (w whereHelperint) EQ(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint) NEQ(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint) LT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint) LTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint) GT(x int) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint) GTE(x int) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint) IN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint) NIN(slice []int) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var ChatInviteLinkWhere = struct {
+	ID        whereHelperstring
+	ChatID    whereHelperstring
+	CreatorID whereHelperstring
+	Code      whereHelperstring
+	IsRo      whereHelperbool
+	MaxUses   whereHelpernull_Int
+	Uses      whereHelperint
+	ExpiresAt whereHelpernull_Time
+	CreatedAt whereHelpertime_Time
+	RevokedAt whereHelpernull_Time
+}{
+	ID:        whereHelperstring{field: "\"chat_invite_links\".\"id\""},
+	ChatID:    whereHelperstring{field: "\"chat_invite_links\".\"chat_id\""},
+	CreatorID: whereHelperstring{field: "\"chat_invite_links\".\"creator_id\""},
+	Code:      whereHelperstring{field: "\"chat_invite_links\".\"code\""},
+	IsRo:      whereHelperbool{field: "\"chat_invite_links\".\"is_ro\""},
+	MaxUses:   whereHelpernull_Int{field: "\"chat_invite_links\".\"max_uses\""},
+	Uses:      whereHelperint{field: "\"chat_invite_links\".\"uses\""},
+	ExpiresAt: whereHelpernull_Time{field: "\"chat_invite_links\".\"expires_at\""},
+	CreatedAt: whereHelpertime_Time{field: "\"chat_invite_links\".\"created_at\""},
+	RevokedAt: whereHelpernull_Time{field: "\"chat_invite_links\".\"revoked_at\""},
+}
+
+// ChatInviteLinkRels is where relationship names are stored.
+var ChatInviteLinkRels = struct {
+	Chat    string
+	Creator string
+}{
+	Chat:    "Chat",
+	Creator: "Creator",
+}
+
+// chatInviteLinkR is where relationships are stored.
+type chatInviteLinkR struct {
+	Chat    *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	Creator *User `boil:"Creator" json:"Creator" toml:"Creator" yaml:"Creator"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatInviteLinkR) NewStruct() *chatInviteLinkR {
+	return &chatInviteLinkR{}
+}
+
+func (r *chatInviteLinkR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatInviteLinkR) GetCreator() *User {
+	if r == nil {
+		return nil
+	}
+	return r.Creator
+}
+
+// chatInviteLinkL is where Load methods for each relationship are stored.
+type chatInviteLinkL struct{}
+
+var (
+	chatInviteLinkAllColumns            = []string{"id", "chat_id", "creator_id", "code", "is_ro", "max_uses", "uses", "expires_at", "created_at", "revoked_at"}
+	chatInviteLinkColumnsWithoutDefault = []string{"chat_id", "creator_id", "code"}
+	chatInviteLinkColumnsWithDefault    = []string{"id", "is_ro", "max_uses", "uses", "expires_at", "created_at", "revoked_at"}
+	chatInviteLinkPrimaryKeyColumns     = []string{"id"}
+	chatInviteLinkGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatInviteLinkSlice is an alias for a slice of pointers to ChatInviteLink.
+	// This should almost always be used instead of []ChatInviteLink.
+	ChatInviteLinkSlice []*ChatInviteLink
+	// ChatInviteLinkHook is the signature for custom ChatInviteLink hook methods
+	ChatInviteLinkHook func(context.Context, boil.ContextExecutor, *ChatInviteLink) error
+
+	chatInviteLinkQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatInviteLinkType                 = reflect.TypeOf(&ChatInviteLink{})
+	chatInviteLinkMapping              = queries.MakeStructMapping(chatInviteLinkType)
+	chatInviteLinkPrimaryKeyMapping, _ = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, chatInviteLinkPrimaryKeyColumns)
+	chatInviteLinkInsertCacheMut       sync.RWMutex
+	chatInviteLinkInsertCache          = make(map[string]insertCache)
+	chatInviteLinkUpdateCacheMut       sync.RWMutex
+	chatInviteLinkUpdateCache          = make(map[string]updateCache)
+	chatInviteLinkUpsertCacheMut       sync.RWMutex
+	chatInviteLinkUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatInviteLinkAfterSelectHooks []ChatInviteLinkHook
+
+var chatInviteLinkBeforeInsertHooks []ChatInviteLinkHook
+var chatInviteLinkAfterInsertHooks []ChatInviteLinkHook
+
+var chatInviteLinkBeforeUpdateHooks []ChatInviteLinkHook
+var chatInviteLinkAfterUpdateHooks []ChatInviteLinkHook
+
+var chatInviteLinkBeforeDeleteHooks []ChatInviteLinkHook
+var chatInviteLinkAfterDeleteHooks []ChatInviteLinkHook
+
+var chatInviteLinkBeforeUpsertHooks []ChatInviteLinkHook
+var chatInviteLinkAfterUpsertHooks []ChatInviteLinkHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatInviteLink) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatInviteLink) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatInviteLink) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatInviteLink) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatInviteLink) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatInviteLink) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatInviteLink) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatInviteLink) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatInviteLink) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatInviteLinkAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatInviteLinkHook registers your hook function for all future operations.
+func AddChatInviteLinkHook(hookPoint boil.HookPoint, chatInviteLinkHook ChatInviteLinkHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatInviteLinkAfterSelectHooks = append(chatInviteLinkAfterSelectHooks, chatInviteLinkHook)
+	case boil.BeforeInsertHook:
+		chatInviteLinkBeforeInsertHooks = append(chatInviteLinkBeforeInsertHooks, chatInviteLinkHook)
+	case boil.AfterInsertHook:
+		chatInviteLinkAfterInsertHooks = append(chatInviteLinkAfterInsertHooks, chatInviteLinkHook)
+	case boil.BeforeUpdateHook:
+		chatInviteLinkBeforeUpdateHooks = append(chatInviteLinkBeforeUpdateHooks, chatInviteLinkHook)
+	case boil.AfterUpdateHook:
+		chatInviteLinkAfterUpdateHooks = append(chatInviteLinkAfterUpdateHooks, chatInviteLinkHook)
+	case boil.BeforeDeleteHook:
+		chatInviteLinkBeforeDeleteHooks = append(chatInviteLinkBeforeDeleteHooks, chatInviteLinkHook)
+	case boil.AfterDeleteHook:
+		chatInviteLinkAfterDeleteHooks = append(chatInviteLinkAfterDeleteHooks, chatInviteLinkHook)
+	case boil.BeforeUpsertHook:
+		chatInviteLinkBeforeUpsertHooks = append(chatInviteLinkBeforeUpsertHooks, chatInviteLinkHook)
+	case boil.AfterUpsertHook:
+		chatInviteLinkAfterUpsertHooks = append(chatInviteLinkAfterUpsertHooks, chatInviteLinkHook)
+	}
+}
+
+// OneG returns a single chatInviteLink record from the query using the global executor.
+func (q chatInviteLinkQuery) OneG(ctx context.Context) (*ChatInviteLink, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatInviteLink record from the query.
+func (q chatInviteLinkQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatInviteLink, error) {
+	o := &ChatInviteLink{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_invite_links")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatInviteLink records from the query using the global executor.
+func (q chatInviteLinkQuery) AllG(ctx context.Context) (ChatInviteLinkSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatInviteLink records from the query.
+func (q chatInviteLinkQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatInviteLinkSlice, error) {
+	var o []*ChatInviteLink
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatInviteLink slice")
+	}
+
+	if len(chatInviteLinkAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatInviteLink records in the query using the global executor
+func (q chatInviteLinkQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatInviteLink records in the query.
+func (q chatInviteLinkQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_invite_links rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatInviteLinkQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatInviteLinkQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_invite_links exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatInviteLink) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// Creator pointed to by the foreign key.
+func (o *ChatInviteLink) Creator(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatorID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatInviteLinkL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatInviteLink interface{}, mods queries.Applicator) error {
+	var slice []*ChatInviteLink
+	var object *ChatInviteLink
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatInviteLink.(*ChatInviteLink)
+		if !ok {
+			object = new(ChatInviteLink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatInviteLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatInviteLink))
+			}
+		}
+	} else {
+		s, ok := maybeChatInviteLink.(*[]*ChatInviteLink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatInviteLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatInviteLink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatInviteLinkR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatInviteLinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatInviteLinks = append(foreign.R.ChatInviteLinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatInviteLinks = append(foreign.R.ChatInviteLinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreator allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatInviteLinkL) LoadCreator(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatInviteLink interface{}, mods queries.Applicator) error {
+	var slice []*ChatInviteLink
+	var object *ChatInviteLink
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatInviteLink.(*ChatInviteLink)
+		if !ok {
+			object = new(ChatInviteLink)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatInviteLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatInviteLink))
+			}
+		}
+	} else {
+		s, ok := maybeChatInviteLink.(*[]*ChatInviteLink)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatInviteLink)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatInviteLink))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatInviteLinkR{}
+		}
+		args = append(args, object.CreatorID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatInviteLinkR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatorID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatorID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Creator = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatorChatInviteLinks = append(foreign.R.CreatorChatInviteLinks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatorID == foreign.ID {
+				local.R.Creator = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatorChatInviteLinks = append(foreign.R.CreatorChatInviteLinks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatInviteLink to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatInviteLinks.
+// Uses the global database handle.
+func (o *ChatInviteLink) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatInviteLink to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatInviteLinks.
+func (o *ChatInviteLink) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_invite_links\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatInviteLinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatInviteLinkR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatInviteLinks: ChatInviteLinkSlice{o},
+		}
+	} else {
+		related.R.ChatInviteLinks = append(related.R.ChatInviteLinks, o)
+	}
+
+	return nil
+}
+
+// SetCreatorG of the chatInviteLink to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorChatInviteLinks.
+// Uses the global database handle.
+func (o *ChatInviteLink) SetCreatorG(ctx context.Context, insert bool, related *User) error {
+	return o.SetCreator(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCreator of the chatInviteLink to the related item.
+// Sets o.R.Creator to related.
+// Adds o to related.R.CreatorChatInviteLinks.
+func (o *ChatInviteLink) SetCreator(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_invite_links\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatInviteLinkPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatorID = related.ID
+	if o.R == nil {
+		o.R = &chatInviteLinkR{
+			Creator: related,
+		}
+	} else {
+		o.R.Creator = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatorChatInviteLinks: ChatInviteLinkSlice{o},
+		}
+	} else {
+		related.R.CreatorChatInviteLinks = append(related.R.CreatorChatInviteLinks, o)
+	}
+
+	return nil
+}
+
+// ChatInviteLinks retrieves all the records using an executor.
+func ChatInviteLinks(mods ...qm.QueryMod) chatInviteLinkQuery {
+	mods = append(mods, qm.From("\"chat_invite_links\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_invite_links\".*"})
+	}
+
+	return chatInviteLinkQuery{q}
+}
+
+// FindChatInviteLinkG retrieves a single record by ID.
+func FindChatInviteLinkG(ctx context.Context, iD string, selectCols ...string) (*ChatInviteLink, error) {
+	return FindChatInviteLink(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatInviteLink retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatInviteLink(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatInviteLink, error) {
+	chatInviteLinkObj := &ChatInviteLink{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_invite_links\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatInviteLinkObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_invite_links")
+	}
+
+	if err = chatInviteLinkObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatInviteLinkObj, err
+	}
+
+	return chatInviteLinkObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatInviteLink) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatInviteLink) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_invite_links provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatInviteLinkColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatInviteLinkInsertCacheMut.RLock()
+	cache, cached := chatInviteLinkInsertCache[key]
+	chatInviteLinkInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatInviteLinkAllColumns,
+			chatInviteLinkColumnsWithDefault,
+			chatInviteLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_invite_links\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_invite_links\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_invite_links")
+	}
+
+	if !cached {
+		chatInviteLinkInsertCacheMut.Lock()
+		chatInviteLinkInsertCache[key] = cache
+		chatInviteLinkInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatInviteLink record using the global executor.
+// See Update for more documentation.
+func (o *ChatInviteLink) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatInviteLink.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatInviteLink) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatInviteLinkUpdateCacheMut.RLock()
+	cache, cached := chatInviteLinkUpdateCache[key]
+	chatInviteLinkUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatInviteLinkAllColumns,
+			chatInviteLinkPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_invite_links, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_invite_links\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatInviteLinkPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, append(wl, chatInviteLinkPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_invite_links row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_invite_links")
+	}
+
+	if !cached {
+		chatInviteLinkUpdateCacheMut.Lock()
+		chatInviteLinkUpdateCache[key] = cache
+		chatInviteLinkUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatInviteLinkQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatInviteLinkQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_invite_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_invite_links")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatInviteLinkSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatInviteLinkSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInviteLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_invite_links\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatInviteLinkPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatInviteLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatInviteLink")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatInviteLink) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatInviteLink) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_invite_links provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatInviteLinkColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatInviteLinkUpsertCacheMut.RLock()
+	cache, cached := chatInviteLinkUpsertCache[key]
+	chatInviteLinkUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatInviteLinkAllColumns,
+			chatInviteLinkColumnsWithDefault,
+			chatInviteLinkColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatInviteLinkAllColumns,
+			chatInviteLinkPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_invite_links, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatInviteLinkPrimaryKeyColumns))
+			copy(conflict, chatInviteLinkPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_invite_links\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatInviteLinkType, chatInviteLinkMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_invite_links")
+	}
+
+	if !cached {
+		chatInviteLinkUpsertCacheMut.Lock()
+		chatInviteLinkUpsertCache[key] = cache
+		chatInviteLinkUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatInviteLink record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatInviteLink) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatInviteLink record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatInviteLink) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatInviteLink provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatInviteLinkPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_invite_links\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_invite_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_invite_links")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatInviteLinkQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatInviteLinkQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatInviteLinkQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_invite_links")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_invite_links")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatInviteLinkSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatInviteLinkSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatInviteLinkBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInviteLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_invite_links\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatInviteLinkPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatInviteLink slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_invite_links")
+	}
+
+	if len(chatInviteLinkAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatInviteLink) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatInviteLink provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatInviteLink) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatInviteLink(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatInviteLinkSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatInviteLinkSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatInviteLinkSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatInviteLinkSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatInviteLinkPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_invite_links\".* FROM \"chat_invite_links\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatInviteLinkPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatInviteLinkSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatInviteLinkExistsG checks if the ChatInviteLink row exists.
+func ChatInviteLinkExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatInviteLinkExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatInviteLinkExists checks if the ChatInviteLink row exists.
+func ChatInviteLinkExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_invite_links\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_invite_links exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatInviteLink row exists.
+func (o *ChatInviteLink) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatInviteLinkExists(ctx, exec, o.ID)
+}
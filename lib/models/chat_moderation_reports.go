@@ -0,0 +1,1691 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatModerationReport is an object representing the database table.
+type ChatModerationReport struct {
+	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID      string      `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	UserID      string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	MessageID   string      `boil:"message_id" json:"message_id" toml:"message_id" yaml:"message_id"`
+	MessageText string      `boil:"message_text" json:"message_text" toml:"message_text" yaml:"message_text"`
+	Reason      string      `boil:"reason" json:"reason" toml:"reason" yaml:"reason"`
+	Details     null.String `boil:"details" json:"details,omitempty" toml:"details" yaml:"details,omitempty"`
+	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	ResolvedAt  null.Time   `boil:"resolved_at" json:"resolved_at,omitempty" toml:"resolved_at" yaml:"resolved_at,omitempty"`
+	ResolvedBy  null.String `boil:"resolved_by" json:"resolved_by,omitempty" toml:"resolved_by" yaml:"resolved_by,omitempty"`
+
+	R *chatModerationReportR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatModerationReportL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatModerationReportColumns = struct {
+	ID          string
+	ChatID      string
+	UserID      string
+	MessageID   string
+	MessageText string
+	Reason      string
+	Details     string
+	CreatedAt   string
+	ResolvedAt  string
+	ResolvedBy  string
+}{
+	ID:          "id",
+	ChatID:      "chat_id",
+	UserID:      "user_id",
+	MessageID:   "message_id",
+	MessageText: "message_text",
+	Reason:      "reason",
+	Details:     "details",
+	CreatedAt:   "created_at",
+	ResolvedAt:  "resolved_at",
+	ResolvedBy:  "resolved_by",
+}
+
+var ChatModerationReportTableColumns = struct {
+	ID          string
+	ChatID      string
+	UserID      string
+	MessageID   string
+	MessageText string
+	Reason      string
+	Details     string
+	CreatedAt   string
+	ResolvedAt  string
+	ResolvedBy  string
+}{
+	ID:          "chat_moderation_reports.id",
+	ChatID:      "chat_moderation_reports.chat_id",
+	UserID:      "chat_moderation_reports.user_id",
+	MessageID:   "chat_moderation_reports.message_id",
+	MessageText: "chat_moderation_reports.message_text",
+	Reason:      "chat_moderation_reports.reason",
+	Details:     "chat_moderation_reports.details",
+	CreatedAt:   "chat_moderation_reports.created_at",
+	ResolvedAt:  "chat_moderation_reports.resolved_at",
+	ResolvedBy:  "chat_moderation_reports.resolved_by",
+}
+
+// Generated where
+
+var ChatModerationReportWhere = struct {
+	ID          whereHelperstring
+	ChatID      whereHelperstring
+	UserID      whereHelperstring
+	MessageID   whereHelperstring
+	MessageText whereHelperstring
+	Reason      whereHelperstring
+	Details     whereHelpernull_String
+	CreatedAt   whereHelpertime_Time
+	ResolvedAt  whereHelpernull_Time
+	ResolvedBy  whereHelpernull_String
+}{
+	ID:          whereHelperstring{field: "\"chat_moderation_reports\".\"id\""},
+	ChatID:      whereHelperstring{field: "\"chat_moderation_reports\".\"chat_id\""},
+	UserID:      whereHelperstring{field: "\"chat_moderation_reports\".\"user_id\""},
+	MessageID:   whereHelperstring{field: "\"chat_moderation_reports\".\"message_id\""},
+	MessageText: whereHelperstring{field: "\"chat_moderation_reports\".\"message_text\""},
+	Reason:      whereHelperstring{field: "\"chat_moderation_reports\".\"reason\""},
+	Details:     whereHelpernull_String{field: "\"chat_moderation_reports\".\"details\""},
+	CreatedAt:   whereHelpertime_Time{field: "\"chat_moderation_reports\".\"created_at\""},
+	ResolvedAt:  whereHelpernull_Time{field: "\"chat_moderation_reports\".\"resolved_at\""},
+	ResolvedBy:  whereHelpernull_String{field: "\"chat_moderation_reports\".\"resolved_by\""},
+}
+
+// ChatModerationReportRels is where relationship names are stored.
+var ChatModerationReportRels = struct {
+	Chat           string
+	User           string
+	ResolvedByUser string
+}{
+	Chat:           "Chat",
+	User:           "User",
+	ResolvedByUser: "ResolvedByUser",
+}
+
+// chatModerationReportR is where relationships are stored.
+type chatModerationReportR struct {
+	Chat           *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	User           *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+	ResolvedByUser *User `boil:"ResolvedByUser" json:"ResolvedByUser" toml:"ResolvedByUser" yaml:"ResolvedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatModerationReportR) NewStruct() *chatModerationReportR {
+	return &chatModerationReportR{}
+}
+
+func (r *chatModerationReportR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatModerationReportR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *chatModerationReportR) GetResolvedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.ResolvedByUser
+}
+
+// chatModerationReportL is where Load methods for each relationship are stored.
+type chatModerationReportL struct{}
+
+var (
+	chatModerationReportAllColumns            = []string{"id", "chat_id", "user_id", "message_id", "message_text", "reason", "details", "created_at", "resolved_at", "resolved_by"}
+	chatModerationReportColumnsWithoutDefault = []string{"chat_id", "user_id", "message_id", "message_text", "reason"}
+	chatModerationReportColumnsWithDefault    = []string{"id", "details", "created_at", "resolved_at", "resolved_by"}
+	chatModerationReportPrimaryKeyColumns     = []string{"id"}
+	chatModerationReportGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatModerationReportSlice is an alias for a slice of pointers to ChatModerationReport.
+	// This should almost always be used instead of []ChatModerationReport.
+	ChatModerationReportSlice []*ChatModerationReport
+	// ChatModerationReportHook is the signature for custom ChatModerationReport hook methods
+	ChatModerationReportHook func(context.Context, boil.ContextExecutor, *ChatModerationReport) error
+
+	chatModerationReportQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatModerationReportType                 = reflect.TypeOf(&ChatModerationReport{})
+	chatModerationReportMapping              = queries.MakeStructMapping(chatModerationReportType)
+	chatModerationReportPrimaryKeyMapping, _ = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, chatModerationReportPrimaryKeyColumns)
+	chatModerationReportInsertCacheMut       sync.RWMutex
+	chatModerationReportInsertCache          = make(map[string]insertCache)
+	chatModerationReportUpdateCacheMut       sync.RWMutex
+	chatModerationReportUpdateCache          = make(map[string]updateCache)
+	chatModerationReportUpsertCacheMut       sync.RWMutex
+	chatModerationReportUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatModerationReportAfterSelectHooks []ChatModerationReportHook
+
+var chatModerationReportBeforeInsertHooks []ChatModerationReportHook
+var chatModerationReportAfterInsertHooks []ChatModerationReportHook
+
+var chatModerationReportBeforeUpdateHooks []ChatModerationReportHook
+var chatModerationReportAfterUpdateHooks []ChatModerationReportHook
+
+var chatModerationReportBeforeDeleteHooks []ChatModerationReportHook
+var chatModerationReportAfterDeleteHooks []ChatModerationReportHook
+
+var chatModerationReportBeforeUpsertHooks []ChatModerationReportHook
+var chatModerationReportAfterUpsertHooks []ChatModerationReportHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatModerationReport) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatModerationReport) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatModerationReport) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatModerationReport) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatModerationReport) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatModerationReport) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatModerationReport) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatModerationReport) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatModerationReport) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatModerationReportAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatModerationReportHook registers your hook function for all future operations.
+func AddChatModerationReportHook(hookPoint boil.HookPoint, chatModerationReportHook ChatModerationReportHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatModerationReportAfterSelectHooks = append(chatModerationReportAfterSelectHooks, chatModerationReportHook)
+	case boil.BeforeInsertHook:
+		chatModerationReportBeforeInsertHooks = append(chatModerationReportBeforeInsertHooks, chatModerationReportHook)
+	case boil.AfterInsertHook:
+		chatModerationReportAfterInsertHooks = append(chatModerationReportAfterInsertHooks, chatModerationReportHook)
+	case boil.BeforeUpdateHook:
+		chatModerationReportBeforeUpdateHooks = append(chatModerationReportBeforeUpdateHooks, chatModerationReportHook)
+	case boil.AfterUpdateHook:
+		chatModerationReportAfterUpdateHooks = append(chatModerationReportAfterUpdateHooks, chatModerationReportHook)
+	case boil.BeforeDeleteHook:
+		chatModerationReportBeforeDeleteHooks = append(chatModerationReportBeforeDeleteHooks, chatModerationReportHook)
+	case boil.AfterDeleteHook:
+		chatModerationReportAfterDeleteHooks = append(chatModerationReportAfterDeleteHooks, chatModerationReportHook)
+	case boil.BeforeUpsertHook:
+		chatModerationReportBeforeUpsertHooks = append(chatModerationReportBeforeUpsertHooks, chatModerationReportHook)
+	case boil.AfterUpsertHook:
+		chatModerationReportAfterUpsertHooks = append(chatModerationReportAfterUpsertHooks, chatModerationReportHook)
+	}
+}
+
+// OneG returns a single chatModerationReport record from the query using the global executor.
+func (q chatModerationReportQuery) OneG(ctx context.Context) (*ChatModerationReport, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatModerationReport record from the query.
+func (q chatModerationReportQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatModerationReport, error) {
+	o := &ChatModerationReport{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_moderation_reports")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatModerationReport records from the query using the global executor.
+func (q chatModerationReportQuery) AllG(ctx context.Context) (ChatModerationReportSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatModerationReport records from the query.
+func (q chatModerationReportQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatModerationReportSlice, error) {
+	var o []*ChatModerationReport
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatModerationReport slice")
+	}
+
+	if len(chatModerationReportAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatModerationReport records in the query using the global executor
+func (q chatModerationReportQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatModerationReport records in the query.
+func (q chatModerationReportQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_moderation_reports rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatModerationReportQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatModerationReportQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_moderation_reports exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatModerationReport) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ChatModerationReport) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// ResolvedByUser pointed to by the foreign key.
+func (o *ChatModerationReport) ResolvedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ResolvedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatModerationReportL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatModerationReport interface{}, mods queries.Applicator) error {
+	var slice []*ChatModerationReport
+	var object *ChatModerationReport
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatModerationReport.(*ChatModerationReport)
+		if !ok {
+			object = new(ChatModerationReport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatModerationReport))
+			}
+		}
+	} else {
+		s, ok := maybeChatModerationReport.(*[]*ChatModerationReport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatModerationReport))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatModerationReportR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatModerationReportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatModerationReports = append(foreign.R.ChatModerationReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatModerationReports = append(foreign.R.ChatModerationReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatModerationReportL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatModerationReport interface{}, mods queries.Applicator) error {
+	var slice []*ChatModerationReport
+	var object *ChatModerationReport
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatModerationReport.(*ChatModerationReport)
+		if !ok {
+			object = new(ChatModerationReport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatModerationReport))
+			}
+		}
+	} else {
+		s, ok := maybeChatModerationReport.(*[]*ChatModerationReport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatModerationReport))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatModerationReportR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatModerationReportR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChatModerationReports = append(foreign.R.ChatModerationReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChatModerationReports = append(foreign.R.ChatModerationReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatModerationReportL) LoadResolvedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatModerationReport interface{}, mods queries.Applicator) error {
+	var slice []*ChatModerationReport
+	var object *ChatModerationReport
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatModerationReport.(*ChatModerationReport)
+		if !ok {
+			object = new(ChatModerationReport)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatModerationReport))
+			}
+		}
+	} else {
+		s, ok := maybeChatModerationReport.(*[]*ChatModerationReport)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatModerationReport)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatModerationReport))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatModerationReportR{}
+		}
+		if !queries.IsNil(object.ResolvedBy) {
+			args = append(args, object.ResolvedBy)
+		}
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatModerationReportR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ResolvedBy) {
+					continue Outer
+				}
+			}
+
+			if !queries.IsNil(obj.ResolvedBy) {
+				args = append(args, obj.ResolvedBy)
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ResolvedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ResolvedByChatModerationReports = append(foreign.R.ResolvedByChatModerationReports, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.ResolvedBy, foreign.ID) {
+				local.R.ResolvedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ResolvedByChatModerationReports = append(foreign.R.ResolvedByChatModerationReports, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatModerationReport to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatModerationReports.
+// Uses the global database handle.
+func (o *ChatModerationReport) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatModerationReport to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatModerationReports.
+func (o *ChatModerationReport) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatModerationReportR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatModerationReports: ChatModerationReportSlice{o},
+		}
+	} else {
+		related.R.ChatModerationReports = append(related.R.ChatModerationReports, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the chatModerationReport to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatModerationReports.
+// Uses the global database handle.
+func (o *ChatModerationReport) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the chatModerationReport to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatModerationReports.
+func (o *ChatModerationReport) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &chatModerationReportR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChatModerationReports: ChatModerationReportSlice{o},
+		}
+	} else {
+		related.R.ChatModerationReports = append(related.R.ChatModerationReports, o)
+	}
+
+	return nil
+}
+
+// SetResolvedByUserG of the chatModerationReport to the related item.
+// Sets o.R.ResolvedByUser to related.
+// Adds o to related.R.ResolvedByChatModerationReports.
+// Uses the global database handle.
+func (o *ChatModerationReport) SetResolvedByUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetResolvedByUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetResolvedByUser of the chatModerationReport to the related item.
+// Sets o.R.ResolvedByUser to related.
+// Adds o to related.R.ResolvedByChatModerationReports.
+func (o *ChatModerationReport) SetResolvedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by"}),
+		strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.ResolvedBy, related.ID)
+	if o.R == nil {
+		o.R = &chatModerationReportR{
+			ResolvedByUser: related,
+		}
+	} else {
+		o.R.ResolvedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ResolvedByChatModerationReports: ChatModerationReportSlice{o},
+		}
+	} else {
+		related.R.ResolvedByChatModerationReports = append(related.R.ResolvedByChatModerationReports, o)
+	}
+
+	return nil
+}
+
+// RemoveResolvedByUserG relationship.
+// Sets o.R.ResolvedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *ChatModerationReport) RemoveResolvedByUserG(ctx context.Context, related *User) error {
+	return o.RemoveResolvedByUser(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveResolvedByUser relationship.
+// Sets o.R.ResolvedByUser to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *ChatModerationReport) RemoveResolvedByUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.ResolvedBy, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.ResolvedByUser = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ResolvedByChatModerationReports {
+		if queries.Equal(o.ResolvedBy, ri.ResolvedBy) {
+			continue
+		}
+
+		ln := len(related.R.ResolvedByChatModerationReports)
+		if ln > 1 && i < ln-1 {
+			related.R.ResolvedByChatModerationReports[i] = related.R.ResolvedByChatModerationReports[ln-1]
+		}
+		related.R.ResolvedByChatModerationReports = related.R.ResolvedByChatModerationReports[:ln-1]
+		break
+	}
+	return nil
+}
+
+// ChatModerationReports retrieves all the records using an executor.
+func ChatModerationReports(mods ...qm.QueryMod) chatModerationReportQuery {
+	mods = append(mods, qm.From("\"chat_moderation_reports\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_moderation_reports\".*"})
+	}
+
+	return chatModerationReportQuery{q}
+}
+
+// FindChatModerationReportG retrieves a single record by ID.
+func FindChatModerationReportG(ctx context.Context, iD string, selectCols ...string) (*ChatModerationReport, error) {
+	return FindChatModerationReport(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatModerationReport retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatModerationReport(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatModerationReport, error) {
+	chatModerationReportObj := &ChatModerationReport{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_moderation_reports\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatModerationReportObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_moderation_reports")
+	}
+
+	if err = chatModerationReportObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatModerationReportObj, err
+	}
+
+	return chatModerationReportObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatModerationReport) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatModerationReport) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_moderation_reports provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatModerationReportColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatModerationReportInsertCacheMut.RLock()
+	cache, cached := chatModerationReportInsertCache[key]
+	chatModerationReportInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatModerationReportAllColumns,
+			chatModerationReportColumnsWithDefault,
+			chatModerationReportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_moderation_reports\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_moderation_reports\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_moderation_reports")
+	}
+
+	if !cached {
+		chatModerationReportInsertCacheMut.Lock()
+		chatModerationReportInsertCache[key] = cache
+		chatModerationReportInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatModerationReport record using the global executor.
+// See Update for more documentation.
+func (o *ChatModerationReport) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatModerationReport.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatModerationReport) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatModerationReportUpdateCacheMut.RLock()
+	cache, cached := chatModerationReportUpdateCache[key]
+	chatModerationReportUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatModerationReportAllColumns,
+			chatModerationReportPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_moderation_reports, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatModerationReportPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, append(wl, chatModerationReportPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_moderation_reports row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_moderation_reports")
+	}
+
+	if !cached {
+		chatModerationReportUpdateCacheMut.Lock()
+		chatModerationReportUpdateCache[key] = cache
+		chatModerationReportUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatModerationReportQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatModerationReportQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_moderation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_moderation_reports")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatModerationReportSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatModerationReportSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatModerationReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatModerationReportPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatModerationReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatModerationReport")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatModerationReport) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatModerationReport) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_moderation_reports provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatModerationReportColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatModerationReportUpsertCacheMut.RLock()
+	cache, cached := chatModerationReportUpsertCache[key]
+	chatModerationReportUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatModerationReportAllColumns,
+			chatModerationReportColumnsWithDefault,
+			chatModerationReportColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatModerationReportAllColumns,
+			chatModerationReportPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_moderation_reports, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatModerationReportPrimaryKeyColumns))
+			copy(conflict, chatModerationReportPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_moderation_reports\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatModerationReportType, chatModerationReportMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_moderation_reports")
+	}
+
+	if !cached {
+		chatModerationReportUpsertCacheMut.Lock()
+		chatModerationReportUpsertCache[key] = cache
+		chatModerationReportUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatModerationReport record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatModerationReport) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatModerationReport record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatModerationReport) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatModerationReport provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatModerationReportPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_moderation_reports\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_moderation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_moderation_reports")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatModerationReportQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatModerationReportQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatModerationReportQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_moderation_reports")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_moderation_reports")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatModerationReportSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatModerationReportSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatModerationReportBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatModerationReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_moderation_reports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatModerationReportPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatModerationReport slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_moderation_reports")
+	}
+
+	if len(chatModerationReportAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatModerationReport) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatModerationReport provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatModerationReport) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatModerationReport(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatModerationReportSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatModerationReportSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatModerationReportSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatModerationReportSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatModerationReportPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_moderation_reports\".* FROM \"chat_moderation_reports\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatModerationReportPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatModerationReportSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatModerationReportExistsG checks if the ChatModerationReport row exists.
+func ChatModerationReportExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatModerationReportExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatModerationReportExists checks if the ChatModerationReport row exists.
+func ChatModerationReportExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_moderation_reports\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_moderation_reports exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatModerationReport row exists.
+func (o *ChatModerationReport) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatModerationReportExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1429 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatPin is an object representing the database table.
+type ChatPin struct {
+	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID    string      `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	Kind      string      `boil:"kind" json:"kind" toml:"kind" yaml:"kind"`
+	URL       null.String `boil:"url" json:"url,omitempty" toml:"url" yaml:"url,omitempty"`
+	MessageID null.String `boil:"message_id" json:"message_id,omitempty" toml:"message_id" yaml:"message_id,omitempty"`
+	Title     null.String `boil:"title" json:"title,omitempty" toml:"title" yaml:"title,omitempty"`
+	Position  int         `boil:"position" json:"position" toml:"position" yaml:"position"`
+	PinnedBy  string      `boil:"pinned_by" json:"pinned_by" toml:"pinned_by" yaml:"pinned_by"`
+	PinnedAt  time.Time   `boil:"pinned_at" json:"pinned_at" toml:"pinned_at" yaml:"pinned_at"`
+
+	R *chatPinR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatPinL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatPinColumns = struct {
+	ID        string
+	ChatID    string
+	Kind      string
+	URL       string
+	MessageID string
+	Title     string
+	Position  string
+	PinnedBy  string
+	PinnedAt  string
+}{
+	ID:        "id",
+	ChatID:    "chat_id",
+	Kind:      "kind",
+	URL:       "url",
+	MessageID: "message_id",
+	Title:     "title",
+	Position:  "position",
+	PinnedBy:  "pinned_by",
+	PinnedAt:  "pinned_at",
+}
+
+var ChatPinTableColumns = struct {
+	ID        string
+	ChatID    string
+	Kind      string
+	URL       string
+	MessageID string
+	Title     string
+	Position  string
+	PinnedBy  string
+	PinnedAt  string
+}{
+	ID:        "chat_pins.id",
+	ChatID:    "chat_pins.chat_id",
+	Kind:      "chat_pins.kind",
+	URL:       "chat_pins.url",
+	MessageID: "chat_pins.message_id",
+	Title:     "chat_pins.title",
+	Position:  "chat_pins.position",
+	PinnedBy:  "chat_pins.pinned_by",
+	PinnedAt:  "chat_pins.pinned_at",
+}
+
+// Generated where
+
+var ChatPinWhere = struct {
+	ID        whereHelperstring
+	ChatID    whereHelperstring
+	Kind      whereHelperstring
+	URL       whereHelpernull_String
+	MessageID whereHelpernull_String
+	Title     whereHelpernull_String
+	Position  whereHelperint
+	PinnedBy  whereHelperstring
+	PinnedAt  whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"chat_pins\".\"id\""},
+	ChatID:    whereHelperstring{field: "\"chat_pins\".\"chat_id\""},
+	Kind:      whereHelperstring{field: "\"chat_pins\".\"kind\""},
+	URL:       whereHelpernull_String{field: "\"chat_pins\".\"url\""},
+	MessageID: whereHelpernull_String{field: "\"chat_pins\".\"message_id\""},
+	Title:     whereHelpernull_String{field: "\"chat_pins\".\"title\""},
+	Position:  whereHelperint{field: "\"chat_pins\".\"position\""},
+	PinnedBy:  whereHelperstring{field: "\"chat_pins\".\"pinned_by\""},
+	PinnedAt:  whereHelpertime_Time{field: "\"chat_pins\".\"pinned_at\""},
+}
+
+// ChatPinRels is where relationship names are stored.
+var ChatPinRels = struct {
+	Chat         string
+	PinnedByUser string
+}{
+	Chat:         "Chat",
+	PinnedByUser: "PinnedByUser",
+}
+
+// chatPinR is where relationships are stored.
+type chatPinR struct {
+	Chat         *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	PinnedByUser *User `boil:"PinnedByUser" json:"PinnedByUser" toml:"PinnedByUser" yaml:"PinnedByUser"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatPinR) NewStruct() *chatPinR {
+	return &chatPinR{}
+}
+
+func (r *chatPinR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatPinR) GetPinnedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.PinnedByUser
+}
+
+// chatPinL is where Load methods for each relationship are stored.
+type chatPinL struct{}
+
+var (
+	chatPinAllColumns            = []string{"id", "chat_id", "kind", "url", "message_id", "title", "position", "pinned_by", "pinned_at"}
+	chatPinColumnsWithoutDefault = []string{"chat_id", "kind", "pinned_by"}
+	chatPinColumnsWithDefault    = []string{"id", "url", "message_id", "title", "position", "pinned_at"}
+	chatPinPrimaryKeyColumns     = []string{"id"}
+	chatPinGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatPinSlice is an alias for a slice of pointers to ChatPin.
+	// This should almost always be used instead of []ChatPin.
+	ChatPinSlice []*ChatPin
+	// ChatPinHook is the signature for custom ChatPin hook methods
+	ChatPinHook func(context.Context, boil.ContextExecutor, *ChatPin) error
+
+	chatPinQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatPinType                 = reflect.TypeOf(&ChatPin{})
+	chatPinMapping              = queries.MakeStructMapping(chatPinType)
+	chatPinPrimaryKeyMapping, _ = queries.BindMapping(chatPinType, chatPinMapping, chatPinPrimaryKeyColumns)
+	chatPinInsertCacheMut       sync.RWMutex
+	chatPinInsertCache          = make(map[string]insertCache)
+	chatPinUpdateCacheMut       sync.RWMutex
+	chatPinUpdateCache          = make(map[string]updateCache)
+	chatPinUpsertCacheMut       sync.RWMutex
+	chatPinUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatPinAfterSelectHooks []ChatPinHook
+
+var chatPinBeforeInsertHooks []ChatPinHook
+var chatPinAfterInsertHooks []ChatPinHook
+
+var chatPinBeforeUpdateHooks []ChatPinHook
+var chatPinAfterUpdateHooks []ChatPinHook
+
+var chatPinBeforeDeleteHooks []ChatPinHook
+var chatPinAfterDeleteHooks []ChatPinHook
+
+var chatPinBeforeUpsertHooks []ChatPinHook
+var chatPinAfterUpsertHooks []ChatPinHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatPin) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatPin) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatPin) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatPin) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatPin) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatPin) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatPin) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatPin) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatPin) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPinAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatPinHook registers your hook function for all future operations.
+func AddChatPinHook(hookPoint boil.HookPoint, chatPinHook ChatPinHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatPinAfterSelectHooks = append(chatPinAfterSelectHooks, chatPinHook)
+	case boil.BeforeInsertHook:
+		chatPinBeforeInsertHooks = append(chatPinBeforeInsertHooks, chatPinHook)
+	case boil.AfterInsertHook:
+		chatPinAfterInsertHooks = append(chatPinAfterInsertHooks, chatPinHook)
+	case boil.BeforeUpdateHook:
+		chatPinBeforeUpdateHooks = append(chatPinBeforeUpdateHooks, chatPinHook)
+	case boil.AfterUpdateHook:
+		chatPinAfterUpdateHooks = append(chatPinAfterUpdateHooks, chatPinHook)
+	case boil.BeforeDeleteHook:
+		chatPinBeforeDeleteHooks = append(chatPinBeforeDeleteHooks, chatPinHook)
+	case boil.AfterDeleteHook:
+		chatPinAfterDeleteHooks = append(chatPinAfterDeleteHooks, chatPinHook)
+	case boil.BeforeUpsertHook:
+		chatPinBeforeUpsertHooks = append(chatPinBeforeUpsertHooks, chatPinHook)
+	case boil.AfterUpsertHook:
+		chatPinAfterUpsertHooks = append(chatPinAfterUpsertHooks, chatPinHook)
+	}
+}
+
+// OneG returns a single chatPin record from the query using the global executor.
+func (q chatPinQuery) OneG(ctx context.Context) (*ChatPin, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatPin record from the query.
+func (q chatPinQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatPin, error) {
+	o := &ChatPin{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_pins")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatPin records from the query using the global executor.
+func (q chatPinQuery) AllG(ctx context.Context) (ChatPinSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatPin records from the query.
+func (q chatPinQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatPinSlice, error) {
+	var o []*ChatPin
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatPin slice")
+	}
+
+	if len(chatPinAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatPin records in the query using the global executor
+func (q chatPinQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatPin records in the query.
+func (q chatPinQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_pins rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatPinQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatPinQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_pins exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatPin) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// PinnedByUser pointed to by the foreign key.
+func (o *ChatPin) PinnedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.PinnedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatPinL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatPin interface{}, mods queries.Applicator) error {
+	var slice []*ChatPin
+	var object *ChatPin
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatPin.(*ChatPin)
+		if !ok {
+			object = new(ChatPin)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatPin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatPin))
+			}
+		}
+	} else {
+		s, ok := maybeChatPin.(*[]*ChatPin)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatPin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatPin))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatPinR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatPinR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatPins = append(foreign.R.ChatPins, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatPins = append(foreign.R.ChatPins, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPinnedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatPinL) LoadPinnedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatPin interface{}, mods queries.Applicator) error {
+	var slice []*ChatPin
+	var object *ChatPin
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatPin.(*ChatPin)
+		if !ok {
+			object = new(ChatPin)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatPin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatPin))
+			}
+		}
+	} else {
+		s, ok := maybeChatPin.(*[]*ChatPin)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatPin)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatPin))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatPinR{}
+		}
+		args = append(args, object.PinnedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatPinR{}
+			}
+
+			for _, a := range args {
+				if a == obj.PinnedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.PinnedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PinnedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PinnedByChatPins = append(foreign.R.PinnedByChatPins, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.PinnedBy == foreign.ID {
+				local.R.PinnedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PinnedByChatPins = append(foreign.R.PinnedByChatPins, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatPin to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatPins.
+// Uses the global database handle.
+func (o *ChatPin) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatPin to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatPins.
+func (o *ChatPin) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_pins\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatPinPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatPinR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatPins: ChatPinSlice{o},
+		}
+	} else {
+		related.R.ChatPins = append(related.R.ChatPins, o)
+	}
+
+	return nil
+}
+
+// SetPinnedByUserG of the chatPin to the related item.
+// Sets o.R.PinnedByUser to related.
+// Adds o to related.R.PinnedByChatPins.
+// Uses the global database handle.
+func (o *ChatPin) SetPinnedByUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetPinnedByUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetPinnedByUser of the chatPin to the related item.
+// Sets o.R.PinnedByUser to related.
+// Adds o to related.R.PinnedByChatPins.
+func (o *ChatPin) SetPinnedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_pins\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"pinned_by"}),
+		strmangle.WhereClause("\"", "\"", 2, chatPinPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.PinnedBy = related.ID
+	if o.R == nil {
+		o.R = &chatPinR{
+			PinnedByUser: related,
+		}
+	} else {
+		o.R.PinnedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PinnedByChatPins: ChatPinSlice{o},
+		}
+	} else {
+		related.R.PinnedByChatPins = append(related.R.PinnedByChatPins, o)
+	}
+
+	return nil
+}
+
+// ChatPins retrieves all the records using an executor.
+func ChatPins(mods ...qm.QueryMod) chatPinQuery {
+	mods = append(mods, qm.From("\"chat_pins\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_pins\".*"})
+	}
+
+	return chatPinQuery{q}
+}
+
+// FindChatPinG retrieves a single record by ID.
+func FindChatPinG(ctx context.Context, iD string, selectCols ...string) (*ChatPin, error) {
+	return FindChatPin(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatPin retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatPin(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatPin, error) {
+	chatPinObj := &ChatPin{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_pins\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatPinObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_pins")
+	}
+
+	if err = chatPinObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatPinObj, err
+	}
+
+	return chatPinObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatPin) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatPin) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_pins provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatPinColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatPinInsertCacheMut.RLock()
+	cache, cached := chatPinInsertCache[key]
+	chatPinInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatPinAllColumns,
+			chatPinColumnsWithDefault,
+			chatPinColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatPinType, chatPinMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatPinType, chatPinMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_pins\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_pins\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_pins")
+	}
+
+	if !cached {
+		chatPinInsertCacheMut.Lock()
+		chatPinInsertCache[key] = cache
+		chatPinInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatPin record using the global executor.
+// See Update for more documentation.
+func (o *ChatPin) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatPin.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatPin) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatPinUpdateCacheMut.RLock()
+	cache, cached := chatPinUpdateCache[key]
+	chatPinUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatPinAllColumns,
+			chatPinPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_pins, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_pins\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatPinPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatPinType, chatPinMapping, append(wl, chatPinPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_pins row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_pins")
+	}
+
+	if !cached {
+		chatPinUpdateCacheMut.Lock()
+		chatPinUpdateCache[key] = cache
+		chatPinUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatPinQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatPinQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_pins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_pins")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatPinSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatPinSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPinPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_pins\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatPinPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatPin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatPin")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatPin) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatPin) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_pins provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatPinColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatPinUpsertCacheMut.RLock()
+	cache, cached := chatPinUpsertCache[key]
+	chatPinUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatPinAllColumns,
+			chatPinColumnsWithDefault,
+			chatPinColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatPinAllColumns,
+			chatPinPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_pins, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatPinPrimaryKeyColumns))
+			copy(conflict, chatPinPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_pins\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatPinType, chatPinMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatPinType, chatPinMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_pins")
+	}
+
+	if !cached {
+		chatPinUpsertCacheMut.Lock()
+		chatPinUpsertCache[key] = cache
+		chatPinUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatPin record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatPin) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatPin record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatPin) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatPin provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatPinPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_pins\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_pins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_pins")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatPinQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatPinQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatPinQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_pins")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_pins")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatPinSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatPinSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatPinBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPinPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_pins\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPinPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatPin slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_pins")
+	}
+
+	if len(chatPinAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatPin) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatPin provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatPin) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatPin(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatPinSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatPinSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatPinSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatPinSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPinPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_pins\".* FROM \"chat_pins\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPinPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatPinSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatPinExistsG checks if the ChatPin row exists.
+func ChatPinExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatPinExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatPinExists checks if the ChatPin row exists.
+func ChatPinExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_pins\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_pins exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatPin row exists.
+func (o *ChatPin) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatPinExists(ctx, exec, o.ID)
+}
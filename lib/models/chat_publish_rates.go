@@ -0,0 +1,1039 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatPublishRate is an object representing the database table.
+type ChatPublishRate struct {
+	UserID      string           `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	Channel     string           `boil:"channel" json:"channel" toml:"channel" yaml:"channel"`
+	PublishedAt types.Int64Array `boil:"published_at" json:"published_at" toml:"published_at" yaml:"published_at"`
+	UpdatedAt   time.Time        `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *chatPublishRateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatPublishRateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatPublishRateColumns = struct {
+	UserID      string
+	Channel     string
+	PublishedAt string
+	UpdatedAt   string
+}{
+	UserID:      "user_id",
+	Channel:     "channel",
+	PublishedAt: "published_at",
+	UpdatedAt:   "updated_at",
+}
+
+var ChatPublishRateTableColumns = struct {
+	UserID      string
+	Channel     string
+	PublishedAt string
+	UpdatedAt   string
+}{
+	UserID:      "chat_publish_rates.user_id",
+	Channel:     "chat_publish_rates.channel",
+	PublishedAt: "chat_publish_rates.published_at",
+	UpdatedAt:   "chat_publish_rates.updated_at",
+}
+
+// Generated where
+
+type whereHelpertypes_Int64Array struct{ field string }
+
+func (w whereHelpertypes_Int64Array) EQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_Int64Array) NEQ(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_Int64Array) LT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_Int64Array) LTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_Int64Array) GT(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_Int64Array) GTE(x types.Int64Array) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var ChatPublishRateWhere = struct {
+	UserID      whereHelperstring
+	Channel     whereHelperstring
+	PublishedAt whereHelpertypes_Int64Array
+	UpdatedAt   whereHelpertime_Time
+}{
+	UserID:      whereHelperstring{field: "\"chat_publish_rates\".\"user_id\""},
+	Channel:     whereHelperstring{field: "\"chat_publish_rates\".\"channel\""},
+	PublishedAt: whereHelpertypes_Int64Array{field: "\"chat_publish_rates\".\"published_at\""},
+	UpdatedAt:   whereHelpertime_Time{field: "\"chat_publish_rates\".\"updated_at\""},
+}
+
+// ChatPublishRateRels is where relationship names are stored.
+var ChatPublishRateRels = struct {
+}{}
+
+// chatPublishRateR is where relationships are stored.
+type chatPublishRateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*chatPublishRateR) NewStruct() *chatPublishRateR {
+	return &chatPublishRateR{}
+}
+
+// chatPublishRateL is where Load methods for each relationship are stored.
+type chatPublishRateL struct{}
+
+var (
+	chatPublishRateAllColumns            = []string{"user_id", "channel", "published_at", "updated_at"}
+	chatPublishRateColumnsWithoutDefault = []string{"user_id", "channel", "published_at"}
+	chatPublishRateColumnsWithDefault    = []string{"updated_at"}
+	chatPublishRatePrimaryKeyColumns     = []string{"user_id", "channel"}
+	chatPublishRateGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatPublishRateSlice is an alias for a slice of pointers to ChatPublishRate.
+	// This should almost always be used instead of []ChatPublishRate.
+	ChatPublishRateSlice []*ChatPublishRate
+	// ChatPublishRateHook is the signature for custom ChatPublishRate hook methods
+	ChatPublishRateHook func(context.Context, boil.ContextExecutor, *ChatPublishRate) error
+
+	chatPublishRateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatPublishRateType                 = reflect.TypeOf(&ChatPublishRate{})
+	chatPublishRateMapping              = queries.MakeStructMapping(chatPublishRateType)
+	chatPublishRatePrimaryKeyMapping, _ = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, chatPublishRatePrimaryKeyColumns)
+	chatPublishRateInsertCacheMut       sync.RWMutex
+	chatPublishRateInsertCache          = make(map[string]insertCache)
+	chatPublishRateUpdateCacheMut       sync.RWMutex
+	chatPublishRateUpdateCache          = make(map[string]updateCache)
+	chatPublishRateUpsertCacheMut       sync.RWMutex
+	chatPublishRateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatPublishRateAfterSelectHooks []ChatPublishRateHook
+
+var chatPublishRateBeforeInsertHooks []ChatPublishRateHook
+var chatPublishRateAfterInsertHooks []ChatPublishRateHook
+
+var chatPublishRateBeforeUpdateHooks []ChatPublishRateHook
+var chatPublishRateAfterUpdateHooks []ChatPublishRateHook
+
+var chatPublishRateBeforeDeleteHooks []ChatPublishRateHook
+var chatPublishRateAfterDeleteHooks []ChatPublishRateHook
+
+var chatPublishRateBeforeUpsertHooks []ChatPublishRateHook
+var chatPublishRateAfterUpsertHooks []ChatPublishRateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatPublishRate) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatPublishRate) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatPublishRate) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatPublishRate) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatPublishRate) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatPublishRate) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatPublishRate) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatPublishRate) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatPublishRate) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatPublishRateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatPublishRateHook registers your hook function for all future operations.
+func AddChatPublishRateHook(hookPoint boil.HookPoint, chatPublishRateHook ChatPublishRateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatPublishRateAfterSelectHooks = append(chatPublishRateAfterSelectHooks, chatPublishRateHook)
+	case boil.BeforeInsertHook:
+		chatPublishRateBeforeInsertHooks = append(chatPublishRateBeforeInsertHooks, chatPublishRateHook)
+	case boil.AfterInsertHook:
+		chatPublishRateAfterInsertHooks = append(chatPublishRateAfterInsertHooks, chatPublishRateHook)
+	case boil.BeforeUpdateHook:
+		chatPublishRateBeforeUpdateHooks = append(chatPublishRateBeforeUpdateHooks, chatPublishRateHook)
+	case boil.AfterUpdateHook:
+		chatPublishRateAfterUpdateHooks = append(chatPublishRateAfterUpdateHooks, chatPublishRateHook)
+	case boil.BeforeDeleteHook:
+		chatPublishRateBeforeDeleteHooks = append(chatPublishRateBeforeDeleteHooks, chatPublishRateHook)
+	case boil.AfterDeleteHook:
+		chatPublishRateAfterDeleteHooks = append(chatPublishRateAfterDeleteHooks, chatPublishRateHook)
+	case boil.BeforeUpsertHook:
+		chatPublishRateBeforeUpsertHooks = append(chatPublishRateBeforeUpsertHooks, chatPublishRateHook)
+	case boil.AfterUpsertHook:
+		chatPublishRateAfterUpsertHooks = append(chatPublishRateAfterUpsertHooks, chatPublishRateHook)
+	}
+}
+
+// OneG returns a single chatPublishRate record from the query using the global executor.
+func (q chatPublishRateQuery) OneG(ctx context.Context) (*ChatPublishRate, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatPublishRate record from the query.
+func (q chatPublishRateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatPublishRate, error) {
+	o := &ChatPublishRate{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_publish_rates")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatPublishRate records from the query using the global executor.
+func (q chatPublishRateQuery) AllG(ctx context.Context) (ChatPublishRateSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatPublishRate records from the query.
+func (q chatPublishRateQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatPublishRateSlice, error) {
+	var o []*ChatPublishRate
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatPublishRate slice")
+	}
+
+	if len(chatPublishRateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatPublishRate records in the query using the global executor
+func (q chatPublishRateQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatPublishRate records in the query.
+func (q chatPublishRateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_publish_rates rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatPublishRateQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatPublishRateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_publish_rates exists")
+	}
+
+	return count > 0, nil
+}
+
+// ChatPublishRates retrieves all the records using an executor.
+func ChatPublishRates(mods ...qm.QueryMod) chatPublishRateQuery {
+	mods = append(mods, qm.From("\"chat_publish_rates\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_publish_rates\".*"})
+	}
+
+	return chatPublishRateQuery{q}
+}
+
+// FindChatPublishRateG retrieves a single record by ID.
+func FindChatPublishRateG(ctx context.Context, userID string, channel string, selectCols ...string) (*ChatPublishRate, error) {
+	return FindChatPublishRate(ctx, boil.GetContextDB(), userID, channel, selectCols...)
+}
+
+// FindChatPublishRate retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatPublishRate(ctx context.Context, exec boil.ContextExecutor, userID string, channel string, selectCols ...string) (*ChatPublishRate, error) {
+	chatPublishRateObj := &ChatPublishRate{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_publish_rates\" where \"user_id\"=$1 AND \"channel\"=$2", sel,
+	)
+
+	q := queries.Raw(query, userID, channel)
+
+	err := q.Bind(ctx, exec, chatPublishRateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_publish_rates")
+	}
+
+	if err = chatPublishRateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatPublishRateObj, err
+	}
+
+	return chatPublishRateObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatPublishRate) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatPublishRate) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_publish_rates provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatPublishRateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatPublishRateInsertCacheMut.RLock()
+	cache, cached := chatPublishRateInsertCache[key]
+	chatPublishRateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatPublishRateAllColumns,
+			chatPublishRateColumnsWithDefault,
+			chatPublishRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_publish_rates\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_publish_rates\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_publish_rates")
+	}
+
+	if !cached {
+		chatPublishRateInsertCacheMut.Lock()
+		chatPublishRateInsertCache[key] = cache
+		chatPublishRateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatPublishRate record using the global executor.
+// See Update for more documentation.
+func (o *ChatPublishRate) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatPublishRate.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatPublishRate) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatPublishRateUpdateCacheMut.RLock()
+	cache, cached := chatPublishRateUpdateCache[key]
+	chatPublishRateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatPublishRateAllColumns,
+			chatPublishRatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_publish_rates, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_publish_rates\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatPublishRatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, append(wl, chatPublishRatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_publish_rates row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_publish_rates")
+	}
+
+	if !cached {
+		chatPublishRateUpdateCacheMut.Lock()
+		chatPublishRateUpdateCache[key] = cache
+		chatPublishRateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatPublishRateQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatPublishRateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_publish_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_publish_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatPublishRateSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatPublishRateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPublishRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_publish_rates\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatPublishRatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatPublishRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatPublishRate")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatPublishRate) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatPublishRate) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_publish_rates provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatPublishRateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatPublishRateUpsertCacheMut.RLock()
+	cache, cached := chatPublishRateUpsertCache[key]
+	chatPublishRateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatPublishRateAllColumns,
+			chatPublishRateColumnsWithDefault,
+			chatPublishRateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatPublishRateAllColumns,
+			chatPublishRatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_publish_rates, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatPublishRatePrimaryKeyColumns))
+			copy(conflict, chatPublishRatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_publish_rates\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatPublishRateType, chatPublishRateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_publish_rates")
+	}
+
+	if !cached {
+		chatPublishRateUpsertCacheMut.Lock()
+		chatPublishRateUpsertCache[key] = cache
+		chatPublishRateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatPublishRate record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatPublishRate) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatPublishRate record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatPublishRate) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatPublishRate provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatPublishRatePrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_publish_rates\" WHERE \"user_id\"=$1 AND \"channel\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_publish_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_publish_rates")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatPublishRateQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatPublishRateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatPublishRateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_publish_rates")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_publish_rates")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatPublishRateSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatPublishRateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatPublishRateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPublishRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_publish_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPublishRatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatPublishRate slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_publish_rates")
+	}
+
+	if len(chatPublishRateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatPublishRate) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatPublishRate provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatPublishRate) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatPublishRate(ctx, exec, o.UserID, o.Channel)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatPublishRateSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatPublishRateSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatPublishRateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatPublishRateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPublishRatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_publish_rates\".* FROM \"chat_publish_rates\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPublishRatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatPublishRateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatPublishRateExistsG checks if the ChatPublishRate row exists.
+func ChatPublishRateExistsG(ctx context.Context, userID string, channel string) (bool, error) {
+	return ChatPublishRateExists(ctx, boil.GetContextDB(), userID, channel)
+}
+
+// ChatPublishRateExists checks if the ChatPublishRate row exists.
+func ChatPublishRateExists(ctx context.Context, exec boil.ContextExecutor, userID string, channel string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_publish_rates\" where \"user_id\"=$1 AND \"channel\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, userID, channel)
+	}
+	row := exec.QueryRowContext(ctx, sql, userID, channel)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_publish_rates exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatPublishRate row exists.
+func (o *ChatPublishRate) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatPublishRateExists(ctx, exec, o.UserID, o.Channel)
+}
@@ -0,0 +1,1419 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatReadCursor is an object representing the database table.
+type ChatReadCursor struct {
+	ChatID            string      `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	UserID            string      `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	LastReadAt        time.Time   `boil:"last_read_at" json:"last_read_at" toml:"last_read_at" yaml:"last_read_at"`
+	LastReadMessageID null.String `boil:"last_read_message_id" json:"last_read_message_id,omitempty" toml:"last_read_message_id" yaml:"last_read_message_id,omitempty"`
+	UpdatedAt         time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *chatReadCursorR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatReadCursorL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatReadCursorColumns = struct {
+	ChatID            string
+	UserID            string
+	LastReadAt        string
+	LastReadMessageID string
+	UpdatedAt         string
+}{
+	ChatID:            "chat_id",
+	UserID:            "user_id",
+	LastReadAt:        "last_read_at",
+	LastReadMessageID: "last_read_message_id",
+	UpdatedAt:         "updated_at",
+}
+
+var ChatReadCursorTableColumns = struct {
+	ChatID            string
+	UserID            string
+	LastReadAt        string
+	LastReadMessageID string
+	UpdatedAt         string
+}{
+	ChatID:            "chat_read_cursors.chat_id",
+	UserID:            "chat_read_cursors.user_id",
+	LastReadAt:        "chat_read_cursors.last_read_at",
+	LastReadMessageID: "chat_read_cursors.last_read_message_id",
+	UpdatedAt:         "chat_read_cursors.updated_at",
+}
+
+// Generated where
+
+var ChatReadCursorWhere = struct {
+	ChatID            whereHelperstring
+	UserID            whereHelperstring
+	LastReadAt        whereHelpertime_Time
+	LastReadMessageID whereHelpernull_String
+	UpdatedAt         whereHelpertime_Time
+}{
+	ChatID:            whereHelperstring{field: "\"chat_read_cursors\".\"chat_id\""},
+	UserID:            whereHelperstring{field: "\"chat_read_cursors\".\"user_id\""},
+	LastReadAt:        whereHelpertime_Time{field: "\"chat_read_cursors\".\"last_read_at\""},
+	LastReadMessageID: whereHelpernull_String{field: "\"chat_read_cursors\".\"last_read_message_id\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"chat_read_cursors\".\"updated_at\""},
+}
+
+// ChatReadCursorRels is where relationship names are stored.
+var ChatReadCursorRels = struct {
+	Chat string
+	User string
+}{
+	Chat: "Chat",
+	User: "User",
+}
+
+// chatReadCursorR is where relationships are stored.
+type chatReadCursorR struct {
+	Chat *Chat `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	User *User `boil:"User" json:"User" toml:"User" yaml:"User"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatReadCursorR) NewStruct() *chatReadCursorR {
+	return &chatReadCursorR{}
+}
+
+func (r *chatReadCursorR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatReadCursorR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+// chatReadCursorL is where Load methods for each relationship are stored.
+type chatReadCursorL struct{}
+
+var (
+	chatReadCursorAllColumns            = []string{"chat_id", "user_id", "last_read_at", "last_read_message_id", "updated_at"}
+	chatReadCursorColumnsWithoutDefault = []string{"chat_id", "user_id", "last_read_at"}
+	chatReadCursorColumnsWithDefault    = []string{"last_read_message_id", "updated_at"}
+	chatReadCursorPrimaryKeyColumns     = []string{"chat_id", "user_id"}
+	chatReadCursorGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatReadCursorSlice is an alias for a slice of pointers to ChatReadCursor.
+	// This should almost always be used instead of []ChatReadCursor.
+	ChatReadCursorSlice []*ChatReadCursor
+	// ChatReadCursorHook is the signature for custom ChatReadCursor hook methods
+	ChatReadCursorHook func(context.Context, boil.ContextExecutor, *ChatReadCursor) error
+
+	chatReadCursorQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatReadCursorType                 = reflect.TypeOf(&ChatReadCursor{})
+	chatReadCursorMapping              = queries.MakeStructMapping(chatReadCursorType)
+	chatReadCursorPrimaryKeyMapping, _ = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, chatReadCursorPrimaryKeyColumns)
+	chatReadCursorInsertCacheMut       sync.RWMutex
+	chatReadCursorInsertCache          = make(map[string]insertCache)
+	chatReadCursorUpdateCacheMut       sync.RWMutex
+	chatReadCursorUpdateCache          = make(map[string]updateCache)
+	chatReadCursorUpsertCacheMut       sync.RWMutex
+	chatReadCursorUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatReadCursorAfterSelectHooks []ChatReadCursorHook
+
+var chatReadCursorBeforeInsertHooks []ChatReadCursorHook
+var chatReadCursorAfterInsertHooks []ChatReadCursorHook
+
+var chatReadCursorBeforeUpdateHooks []ChatReadCursorHook
+var chatReadCursorAfterUpdateHooks []ChatReadCursorHook
+
+var chatReadCursorBeforeDeleteHooks []ChatReadCursorHook
+var chatReadCursorAfterDeleteHooks []ChatReadCursorHook
+
+var chatReadCursorBeforeUpsertHooks []ChatReadCursorHook
+var chatReadCursorAfterUpsertHooks []ChatReadCursorHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatReadCursor) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatReadCursor) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatReadCursor) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatReadCursor) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatReadCursor) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatReadCursor) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatReadCursor) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatReadCursor) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatReadCursor) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatReadCursorAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatReadCursorHook registers your hook function for all future operations.
+func AddChatReadCursorHook(hookPoint boil.HookPoint, chatReadCursorHook ChatReadCursorHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatReadCursorAfterSelectHooks = append(chatReadCursorAfterSelectHooks, chatReadCursorHook)
+	case boil.BeforeInsertHook:
+		chatReadCursorBeforeInsertHooks = append(chatReadCursorBeforeInsertHooks, chatReadCursorHook)
+	case boil.AfterInsertHook:
+		chatReadCursorAfterInsertHooks = append(chatReadCursorAfterInsertHooks, chatReadCursorHook)
+	case boil.BeforeUpdateHook:
+		chatReadCursorBeforeUpdateHooks = append(chatReadCursorBeforeUpdateHooks, chatReadCursorHook)
+	case boil.AfterUpdateHook:
+		chatReadCursorAfterUpdateHooks = append(chatReadCursorAfterUpdateHooks, chatReadCursorHook)
+	case boil.BeforeDeleteHook:
+		chatReadCursorBeforeDeleteHooks = append(chatReadCursorBeforeDeleteHooks, chatReadCursorHook)
+	case boil.AfterDeleteHook:
+		chatReadCursorAfterDeleteHooks = append(chatReadCursorAfterDeleteHooks, chatReadCursorHook)
+	case boil.BeforeUpsertHook:
+		chatReadCursorBeforeUpsertHooks = append(chatReadCursorBeforeUpsertHooks, chatReadCursorHook)
+	case boil.AfterUpsertHook:
+		chatReadCursorAfterUpsertHooks = append(chatReadCursorAfterUpsertHooks, chatReadCursorHook)
+	}
+}
+
+// OneG returns a single chatReadCursor record from the query using the global executor.
+func (q chatReadCursorQuery) OneG(ctx context.Context) (*ChatReadCursor, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatReadCursor record from the query.
+func (q chatReadCursorQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatReadCursor, error) {
+	o := &ChatReadCursor{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_read_cursors")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatReadCursor records from the query using the global executor.
+func (q chatReadCursorQuery) AllG(ctx context.Context) (ChatReadCursorSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatReadCursor records from the query.
+func (q chatReadCursorQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatReadCursorSlice, error) {
+	var o []*ChatReadCursor
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatReadCursor slice")
+	}
+
+	if len(chatReadCursorAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatReadCursor records in the query using the global executor
+func (q chatReadCursorQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatReadCursor records in the query.
+func (q chatReadCursorQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_read_cursors rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatReadCursorQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatReadCursorQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_read_cursors exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatReadCursor) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// User pointed to by the foreign key.
+func (o *ChatReadCursor) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatReadCursorL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatReadCursor interface{}, mods queries.Applicator) error {
+	var slice []*ChatReadCursor
+	var object *ChatReadCursor
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatReadCursor.(*ChatReadCursor)
+		if !ok {
+			object = new(ChatReadCursor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatReadCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatReadCursor))
+			}
+		}
+	} else {
+		s, ok := maybeChatReadCursor.(*[]*ChatReadCursor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatReadCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatReadCursor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatReadCursorR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatReadCursorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatReadCursors = append(foreign.R.ChatReadCursors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatReadCursors = append(foreign.R.ChatReadCursors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatReadCursorL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatReadCursor interface{}, mods queries.Applicator) error {
+	var slice []*ChatReadCursor
+	var object *ChatReadCursor
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatReadCursor.(*ChatReadCursor)
+		if !ok {
+			object = new(ChatReadCursor)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatReadCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatReadCursor))
+			}
+		}
+	} else {
+		s, ok := maybeChatReadCursor.(*[]*ChatReadCursor)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatReadCursor)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatReadCursor))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatReadCursorR{}
+		}
+		args = append(args, object.UserID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatReadCursorR{}
+			}
+
+			for _, a := range args {
+				if a == obj.UserID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.UserID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.ChatReadCursors = append(foreign.R.ChatReadCursors, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.UserID == foreign.ID {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.ChatReadCursors = append(foreign.R.ChatReadCursors, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatReadCursor to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatReadCursors.
+// Uses the global database handle.
+func (o *ChatReadCursor) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatReadCursor to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatReadCursors.
+func (o *ChatReadCursor) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatReadCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChatID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatReadCursorR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatReadCursors: ChatReadCursorSlice{o},
+		}
+	} else {
+		related.R.ChatReadCursors = append(related.R.ChatReadCursors, o)
+	}
+
+	return nil
+}
+
+// SetUserG of the chatReadCursor to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatReadCursors.
+// Uses the global database handle.
+func (o *ChatReadCursor) SetUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetUser of the chatReadCursor to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.ChatReadCursors.
+func (o *ChatReadCursor) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatReadCursorPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ChatID, o.UserID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.UserID = related.ID
+	if o.R == nil {
+		o.R = &chatReadCursorR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			ChatReadCursors: ChatReadCursorSlice{o},
+		}
+	} else {
+		related.R.ChatReadCursors = append(related.R.ChatReadCursors, o)
+	}
+
+	return nil
+}
+
+// ChatReadCursors retrieves all the records using an executor.
+func ChatReadCursors(mods ...qm.QueryMod) chatReadCursorQuery {
+	mods = append(mods, qm.From("\"chat_read_cursors\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_read_cursors\".*"})
+	}
+
+	return chatReadCursorQuery{q}
+}
+
+// FindChatReadCursorG retrieves a single record by ID.
+func FindChatReadCursorG(ctx context.Context, chatID string, userID string, selectCols ...string) (*ChatReadCursor, error) {
+	return FindChatReadCursor(ctx, boil.GetContextDB(), chatID, userID, selectCols...)
+}
+
+// FindChatReadCursor retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatReadCursor(ctx context.Context, exec boil.ContextExecutor, chatID string, userID string, selectCols ...string) (*ChatReadCursor, error) {
+	chatReadCursorObj := &ChatReadCursor{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_read_cursors\" where \"chat_id\"=$1 AND \"user_id\"=$2", sel,
+	)
+
+	q := queries.Raw(query, chatID, userID)
+
+	err := q.Bind(ctx, exec, chatReadCursorObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_read_cursors")
+	}
+
+	if err = chatReadCursorObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatReadCursorObj, err
+	}
+
+	return chatReadCursorObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatReadCursor) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatReadCursor) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_read_cursors provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatReadCursorColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatReadCursorInsertCacheMut.RLock()
+	cache, cached := chatReadCursorInsertCache[key]
+	chatReadCursorInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatReadCursorAllColumns,
+			chatReadCursorColumnsWithDefault,
+			chatReadCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_read_cursors\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_read_cursors\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_read_cursors")
+	}
+
+	if !cached {
+		chatReadCursorInsertCacheMut.Lock()
+		chatReadCursorInsertCache[key] = cache
+		chatReadCursorInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatReadCursor record using the global executor.
+// See Update for more documentation.
+func (o *ChatReadCursor) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatReadCursor.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatReadCursor) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatReadCursorUpdateCacheMut.RLock()
+	cache, cached := chatReadCursorUpdateCache[key]
+	chatReadCursorUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatReadCursorAllColumns,
+			chatReadCursorPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_read_cursors, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatReadCursorPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, append(wl, chatReadCursorPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_read_cursors row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_read_cursors")
+	}
+
+	if !cached {
+		chatReadCursorUpdateCacheMut.Lock()
+		chatReadCursorUpdateCache[key] = cache
+		chatReadCursorUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatReadCursorQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatReadCursorQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_read_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_read_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatReadCursorSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatReadCursorSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatReadCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatReadCursorPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatReadCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatReadCursor")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatReadCursor) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatReadCursor) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_read_cursors provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatReadCursorColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatReadCursorUpsertCacheMut.RLock()
+	cache, cached := chatReadCursorUpsertCache[key]
+	chatReadCursorUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatReadCursorAllColumns,
+			chatReadCursorColumnsWithDefault,
+			chatReadCursorColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatReadCursorAllColumns,
+			chatReadCursorPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_read_cursors, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatReadCursorPrimaryKeyColumns))
+			copy(conflict, chatReadCursorPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_read_cursors\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatReadCursorType, chatReadCursorMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_read_cursors")
+	}
+
+	if !cached {
+		chatReadCursorUpsertCacheMut.Lock()
+		chatReadCursorUpsertCache[key] = cache
+		chatReadCursorUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatReadCursor record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatReadCursor) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatReadCursor record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatReadCursor) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatReadCursor provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatReadCursorPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_read_cursors\" WHERE \"chat_id\"=$1 AND \"user_id\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_read_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_read_cursors")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatReadCursorQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatReadCursorQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatReadCursorQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_read_cursors")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_read_cursors")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatReadCursorSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatReadCursorSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatReadCursorBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatReadCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_read_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatReadCursorPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatReadCursor slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_read_cursors")
+	}
+
+	if len(chatReadCursorAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatReadCursor) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatReadCursor provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatReadCursor) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatReadCursor(ctx, exec, o.ChatID, o.UserID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatReadCursorSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatReadCursorSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatReadCursorSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatReadCursorSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatReadCursorPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_read_cursors\".* FROM \"chat_read_cursors\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatReadCursorPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatReadCursorSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatReadCursorExistsG checks if the ChatReadCursor row exists.
+func ChatReadCursorExistsG(ctx context.Context, chatID string, userID string) (bool, error) {
+	return ChatReadCursorExists(ctx, boil.GetContextDB(), chatID, userID)
+}
+
+// ChatReadCursorExists checks if the ChatReadCursor row exists.
+func ChatReadCursorExists(ctx context.Context, exec boil.ContextExecutor, chatID string, userID string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_read_cursors\" where \"chat_id\"=$1 AND \"user_id\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, chatID, userID)
+	}
+	row := exec.QueryRowContext(ctx, sql, chatID, userID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_read_cursors exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatReadCursor row exists.
+func (o *ChatReadCursor) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatReadCursorExists(ctx, exec, o.ChatID, o.UserID)
+}
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,87 +24,65 @@ import (
 
 // ChatUser is an object representing the database table.
 type ChatUser struct {
-	ChatID   string `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
-	UserID   string `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
-	IsRo     bool   `boil:"is_ro" json:"is_ro" toml:"is_ro" yaml:"is_ro"`
-	Disabled bool   `boil:"disabled" json:"disabled" toml:"disabled" yaml:"disabled"`
+	ChatID     string    `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	UserID     string    `boil:"user_id" json:"user_id" toml:"user_id" yaml:"user_id"`
+	IsRo       bool      `boil:"is_ro" json:"is_ro" toml:"is_ro" yaml:"is_ro"`
+	Disabled   bool      `boil:"disabled" json:"disabled" toml:"disabled" yaml:"disabled"`
+	DeletedAt  null.Time `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	MutedUntil null.Time `boil:"muted_until" json:"muted_until,omitempty" toml:"muted_until" yaml:"muted_until,omitempty"`
 
 	R *chatUserR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L chatUserL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ChatUserColumns = struct {
-	ChatID   string
-	UserID   string
-	IsRo     string
-	Disabled string
+	ChatID     string
+	UserID     string
+	IsRo       string
+	Disabled   string
+	DeletedAt  string
+	MutedUntil string
 }{
-	ChatID:   "chat_id",
-	UserID:   "user_id",
-	IsRo:     "is_ro",
-	Disabled: "disabled",
+	ChatID:     "chat_id",
+	UserID:     "user_id",
+	IsRo:       "is_ro",
+	Disabled:   "disabled",
+	DeletedAt:  "deleted_at",
+	MutedUntil: "muted_until",
 }
 
 var ChatUserTableColumns = struct {
-	ChatID   string
-	UserID   string
-	IsRo     string
-	Disabled string
+	ChatID     string
+	UserID     string
+	IsRo       string
+	Disabled   string
+	DeletedAt  string
+	MutedUntil string
 }{
-	ChatID:   "chat_user.chat_id",
-	UserID:   "chat_user.user_id",
-	IsRo:     "chat_user.is_ro",
-	Disabled: "chat_user.disabled",
+	ChatID:     "chat_user.chat_id",
+	UserID:     "chat_user.user_id",
+	IsRo:       "chat_user.is_ro",
+	Disabled:   "chat_user.disabled",
+	DeletedAt:  "chat_user.deleted_at",
+	MutedUntil: "chat_user.muted_until",
 }
 
 // Generated where
 
-type whereHelperstring struct{ field string }
-
-func (w whereHelperstring) EQ(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperstring) NEQ(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperstring) LT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperstring) LTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperstring) GT(x string) qm.QueryMod     { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperstring) GTE(x string) qm.QueryMod    { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-func (w whereHelperstring) LIKE(x string) qm.QueryMod   { return qm.Where(w.field+" LIKE ?", x) }
-func (w whereHelperstring) NLIKE(x string) qm.QueryMod  { return qm.Where(w.field+" NOT LIKE ?", x) }
-func (w whereHelperstring) ILIKE(x string) qm.QueryMod  { return qm.Where(w.field+" ILIKE ?", x) }
-func (w whereHelperstring) NILIKE(x string) qm.QueryMod { return qm.Where(w.field+" NOT ILIKE ?", x) }
-func (w whereHelperstring) IN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
-}
-func (w whereHelperstring) NIN(slice []string) qm.QueryMod {
-	values := make([]interface{}, 0, len(slice))
-	for _, value := range slice {
-		values = append(values, value)
-	}
-	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
-}
-
-type whereHelperbool struct{ field string }
-
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
-
 var ChatUserWhere = struct {
-	ChatID   whereHelperstring
-	UserID   whereHelperstring
-	IsRo     whereHelperbool
-	Disabled whereHelperbool
+	ChatID     whereHelperstring
+	UserID     whereHelperstring
+	IsRo       whereHelperbool
+	Disabled   whereHelperbool
+	DeletedAt  whereHelpernull_Time
+	MutedUntil whereHelpernull_Time
 }{
-	ChatID:   whereHelperstring{field: "\"chat_user\".\"chat_id\""},
-	UserID:   whereHelperstring{field: "\"chat_user\".\"user_id\""},
-	IsRo:     whereHelperbool{field: "\"chat_user\".\"is_ro\""},
-	Disabled: whereHelperbool{field: "\"chat_user\".\"disabled\""},
+	ChatID:     whereHelperstring{field: "\"chat_user\".\"chat_id\""},
+	UserID:     whereHelperstring{field: "\"chat_user\".\"user_id\""},
+	IsRo:       whereHelperbool{field: "\"chat_user\".\"is_ro\""},
+	Disabled:   whereHelperbool{field: "\"chat_user\".\"disabled\""},
+	DeletedAt:  whereHelpernull_Time{field: "\"chat_user\".\"deleted_at\""},
+	MutedUntil: whereHelpernull_Time{field: "\"chat_user\".\"muted_until\""},
 }
 
 // ChatUserRels is where relationship names are stored.
@@ -144,9 +123,9 @@ func (r *chatUserR) GetUser() *User {
 type chatUserL struct{}
 
 var (
-	chatUserAllColumns            = []string{"chat_id", "user_id", "is_ro", "disabled"}
+	chatUserAllColumns            = []string{"chat_id", "user_id", "is_ro", "disabled", "deleted_at", "muted_until"}
 	chatUserColumnsWithoutDefault = []string{"chat_id", "user_id"}
-	chatUserColumnsWithDefault    = []string{"is_ro", "disabled"}
+	chatUserColumnsWithDefault    = []string{"is_ro", "disabled", "deleted_at", "muted_until"}
 	chatUserPrimaryKeyColumns     = []string{"chat_id", "user_id"}
 	chatUserGeneratedColumns      = []string{}
 )
@@ -531,6 +510,7 @@ func (chatUserL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular
 	query := NewQuery(
 		qm.From(`chats`),
 		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -823,7 +803,7 @@ func (o *ChatUser) SetUser(ctx context.Context, exec boil.ContextExecutor, inser
 
 // ChatUsers retrieves all the records using an executor.
 func ChatUsers(mods ...qm.QueryMod) chatUserQuery {
-	mods = append(mods, qm.From("\"chat_user\""))
+	mods = append(mods, qm.From("\"chat_user\""), qmhelper.WhereIsNull("\"chat_user\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"chat_user\".*"})
@@ -847,7 +827,7 @@ func FindChatUser(ctx context.Context, exec boil.ContextExecutor, chatID string,
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"chat_user\" where \"chat_id\"=$1 AND \"user_id\"=$2", sel,
+		"select %s from \"chat_user\" where \"chat_id\"=$1 AND \"user_id\"=$2 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, chatID, userID)
@@ -1218,13 +1198,13 @@ func (o *ChatUser) Upsert(ctx context.Context, exec boil.ContextExecutor, update
 
 // DeleteG deletes a single ChatUser record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *ChatUser) DeleteG(ctx context.Context) (int64, error) {
-	return o.Delete(ctx, boil.GetContextDB())
+func (o *ChatUser) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // Delete deletes a single ChatUser record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *ChatUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *ChatUser) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no ChatUser provided for delete")
 	}
@@ -1233,8 +1213,26 @@ func (o *ChatUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatUserPrimaryKeyMapping)
-	sql := "DELETE FROM \"chat_user\" WHERE \"chat_id\"=$1 AND \"user_id\"=$2"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatUserPrimaryKeyMapping)
+		sql = "DELETE FROM \"chat_user\" WHERE \"chat_id\"=$1 AND \"user_id\"=$2"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"chat_user\" SET %s WHERE \"chat_id\"=$2 AND \"user_id\"=$3",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(chatUserType, chatUserMapping, append(wl, chatUserPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1258,17 +1256,22 @@ func (o *ChatUser) Delete(ctx context.Context, exec boil.ContextExecutor) (int64
 	return rowsAff, nil
 }
 
-func (q chatUserQuery) DeleteAllG(ctx context.Context) (int64, error) {
-	return q.DeleteAll(ctx, boil.GetContextDB())
+func (q chatUserQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all matching rows.
-func (q chatUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q chatUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no chatUserQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1284,12 +1287,12 @@ func (q chatUserQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o ChatUserSlice) DeleteAllG(ctx context.Context) (int64, error) {
-	return o.DeleteAll(ctx, boil.GetContextDB())
+func (o ChatUserSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o ChatUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o ChatUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1302,15 +1305,32 @@ func (o ChatUserSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor)
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatUserPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatUserPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"chat_user\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatUserPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatUserPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"chat_user\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, chatUserPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"chat_user\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatUserPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -1383,7 +1403,8 @@ func (o *ChatUserSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor
 	}
 
 	sql := "SELECT \"chat_user\".* FROM \"chat_user\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatUserPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatUserPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -1405,7 +1426,7 @@ func ChatUserExistsG(ctx context.Context, chatID string, userID string) (bool, e
 // ChatUserExists checks if the ChatUser row exists.
 func ChatUserExists(ctx context.Context, exec boil.ContextExecutor, chatID string, userID string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"chat_user\" where \"chat_id\"=$1 AND \"user_id\"=$2 limit 1)"
+	sql := "select exists(select 1 from \"chat_user\" where \"chat_id\"=$1 AND \"user_id\"=$2 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
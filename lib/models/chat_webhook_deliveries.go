@@ -0,0 +1,1260 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatWebhookDelivery is an object representing the database table.
+type ChatWebhookDelivery struct {
+	ID             string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	WebhookID      string      `boil:"webhook_id" json:"webhook_id" toml:"webhook_id" yaml:"webhook_id"`
+	Event          string      `boil:"event" json:"event" toml:"event" yaml:"event"`
+	Payload        string      `boil:"payload" json:"payload" toml:"payload" yaml:"payload"`
+	Status         string      `boil:"status" json:"status" toml:"status" yaml:"status"`
+	Attempts       int         `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	NextAttemptAt  null.Time   `boil:"next_attempt_at" json:"next_attempt_at,omitempty" toml:"next_attempt_at" yaml:"next_attempt_at,omitempty"`
+	LastStatusCode null.Int    `boil:"last_status_code" json:"last_status_code,omitempty" toml:"last_status_code" yaml:"last_status_code,omitempty"`
+	LastError      null.String `boil:"last_error" json:"last_error,omitempty" toml:"last_error" yaml:"last_error,omitempty"`
+	CreatedAt      time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	DeliveredAt    null.Time   `boil:"delivered_at" json:"delivered_at,omitempty" toml:"delivered_at" yaml:"delivered_at,omitempty"`
+
+	R *chatWebhookDeliveryR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatWebhookDeliveryL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatWebhookDeliveryColumns = struct {
+	ID             string
+	WebhookID      string
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       string
+	NextAttemptAt  string
+	LastStatusCode string
+	LastError      string
+	CreatedAt      string
+	DeliveredAt    string
+}{
+	ID:             "id",
+	WebhookID:      "webhook_id",
+	Event:          "event",
+	Payload:        "payload",
+	Status:         "status",
+	Attempts:       "attempts",
+	NextAttemptAt:  "next_attempt_at",
+	LastStatusCode: "last_status_code",
+	LastError:      "last_error",
+	CreatedAt:      "created_at",
+	DeliveredAt:    "delivered_at",
+}
+
+var ChatWebhookDeliveryTableColumns = struct {
+	ID             string
+	WebhookID      string
+	Event          string
+	Payload        string
+	Status         string
+	Attempts       string
+	NextAttemptAt  string
+	LastStatusCode string
+	LastError      string
+	CreatedAt      string
+	DeliveredAt    string
+}{
+	ID:             "chat_webhook_deliveries.id",
+	WebhookID:      "chat_webhook_deliveries.webhook_id",
+	Event:          "chat_webhook_deliveries.event",
+	Payload:        "chat_webhook_deliveries.payload",
+	Status:         "chat_webhook_deliveries.status",
+	Attempts:       "chat_webhook_deliveries.attempts",
+	NextAttemptAt:  "chat_webhook_deliveries.next_attempt_at",
+	LastStatusCode: "chat_webhook_deliveries.last_status_code",
+	LastError:      "chat_webhook_deliveries.last_error",
+	CreatedAt:      "chat_webhook_deliveries.created_at",
+	DeliveredAt:    "chat_webhook_deliveries.delivered_at",
+}
+
+// Generated where
+
+var ChatWebhookDeliveryWhere = struct {
+	ID             whereHelperstring
+	WebhookID      whereHelperstring
+	Event          whereHelperstring
+	Payload        whereHelperstring
+	Status         whereHelperstring
+	Attempts       whereHelperint
+	NextAttemptAt  whereHelpernull_Time
+	LastStatusCode whereHelpernull_Int
+	LastError      whereHelpernull_String
+	CreatedAt      whereHelpertime_Time
+	DeliveredAt    whereHelpernull_Time
+}{
+	ID:             whereHelperstring{field: "\"chat_webhook_deliveries\".\"id\""},
+	WebhookID:      whereHelperstring{field: "\"chat_webhook_deliveries\".\"webhook_id\""},
+	Event:          whereHelperstring{field: "\"chat_webhook_deliveries\".\"event\""},
+	Payload:        whereHelperstring{field: "\"chat_webhook_deliveries\".\"payload\""},
+	Status:         whereHelperstring{field: "\"chat_webhook_deliveries\".\"status\""},
+	Attempts:       whereHelperint{field: "\"chat_webhook_deliveries\".\"attempts\""},
+	NextAttemptAt:  whereHelpernull_Time{field: "\"chat_webhook_deliveries\".\"next_attempt_at\""},
+	LastStatusCode: whereHelpernull_Int{field: "\"chat_webhook_deliveries\".\"last_status_code\""},
+	LastError:      whereHelpernull_String{field: "\"chat_webhook_deliveries\".\"last_error\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"chat_webhook_deliveries\".\"created_at\""},
+	DeliveredAt:    whereHelpernull_Time{field: "\"chat_webhook_deliveries\".\"delivered_at\""},
+}
+
+// ChatWebhookDeliveryRels is where relationship names are stored.
+var ChatWebhookDeliveryRels = struct {
+	Webhook string
+}{
+	Webhook: "Webhook",
+}
+
+// chatWebhookDeliveryR is where relationships are stored.
+type chatWebhookDeliveryR struct {
+	Webhook *ChatWebhook `boil:"Webhook" json:"Webhook" toml:"Webhook" yaml:"Webhook"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatWebhookDeliveryR) NewStruct() *chatWebhookDeliveryR {
+	return &chatWebhookDeliveryR{}
+}
+
+func (r *chatWebhookDeliveryR) GetWebhook() *ChatWebhook {
+	if r == nil {
+		return nil
+	}
+	return r.Webhook
+}
+
+// chatWebhookDeliveryL is where Load methods for each relationship are stored.
+type chatWebhookDeliveryL struct{}
+
+var (
+	chatWebhookDeliveryAllColumns            = []string{"id", "webhook_id", "event", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "created_at", "delivered_at"}
+	chatWebhookDeliveryColumnsWithoutDefault = []string{"webhook_id", "event", "payload"}
+	chatWebhookDeliveryColumnsWithDefault    = []string{"id", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "created_at", "delivered_at"}
+	chatWebhookDeliveryPrimaryKeyColumns     = []string{"id"}
+	chatWebhookDeliveryGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatWebhookDeliverySlice is an alias for a slice of pointers to ChatWebhookDelivery.
+	// This should almost always be used instead of []ChatWebhookDelivery.
+	ChatWebhookDeliverySlice []*ChatWebhookDelivery
+	// ChatWebhookDeliveryHook is the signature for custom ChatWebhookDelivery hook methods
+	ChatWebhookDeliveryHook func(context.Context, boil.ContextExecutor, *ChatWebhookDelivery) error
+
+	chatWebhookDeliveryQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatWebhookDeliveryType                 = reflect.TypeOf(&ChatWebhookDelivery{})
+	chatWebhookDeliveryMapping              = queries.MakeStructMapping(chatWebhookDeliveryType)
+	chatWebhookDeliveryPrimaryKeyMapping, _ = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, chatWebhookDeliveryPrimaryKeyColumns)
+	chatWebhookDeliveryInsertCacheMut       sync.RWMutex
+	chatWebhookDeliveryInsertCache          = make(map[string]insertCache)
+	chatWebhookDeliveryUpdateCacheMut       sync.RWMutex
+	chatWebhookDeliveryUpdateCache          = make(map[string]updateCache)
+	chatWebhookDeliveryUpsertCacheMut       sync.RWMutex
+	chatWebhookDeliveryUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatWebhookDeliveryAfterSelectHooks []ChatWebhookDeliveryHook
+
+var chatWebhookDeliveryBeforeInsertHooks []ChatWebhookDeliveryHook
+var chatWebhookDeliveryAfterInsertHooks []ChatWebhookDeliveryHook
+
+var chatWebhookDeliveryBeforeUpdateHooks []ChatWebhookDeliveryHook
+var chatWebhookDeliveryAfterUpdateHooks []ChatWebhookDeliveryHook
+
+var chatWebhookDeliveryBeforeDeleteHooks []ChatWebhookDeliveryHook
+var chatWebhookDeliveryAfterDeleteHooks []ChatWebhookDeliveryHook
+
+var chatWebhookDeliveryBeforeUpsertHooks []ChatWebhookDeliveryHook
+var chatWebhookDeliveryAfterUpsertHooks []ChatWebhookDeliveryHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatWebhookDelivery) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatWebhookDelivery) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatWebhookDelivery) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatWebhookDelivery) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatWebhookDelivery) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatWebhookDelivery) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatWebhookDelivery) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatWebhookDelivery) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatWebhookDelivery) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookDeliveryAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatWebhookDeliveryHook registers your hook function for all future operations.
+func AddChatWebhookDeliveryHook(hookPoint boil.HookPoint, chatWebhookDeliveryHook ChatWebhookDeliveryHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatWebhookDeliveryAfterSelectHooks = append(chatWebhookDeliveryAfterSelectHooks, chatWebhookDeliveryHook)
+	case boil.BeforeInsertHook:
+		chatWebhookDeliveryBeforeInsertHooks = append(chatWebhookDeliveryBeforeInsertHooks, chatWebhookDeliveryHook)
+	case boil.AfterInsertHook:
+		chatWebhookDeliveryAfterInsertHooks = append(chatWebhookDeliveryAfterInsertHooks, chatWebhookDeliveryHook)
+	case boil.BeforeUpdateHook:
+		chatWebhookDeliveryBeforeUpdateHooks = append(chatWebhookDeliveryBeforeUpdateHooks, chatWebhookDeliveryHook)
+	case boil.AfterUpdateHook:
+		chatWebhookDeliveryAfterUpdateHooks = append(chatWebhookDeliveryAfterUpdateHooks, chatWebhookDeliveryHook)
+	case boil.BeforeDeleteHook:
+		chatWebhookDeliveryBeforeDeleteHooks = append(chatWebhookDeliveryBeforeDeleteHooks, chatWebhookDeliveryHook)
+	case boil.AfterDeleteHook:
+		chatWebhookDeliveryAfterDeleteHooks = append(chatWebhookDeliveryAfterDeleteHooks, chatWebhookDeliveryHook)
+	case boil.BeforeUpsertHook:
+		chatWebhookDeliveryBeforeUpsertHooks = append(chatWebhookDeliveryBeforeUpsertHooks, chatWebhookDeliveryHook)
+	case boil.AfterUpsertHook:
+		chatWebhookDeliveryAfterUpsertHooks = append(chatWebhookDeliveryAfterUpsertHooks, chatWebhookDeliveryHook)
+	}
+}
+
+// OneG returns a single chatWebhookDelivery record from the query using the global executor.
+func (q chatWebhookDeliveryQuery) OneG(ctx context.Context) (*ChatWebhookDelivery, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatWebhookDelivery record from the query.
+func (q chatWebhookDeliveryQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatWebhookDelivery, error) {
+	o := &ChatWebhookDelivery{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_webhook_deliveries")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatWebhookDelivery records from the query using the global executor.
+func (q chatWebhookDeliveryQuery) AllG(ctx context.Context) (ChatWebhookDeliverySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatWebhookDelivery records from the query.
+func (q chatWebhookDeliveryQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatWebhookDeliverySlice, error) {
+	var o []*ChatWebhookDelivery
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatWebhookDelivery slice")
+	}
+
+	if len(chatWebhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatWebhookDelivery records in the query using the global executor
+func (q chatWebhookDeliveryQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatWebhookDelivery records in the query.
+func (q chatWebhookDeliveryQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_webhook_deliveries rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatWebhookDeliveryQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatWebhookDeliveryQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_webhook_deliveries exists")
+	}
+
+	return count > 0, nil
+}
+
+// Webhook pointed to by the foreign key.
+func (o *ChatWebhookDelivery) Webhook(mods ...qm.QueryMod) chatWebhookQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.WebhookID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ChatWebhooks(queryMods...)
+}
+
+// LoadWebhook allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatWebhookDeliveryL) LoadWebhook(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatWebhookDelivery interface{}, mods queries.Applicator) error {
+	var slice []*ChatWebhookDelivery
+	var object *ChatWebhookDelivery
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatWebhookDelivery.(*ChatWebhookDelivery)
+		if !ok {
+			object = new(ChatWebhookDelivery)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatWebhookDelivery))
+			}
+		}
+	} else {
+		s, ok := maybeChatWebhookDelivery.(*[]*ChatWebhookDelivery)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatWebhookDelivery)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatWebhookDelivery))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatWebhookDeliveryR{}
+		}
+		args = append(args, object.WebhookID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatWebhookDeliveryR{}
+			}
+
+			for _, a := range args {
+				if a == obj.WebhookID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.WebhookID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_webhooks`),
+		qm.WhereIn(`chat_webhooks.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load ChatWebhook")
+	}
+
+	var resultSlice []*ChatWebhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice ChatWebhook")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chat_webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_webhooks")
+	}
+
+	if len(chatWebhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Webhook = foreign
+		if foreign.R == nil {
+			foreign.R = &chatWebhookR{}
+		}
+		foreign.R.WebhookChatWebhookDeliveries = append(foreign.R.WebhookChatWebhookDeliveries, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.WebhookID == foreign.ID {
+				local.R.Webhook = foreign
+				if foreign.R == nil {
+					foreign.R = &chatWebhookR{}
+				}
+				foreign.R.WebhookChatWebhookDeliveries = append(foreign.R.WebhookChatWebhookDeliveries, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetWebhookG of the chatWebhookDelivery to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.WebhookChatWebhookDeliveries.
+// Uses the global database handle.
+func (o *ChatWebhookDelivery) SetWebhookG(ctx context.Context, insert bool, related *ChatWebhook) error {
+	return o.SetWebhook(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetWebhook of the chatWebhookDelivery to the related item.
+// Sets o.R.Webhook to related.
+// Adds o to related.R.WebhookChatWebhookDeliveries.
+func (o *ChatWebhookDelivery) SetWebhook(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChatWebhook) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"webhook_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatWebhookDeliveryPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.WebhookID = related.ID
+	if o.R == nil {
+		o.R = &chatWebhookDeliveryR{
+			Webhook: related,
+		}
+	} else {
+		o.R.Webhook = related
+	}
+
+	if related.R == nil {
+		related.R = &chatWebhookR{
+			WebhookChatWebhookDeliveries: ChatWebhookDeliverySlice{o},
+		}
+	} else {
+		related.R.WebhookChatWebhookDeliveries = append(related.R.WebhookChatWebhookDeliveries, o)
+	}
+
+	return nil
+}
+
+// ChatWebhookDeliveries retrieves all the records using an executor.
+func ChatWebhookDeliveries(mods ...qm.QueryMod) chatWebhookDeliveryQuery {
+	mods = append(mods, qm.From("\"chat_webhook_deliveries\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_webhook_deliveries\".*"})
+	}
+
+	return chatWebhookDeliveryQuery{q}
+}
+
+// FindChatWebhookDeliveryG retrieves a single record by ID.
+func FindChatWebhookDeliveryG(ctx context.Context, iD string, selectCols ...string) (*ChatWebhookDelivery, error) {
+	return FindChatWebhookDelivery(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatWebhookDelivery retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatWebhookDelivery(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatWebhookDelivery, error) {
+	chatWebhookDeliveryObj := &ChatWebhookDelivery{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_webhook_deliveries\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatWebhookDeliveryObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_webhook_deliveries")
+	}
+
+	if err = chatWebhookDeliveryObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatWebhookDeliveryObj, err
+	}
+
+	return chatWebhookDeliveryObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatWebhookDelivery) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatWebhookDelivery) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_webhook_deliveries provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatWebhookDeliveryColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatWebhookDeliveryInsertCacheMut.RLock()
+	cache, cached := chatWebhookDeliveryInsertCache[key]
+	chatWebhookDeliveryInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatWebhookDeliveryAllColumns,
+			chatWebhookDeliveryColumnsWithDefault,
+			chatWebhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_webhook_deliveries\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_webhook_deliveries\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_webhook_deliveries")
+	}
+
+	if !cached {
+		chatWebhookDeliveryInsertCacheMut.Lock()
+		chatWebhookDeliveryInsertCache[key] = cache
+		chatWebhookDeliveryInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatWebhookDelivery record using the global executor.
+// See Update for more documentation.
+func (o *ChatWebhookDelivery) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatWebhookDelivery.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatWebhookDelivery) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatWebhookDeliveryUpdateCacheMut.RLock()
+	cache, cached := chatWebhookDeliveryUpdateCache[key]
+	chatWebhookDeliveryUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatWebhookDeliveryAllColumns,
+			chatWebhookDeliveryPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_webhook_deliveries, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_webhook_deliveries\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatWebhookDeliveryPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, append(wl, chatWebhookDeliveryPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_webhook_deliveries row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_webhook_deliveries")
+	}
+
+	if !cached {
+		chatWebhookDeliveryUpdateCacheMut.Lock()
+		chatWebhookDeliveryUpdateCache[key] = cache
+		chatWebhookDeliveryUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatWebhookDeliveryQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatWebhookDeliveryQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatWebhookDeliverySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatWebhookDeliverySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_webhook_deliveries\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatWebhookDeliveryPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatWebhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatWebhookDelivery")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatWebhookDelivery) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatWebhookDelivery) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_webhook_deliveries provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatWebhookDeliveryColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatWebhookDeliveryUpsertCacheMut.RLock()
+	cache, cached := chatWebhookDeliveryUpsertCache[key]
+	chatWebhookDeliveryUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatWebhookDeliveryAllColumns,
+			chatWebhookDeliveryColumnsWithDefault,
+			chatWebhookDeliveryColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatWebhookDeliveryAllColumns,
+			chatWebhookDeliveryPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_webhook_deliveries, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatWebhookDeliveryPrimaryKeyColumns))
+			copy(conflict, chatWebhookDeliveryPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_webhook_deliveries\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatWebhookDeliveryType, chatWebhookDeliveryMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_webhook_deliveries")
+	}
+
+	if !cached {
+		chatWebhookDeliveryUpsertCacheMut.Lock()
+		chatWebhookDeliveryUpsertCache[key] = cache
+		chatWebhookDeliveryUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatWebhookDelivery record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatWebhookDelivery) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatWebhookDelivery record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatWebhookDelivery) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatWebhookDelivery provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatWebhookDeliveryPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_webhook_deliveries\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_webhook_deliveries")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatWebhookDeliveryQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatWebhookDeliveryQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatWebhookDeliveryQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_webhook_deliveries")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_webhook_deliveries")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatWebhookDeliverySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatWebhookDeliverySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatWebhookDeliveryBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatWebhookDeliveryPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatWebhookDelivery slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_webhook_deliveries")
+	}
+
+	if len(chatWebhookDeliveryAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatWebhookDelivery) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatWebhookDelivery provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatWebhookDelivery) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatWebhookDelivery(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatWebhookDeliverySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatWebhookDeliverySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatWebhookDeliverySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatWebhookDeliverySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookDeliveryPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_webhook_deliveries\".* FROM \"chat_webhook_deliveries\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatWebhookDeliveryPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatWebhookDeliverySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatWebhookDeliveryExistsG checks if the ChatWebhookDelivery row exists.
+func ChatWebhookDeliveryExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatWebhookDeliveryExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatWebhookDeliveryExists checks if the ChatWebhookDelivery row exists.
+func ChatWebhookDeliveryExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_webhook_deliveries\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_webhook_deliveries exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatWebhookDelivery row exists.
+func (o *ChatWebhookDelivery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatWebhookDeliveryExists(ctx, exec, o.ID)
+}
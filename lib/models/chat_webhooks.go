@@ -0,0 +1,1628 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// ChatWebhook is an object representing the database table.
+type ChatWebhook struct {
+	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ChatID    string    `boil:"chat_id" json:"chat_id" toml:"chat_id" yaml:"chat_id"`
+	URL       string    `boil:"url" json:"url" toml:"url" yaml:"url"`
+	Secret    string    `boil:"secret" json:"secret" toml:"secret" yaml:"secret"`
+	Events    string    `boil:"events" json:"events" toml:"events" yaml:"events"`
+	CreatedBy string    `boil:"created_by" json:"created_by" toml:"created_by" yaml:"created_by"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *chatWebhookR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L chatWebhookL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var ChatWebhookColumns = struct {
+	ID        string
+	ChatID    string
+	URL       string
+	Secret    string
+	Events    string
+	CreatedBy string
+	CreatedAt string
+}{
+	ID:        "id",
+	ChatID:    "chat_id",
+	URL:       "url",
+	Secret:    "secret",
+	Events:    "events",
+	CreatedBy: "created_by",
+	CreatedAt: "created_at",
+}
+
+var ChatWebhookTableColumns = struct {
+	ID        string
+	ChatID    string
+	URL       string
+	Secret    string
+	Events    string
+	CreatedBy string
+	CreatedAt string
+}{
+	ID:        "chat_webhooks.id",
+	ChatID:    "chat_webhooks.chat_id",
+	URL:       "chat_webhooks.url",
+	Secret:    "chat_webhooks.secret",
+	Events:    "chat_webhooks.events",
+	CreatedBy: "chat_webhooks.created_by",
+	CreatedAt: "chat_webhooks.created_at",
+}
+
+// Generated where
+
+var ChatWebhookWhere = struct {
+	ID        whereHelperstring
+	ChatID    whereHelperstring
+	URL       whereHelperstring
+	Secret    whereHelperstring
+	Events    whereHelperstring
+	CreatedBy whereHelperstring
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperstring{field: "\"chat_webhooks\".\"id\""},
+	ChatID:    whereHelperstring{field: "\"chat_webhooks\".\"chat_id\""},
+	URL:       whereHelperstring{field: "\"chat_webhooks\".\"url\""},
+	Secret:    whereHelperstring{field: "\"chat_webhooks\".\"secret\""},
+	Events:    whereHelperstring{field: "\"chat_webhooks\".\"events\""},
+	CreatedBy: whereHelperstring{field: "\"chat_webhooks\".\"created_by\""},
+	CreatedAt: whereHelpertime_Time{field: "\"chat_webhooks\".\"created_at\""},
+}
+
+// ChatWebhookRels is where relationship names are stored.
+var ChatWebhookRels = struct {
+	Chat                         string
+	CreatedByUser                string
+	WebhookChatWebhookDeliveries string
+}{
+	Chat:                         "Chat",
+	CreatedByUser:                "CreatedByUser",
+	WebhookChatWebhookDeliveries: "WebhookChatWebhookDeliveries",
+}
+
+// chatWebhookR is where relationships are stored.
+type chatWebhookR struct {
+	Chat                         *Chat                    `boil:"Chat" json:"Chat" toml:"Chat" yaml:"Chat"`
+	CreatedByUser                *User                    `boil:"CreatedByUser" json:"CreatedByUser" toml:"CreatedByUser" yaml:"CreatedByUser"`
+	WebhookChatWebhookDeliveries ChatWebhookDeliverySlice `boil:"WebhookChatWebhookDeliveries" json:"WebhookChatWebhookDeliveries" toml:"WebhookChatWebhookDeliveries" yaml:"WebhookChatWebhookDeliveries"`
+}
+
+// NewStruct creates a new relationship struct
+func (*chatWebhookR) NewStruct() *chatWebhookR {
+	return &chatWebhookR{}
+}
+
+func (r *chatWebhookR) GetChat() *Chat {
+	if r == nil {
+		return nil
+	}
+	return r.Chat
+}
+
+func (r *chatWebhookR) GetCreatedByUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByUser
+}
+
+func (r *chatWebhookR) GetWebhookChatWebhookDeliveries() ChatWebhookDeliverySlice {
+	if r == nil {
+		return nil
+	}
+	return r.WebhookChatWebhookDeliveries
+}
+
+// chatWebhookL is where Load methods for each relationship are stored.
+type chatWebhookL struct{}
+
+var (
+	chatWebhookAllColumns            = []string{"id", "chat_id", "url", "secret", "events", "created_by", "created_at"}
+	chatWebhookColumnsWithoutDefault = []string{"chat_id", "url", "secret", "events", "created_by"}
+	chatWebhookColumnsWithDefault    = []string{"id", "created_at"}
+	chatWebhookPrimaryKeyColumns     = []string{"id"}
+	chatWebhookGeneratedColumns      = []string{}
+)
+
+type (
+	// ChatWebhookSlice is an alias for a slice of pointers to ChatWebhook.
+	// This should almost always be used instead of []ChatWebhook.
+	ChatWebhookSlice []*ChatWebhook
+	// ChatWebhookHook is the signature for custom ChatWebhook hook methods
+	ChatWebhookHook func(context.Context, boil.ContextExecutor, *ChatWebhook) error
+
+	chatWebhookQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	chatWebhookType                 = reflect.TypeOf(&ChatWebhook{})
+	chatWebhookMapping              = queries.MakeStructMapping(chatWebhookType)
+	chatWebhookPrimaryKeyMapping, _ = queries.BindMapping(chatWebhookType, chatWebhookMapping, chatWebhookPrimaryKeyColumns)
+	chatWebhookInsertCacheMut       sync.RWMutex
+	chatWebhookInsertCache          = make(map[string]insertCache)
+	chatWebhookUpdateCacheMut       sync.RWMutex
+	chatWebhookUpdateCache          = make(map[string]updateCache)
+	chatWebhookUpsertCacheMut       sync.RWMutex
+	chatWebhookUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var chatWebhookAfterSelectHooks []ChatWebhookHook
+
+var chatWebhookBeforeInsertHooks []ChatWebhookHook
+var chatWebhookAfterInsertHooks []ChatWebhookHook
+
+var chatWebhookBeforeUpdateHooks []ChatWebhookHook
+var chatWebhookAfterUpdateHooks []ChatWebhookHook
+
+var chatWebhookBeforeDeleteHooks []ChatWebhookHook
+var chatWebhookAfterDeleteHooks []ChatWebhookHook
+
+var chatWebhookBeforeUpsertHooks []ChatWebhookHook
+var chatWebhookAfterUpsertHooks []ChatWebhookHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *ChatWebhook) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *ChatWebhook) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *ChatWebhook) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *ChatWebhook) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *ChatWebhook) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *ChatWebhook) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *ChatWebhook) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *ChatWebhook) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *ChatWebhook) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range chatWebhookAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddChatWebhookHook registers your hook function for all future operations.
+func AddChatWebhookHook(hookPoint boil.HookPoint, chatWebhookHook ChatWebhookHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		chatWebhookAfterSelectHooks = append(chatWebhookAfterSelectHooks, chatWebhookHook)
+	case boil.BeforeInsertHook:
+		chatWebhookBeforeInsertHooks = append(chatWebhookBeforeInsertHooks, chatWebhookHook)
+	case boil.AfterInsertHook:
+		chatWebhookAfterInsertHooks = append(chatWebhookAfterInsertHooks, chatWebhookHook)
+	case boil.BeforeUpdateHook:
+		chatWebhookBeforeUpdateHooks = append(chatWebhookBeforeUpdateHooks, chatWebhookHook)
+	case boil.AfterUpdateHook:
+		chatWebhookAfterUpdateHooks = append(chatWebhookAfterUpdateHooks, chatWebhookHook)
+	case boil.BeforeDeleteHook:
+		chatWebhookBeforeDeleteHooks = append(chatWebhookBeforeDeleteHooks, chatWebhookHook)
+	case boil.AfterDeleteHook:
+		chatWebhookAfterDeleteHooks = append(chatWebhookAfterDeleteHooks, chatWebhookHook)
+	case boil.BeforeUpsertHook:
+		chatWebhookBeforeUpsertHooks = append(chatWebhookBeforeUpsertHooks, chatWebhookHook)
+	case boil.AfterUpsertHook:
+		chatWebhookAfterUpsertHooks = append(chatWebhookAfterUpsertHooks, chatWebhookHook)
+	}
+}
+
+// OneG returns a single chatWebhook record from the query using the global executor.
+func (q chatWebhookQuery) OneG(ctx context.Context) (*ChatWebhook, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single chatWebhook record from the query.
+func (q chatWebhookQuery) One(ctx context.Context, exec boil.ContextExecutor) (*ChatWebhook, error) {
+	o := &ChatWebhook{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for chat_webhooks")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all ChatWebhook records from the query using the global executor.
+func (q chatWebhookQuery) AllG(ctx context.Context) (ChatWebhookSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all ChatWebhook records from the query.
+func (q chatWebhookQuery) All(ctx context.Context, exec boil.ContextExecutor) (ChatWebhookSlice, error) {
+	var o []*ChatWebhook
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to ChatWebhook slice")
+	}
+
+	if len(chatWebhookAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all ChatWebhook records in the query using the global executor
+func (q chatWebhookQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all ChatWebhook records in the query.
+func (q chatWebhookQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count chat_webhooks rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q chatWebhookQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q chatWebhookQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if chat_webhooks exists")
+	}
+
+	return count > 0, nil
+}
+
+// Chat pointed to by the foreign key.
+func (o *ChatWebhook) Chat(mods ...qm.QueryMod) chatQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.ChatID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Chats(queryMods...)
+}
+
+// CreatedByUser pointed to by the foreign key.
+func (o *ChatWebhook) CreatedByUser(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.CreatedBy),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// WebhookChatWebhookDeliveries retrieves all the chat_webhook_delivery's ChatWebhookDeliveries with an executor via webhook_id column.
+func (o *ChatWebhook) WebhookChatWebhookDeliveries(mods ...qm.QueryMod) chatWebhookDeliveryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_webhook_deliveries\".\"webhook_id\"=?", o.ID),
+	)
+
+	return ChatWebhookDeliveries(queryMods...)
+}
+
+// LoadChat allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatWebhookL) LoadChat(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatWebhook interface{}, mods queries.Applicator) error {
+	var slice []*ChatWebhook
+	var object *ChatWebhook
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatWebhook.(*ChatWebhook)
+		if !ok {
+			object = new(ChatWebhook)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatWebhook))
+			}
+		}
+	} else {
+		s, ok := maybeChatWebhook.(*[]*ChatWebhook)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatWebhook))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatWebhookR{}
+		}
+		args = append(args, object.ChatID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatWebhookR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ChatID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ChatID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Chat")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Chat")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Chat = foreign
+		if foreign.R == nil {
+			foreign.R = &chatR{}
+		}
+		foreign.R.ChatWebhooks = append(foreign.R.ChatWebhooks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ChatID == foreign.ID {
+				local.R.Chat = foreign
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.ChatWebhooks = append(foreign.R.ChatWebhooks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (chatWebhookL) LoadCreatedByUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatWebhook interface{}, mods queries.Applicator) error {
+	var slice []*ChatWebhook
+	var object *ChatWebhook
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatWebhook.(*ChatWebhook)
+		if !ok {
+			object = new(ChatWebhook)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatWebhook))
+			}
+		}
+	} else {
+		s, ok := maybeChatWebhook.(*[]*ChatWebhook)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatWebhook))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatWebhookR{}
+		}
+		args = append(args, object.CreatedBy)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatWebhookR{}
+			}
+
+			for _, a := range args {
+				if a == obj.CreatedBy {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.CreatedBy)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.CreatedByUser = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.CreatedByChatWebhooks = append(foreign.R.CreatedByChatWebhooks, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.CreatedBy == foreign.ID {
+				local.R.CreatedByUser = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.CreatedByChatWebhooks = append(foreign.R.CreatedByChatWebhooks, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadWebhookChatWebhookDeliveries allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatWebhookL) LoadWebhookChatWebhookDeliveries(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChatWebhook interface{}, mods queries.Applicator) error {
+	var slice []*ChatWebhook
+	var object *ChatWebhook
+
+	if singular {
+		var ok bool
+		object, ok = maybeChatWebhook.(*ChatWebhook)
+		if !ok {
+			object = new(ChatWebhook)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChatWebhook))
+			}
+		}
+	} else {
+		s, ok := maybeChatWebhook.(*[]*ChatWebhook)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChatWebhook)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChatWebhook))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatWebhookR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatWebhookR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_webhook_deliveries`),
+		qm.WhereIn(`chat_webhook_deliveries.webhook_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_webhook_deliveries")
+	}
+
+	var resultSlice []*ChatWebhookDelivery
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_webhook_deliveries")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_webhook_deliveries")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_webhook_deliveries")
+	}
+
+	if len(chatWebhookDeliveryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.WebhookChatWebhookDeliveries = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatWebhookDeliveryR{}
+			}
+			foreign.R.Webhook = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.WebhookID {
+				local.R.WebhookChatWebhookDeliveries = append(local.R.WebhookChatWebhookDeliveries, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatWebhookDeliveryR{}
+				}
+				foreign.R.Webhook = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetChatG of the chatWebhook to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatWebhooks.
+// Uses the global database handle.
+func (o *ChatWebhook) SetChatG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetChat(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChat of the chatWebhook to the related item.
+// Sets o.R.Chat to related.
+// Adds o to related.R.ChatWebhooks.
+func (o *ChatWebhook) SetChat(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_webhooks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatWebhookPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.ChatID = related.ID
+	if o.R == nil {
+		o.R = &chatWebhookR{
+			Chat: related,
+		}
+	} else {
+		o.R.Chat = related
+	}
+
+	if related.R == nil {
+		related.R = &chatR{
+			ChatWebhooks: ChatWebhookSlice{o},
+		}
+	} else {
+		related.R.ChatWebhooks = append(related.R.ChatWebhooks, o)
+	}
+
+	return nil
+}
+
+// SetCreatedByUserG of the chatWebhook to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByChatWebhooks.
+// Uses the global database handle.
+func (o *ChatWebhook) SetCreatedByUserG(ctx context.Context, insert bool, related *User) error {
+	return o.SetCreatedByUser(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetCreatedByUser of the chatWebhook to the related item.
+// Sets o.R.CreatedByUser to related.
+// Adds o to related.R.CreatedByChatWebhooks.
+func (o *ChatWebhook) SetCreatedByUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chat_webhooks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+		strmangle.WhereClause("\"", "\"", 2, chatWebhookPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.CreatedBy = related.ID
+	if o.R == nil {
+		o.R = &chatWebhookR{
+			CreatedByUser: related,
+		}
+	} else {
+		o.R.CreatedByUser = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			CreatedByChatWebhooks: ChatWebhookSlice{o},
+		}
+	} else {
+		related.R.CreatedByChatWebhooks = append(related.R.CreatedByChatWebhooks, o)
+	}
+
+	return nil
+}
+
+// AddWebhookChatWebhookDeliveriesG adds the given related objects to the existing relationships
+// of the chat_webhook, optionally inserting them as new records.
+// Appends related to o.R.WebhookChatWebhookDeliveries.
+// Sets related.R.Webhook appropriately.
+// Uses the global database handle.
+func (o *ChatWebhook) AddWebhookChatWebhookDeliveriesG(ctx context.Context, insert bool, related ...*ChatWebhookDelivery) error {
+	return o.AddWebhookChatWebhookDeliveries(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddWebhookChatWebhookDeliveries adds the given related objects to the existing relationships
+// of the chat_webhook, optionally inserting them as new records.
+// Appends related to o.R.WebhookChatWebhookDeliveries.
+// Sets related.R.Webhook appropriately.
+func (o *ChatWebhook) AddWebhookChatWebhookDeliveries(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatWebhookDelivery) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.WebhookID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_webhook_deliveries\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"webhook_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatWebhookDeliveryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.WebhookID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatWebhookR{
+			WebhookChatWebhookDeliveries: related,
+		}
+	} else {
+		o.R.WebhookChatWebhookDeliveries = append(o.R.WebhookChatWebhookDeliveries, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatWebhookDeliveryR{
+				Webhook: o,
+			}
+		} else {
+			rel.R.Webhook = o
+		}
+	}
+	return nil
+}
+
+// ChatWebhooks retrieves all the records using an executor.
+func ChatWebhooks(mods ...qm.QueryMod) chatWebhookQuery {
+	mods = append(mods, qm.From("\"chat_webhooks\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"chat_webhooks\".*"})
+	}
+
+	return chatWebhookQuery{q}
+}
+
+// FindChatWebhookG retrieves a single record by ID.
+func FindChatWebhookG(ctx context.Context, iD string, selectCols ...string) (*ChatWebhook, error) {
+	return FindChatWebhook(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindChatWebhook retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindChatWebhook(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*ChatWebhook, error) {
+	chatWebhookObj := &ChatWebhook{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"chat_webhooks\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, chatWebhookObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from chat_webhooks")
+	}
+
+	if err = chatWebhookObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return chatWebhookObj, err
+	}
+
+	return chatWebhookObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *ChatWebhook) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *ChatWebhook) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_webhooks provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatWebhookColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	chatWebhookInsertCacheMut.RLock()
+	cache, cached := chatWebhookInsertCache[key]
+	chatWebhookInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			chatWebhookAllColumns,
+			chatWebhookColumnsWithDefault,
+			chatWebhookColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(chatWebhookType, chatWebhookMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(chatWebhookType, chatWebhookMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"chat_webhooks\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"chat_webhooks\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into chat_webhooks")
+	}
+
+	if !cached {
+		chatWebhookInsertCacheMut.Lock()
+		chatWebhookInsertCache[key] = cache
+		chatWebhookInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single ChatWebhook record using the global executor.
+// See Update for more documentation.
+func (o *ChatWebhook) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the ChatWebhook.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *ChatWebhook) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	chatWebhookUpdateCacheMut.RLock()
+	cache, cached := chatWebhookUpdateCache[key]
+	chatWebhookUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			chatWebhookAllColumns,
+			chatWebhookPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update chat_webhooks, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"chat_webhooks\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, chatWebhookPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(chatWebhookType, chatWebhookMapping, append(wl, chatWebhookPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update chat_webhooks row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for chat_webhooks")
+	}
+
+	if !cached {
+		chatWebhookUpdateCacheMut.Lock()
+		chatWebhookUpdateCache[key] = cache
+		chatWebhookUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q chatWebhookQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q chatWebhookQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for chat_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for chat_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o ChatWebhookSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o ChatWebhookSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"chat_webhooks\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, chatWebhookPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in chatWebhook slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all chatWebhook")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *ChatWebhook) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *ChatWebhook) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no chat_webhooks provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(chatWebhookColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	chatWebhookUpsertCacheMut.RLock()
+	cache, cached := chatWebhookUpsertCache[key]
+	chatWebhookUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			chatWebhookAllColumns,
+			chatWebhookColumnsWithDefault,
+			chatWebhookColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			chatWebhookAllColumns,
+			chatWebhookPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert chat_webhooks, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(chatWebhookPrimaryKeyColumns))
+			copy(conflict, chatWebhookPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"chat_webhooks\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(chatWebhookType, chatWebhookMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(chatWebhookType, chatWebhookMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert chat_webhooks")
+	}
+
+	if !cached {
+		chatWebhookUpsertCacheMut.Lock()
+		chatWebhookUpsertCache[key] = cache
+		chatWebhookUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single ChatWebhook record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *ChatWebhook) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single ChatWebhook record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *ChatWebhook) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no ChatWebhook provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatWebhookPrimaryKeyMapping)
+	sql := "DELETE FROM \"chat_webhooks\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from chat_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for chat_webhooks")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q chatWebhookQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q chatWebhookQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no chatWebhookQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chat_webhooks")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_webhooks")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o ChatWebhookSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o ChatWebhookSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(chatWebhookBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"chat_webhooks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatWebhookPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from chatWebhook slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for chat_webhooks")
+	}
+
+	if len(chatWebhookAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *ChatWebhook) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no ChatWebhook provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *ChatWebhook) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindChatWebhook(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatWebhookSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty ChatWebhookSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *ChatWebhookSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := ChatWebhookSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatWebhookPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"chat_webhooks\".* FROM \"chat_webhooks\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatWebhookPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in ChatWebhookSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// ChatWebhookExistsG checks if the ChatWebhook row exists.
+func ChatWebhookExistsG(ctx context.Context, iD string) (bool, error) {
+	return ChatWebhookExists(ctx, boil.GetContextDB(), iD)
+}
+
+// ChatWebhookExists checks if the ChatWebhook row exists.
+func ChatWebhookExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"chat_webhooks\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if chat_webhooks exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the ChatWebhook row exists.
+func (o *ChatWebhook) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return ChatWebhookExists(ctx, exec, o.ID)
+}
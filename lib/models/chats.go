@@ -24,96 +24,163 @@ import (
 
 // Chat is an object representing the database table.
 type Chat struct {
-	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Resource  string      `boil:"resource" json:"resource" toml:"resource" yaml:"resource"`
-	Summary   null.String `boil:"summary" json:"summary,omitempty" toml:"summary" yaml:"summary,omitempty"`
-	Title     string      `boil:"title" json:"title" toml:"title" yaml:"title"`
-	ParentID  null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
-	IsPrivate null.Bool   `boil:"is_private" json:"is_private,omitempty" toml:"is_private" yaml:"is_private,omitempty"`
-	OwnerID   null.String `boil:"owner_id" json:"owner_id,omitempty" toml:"owner_id" yaml:"owner_id,omitempty"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Resource        string      `boil:"resource" json:"resource" toml:"resource" yaml:"resource"`
+	Summary         null.String `boil:"summary" json:"summary,omitempty" toml:"summary" yaml:"summary,omitempty"`
+	Title           string      `boil:"title" json:"title" toml:"title" yaml:"title"`
+	ParentID        null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	IsPrivate       null.Bool   `boil:"is_private" json:"is_private,omitempty" toml:"is_private" yaml:"is_private,omitempty"`
+	OwnerID         null.String `boil:"owner_id" json:"owner_id,omitempty" toml:"owner_id" yaml:"owner_id,omitempty"`
+	DeletedAt       null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	GameID          null.Int64  `boil:"game_id" json:"game_id,omitempty" toml:"game_id" yaml:"game_id,omitempty"`
+	ArchivedAt      null.Time   `boil:"archived_at" json:"archived_at,omitempty" toml:"archived_at" yaml:"archived_at,omitempty"`
+	Name            null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	LegacyResource  null.String `boil:"legacy_resource" json:"legacy_resource,omitempty" toml:"legacy_resource" yaml:"legacy_resource,omitempty"`
+	DMKey           null.String `boil:"dm_key" json:"dm_key,omitempty" toml:"dm_key" yaml:"dm_key,omitempty"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	LastMessageAt   null.Time   `boil:"last_message_at" json:"last_message_at,omitempty" toml:"last_message_at" yaml:"last_message_at,omitempty"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Topic           null.String `boil:"topic" json:"topic,omitempty" toml:"topic" yaml:"topic,omitempty"`
+	AvatarUpdatedAt null.Time   `boil:"avatar_updated_at" json:"avatar_updated_at,omitempty" toml:"avatar_updated_at" yaml:"avatar_updated_at,omitempty"`
 
 	R *chatR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L chatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var ChatColumns = struct {
-	ID        string
-	Resource  string
-	Summary   string
-	Title     string
-	ParentID  string
-	IsPrivate string
-	OwnerID   string
+	ID              string
+	Resource        string
+	Summary         string
+	Title           string
+	ParentID        string
+	IsPrivate       string
+	OwnerID         string
+	DeletedAt       string
+	GameID          string
+	ArchivedAt      string
+	Name            string
+	LegacyResource  string
+	DMKey           string
+	CreatedAt       string
+	LastMessageAt   string
+	UpdatedAt       string
+	Topic           string
+	AvatarUpdatedAt string
 }{
-	ID:        "id",
-	Resource:  "resource",
-	Summary:   "summary",
-	Title:     "title",
-	ParentID:  "parent_id",
-	IsPrivate: "is_private",
-	OwnerID:   "owner_id",
+	ID:              "id",
+	Resource:        "resource",
+	Summary:         "summary",
+	Title:           "title",
+	ParentID:        "parent_id",
+	IsPrivate:       "is_private",
+	OwnerID:         "owner_id",
+	DeletedAt:       "deleted_at",
+	GameID:          "game_id",
+	ArchivedAt:      "archived_at",
+	Name:            "name",
+	LegacyResource:  "legacy_resource",
+	DMKey:           "dm_key",
+	CreatedAt:       "created_at",
+	LastMessageAt:   "last_message_at",
+	UpdatedAt:       "updated_at",
+	Topic:           "topic",
+	AvatarUpdatedAt: "avatar_updated_at",
 }
 
 var ChatTableColumns = struct {
-	ID        string
-	Resource  string
-	Summary   string
-	Title     string
-	ParentID  string
-	IsPrivate string
-	OwnerID   string
+	ID              string
+	Resource        string
+	Summary         string
+	Title           string
+	ParentID        string
+	IsPrivate       string
+	OwnerID         string
+	DeletedAt       string
+	GameID          string
+	ArchivedAt      string
+	Name            string
+	LegacyResource  string
+	DMKey           string
+	CreatedAt       string
+	LastMessageAt   string
+	UpdatedAt       string
+	Topic           string
+	AvatarUpdatedAt string
 }{
-	ID:        "chats.id",
-	Resource:  "chats.resource",
-	Summary:   "chats.summary",
-	Title:     "chats.title",
-	ParentID:  "chats.parent_id",
-	IsPrivate: "chats.is_private",
-	OwnerID:   "chats.owner_id",
+	ID:              "chats.id",
+	Resource:        "chats.resource",
+	Summary:         "chats.summary",
+	Title:           "chats.title",
+	ParentID:        "chats.parent_id",
+	IsPrivate:       "chats.is_private",
+	OwnerID:         "chats.owner_id",
+	DeletedAt:       "chats.deleted_at",
+	GameID:          "chats.game_id",
+	ArchivedAt:      "chats.archived_at",
+	Name:            "chats.name",
+	LegacyResource:  "chats.legacy_resource",
+	DMKey:           "chats.dm_key",
+	CreatedAt:       "chats.created_at",
+	LastMessageAt:   "chats.last_message_at",
+	UpdatedAt:       "chats.updated_at",
+	Topic:           "chats.topic",
+	AvatarUpdatedAt: "chats.avatar_updated_at",
 }
 
 // Generated where
 
-type whereHelpernull_String struct{ field string }
+type whereHelpernull_Bool struct{ field string }
 
-func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, false, x)
 }
-func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
 	return qmhelper.WhereNullEQ(w.field, true, x)
 }
-func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
 	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) LIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" LIKE ?", x)
+
+func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
 }
-func (w whereHelpernull_String) NLIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT LIKE ?", x)
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
 }
-func (w whereHelpernull_String) ILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" ILIKE ?", x)
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
 }
-func (w whereHelpernull_String) NILIKE(x null.String) qm.QueryMod {
-	return qm.Where(w.field+" NOT ILIKE ?", x)
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
 }
-func (w whereHelpernull_String) IN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int64) IN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
 	}
 	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
 }
-func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
+func (w whereHelpernull_Int64) NIN(slice []int64) qm.QueryMod {
 	values := make([]interface{}, 0, len(slice))
 	for _, value := range slice {
 		values = append(values, value)
@@ -121,70 +188,92 @@ func (w whereHelpernull_String) NIN(slice []string) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
-func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
-
-type whereHelpernull_Bool struct{ field string }
-
-func (w whereHelpernull_Bool) EQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, false, x)
-}
-func (w whereHelpernull_Bool) NEQ(x null.Bool) qm.QueryMod {
-	return qmhelper.WhereNullEQ(w.field, true, x)
-}
-func (w whereHelpernull_Bool) LT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpernull_Bool) LTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpernull_Bool) GT(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpernull_Bool) GTE(x null.Bool) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
-func (w whereHelpernull_Bool) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
-func (w whereHelpernull_Bool) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var ChatWhere = struct {
-	ID        whereHelperstring
-	Resource  whereHelperstring
-	Summary   whereHelpernull_String
-	Title     whereHelperstring
-	ParentID  whereHelpernull_String
-	IsPrivate whereHelpernull_Bool
-	OwnerID   whereHelpernull_String
+	ID              whereHelperstring
+	Resource        whereHelperstring
+	Summary         whereHelpernull_String
+	Title           whereHelperstring
+	ParentID        whereHelpernull_String
+	IsPrivate       whereHelpernull_Bool
+	OwnerID         whereHelpernull_String
+	DeletedAt       whereHelpernull_Time
+	GameID          whereHelpernull_Int64
+	ArchivedAt      whereHelpernull_Time
+	Name            whereHelpernull_String
+	LegacyResource  whereHelpernull_String
+	DMKey           whereHelpernull_String
+	CreatedAt       whereHelpertime_Time
+	LastMessageAt   whereHelpernull_Time
+	UpdatedAt       whereHelpertime_Time
+	Topic           whereHelpernull_String
+	AvatarUpdatedAt whereHelpernull_Time
 }{
-	ID:        whereHelperstring{field: "\"chats\".\"id\""},
-	Resource:  whereHelperstring{field: "\"chats\".\"resource\""},
-	Summary:   whereHelpernull_String{field: "\"chats\".\"summary\""},
-	Title:     whereHelperstring{field: "\"chats\".\"title\""},
-	ParentID:  whereHelpernull_String{field: "\"chats\".\"parent_id\""},
-	IsPrivate: whereHelpernull_Bool{field: "\"chats\".\"is_private\""},
-	OwnerID:   whereHelpernull_String{field: "\"chats\".\"owner_id\""},
+	ID:              whereHelperstring{field: "\"chats\".\"id\""},
+	Resource:        whereHelperstring{field: "\"chats\".\"resource\""},
+	Summary:         whereHelpernull_String{field: "\"chats\".\"summary\""},
+	Title:           whereHelperstring{field: "\"chats\".\"title\""},
+	ParentID:        whereHelpernull_String{field: "\"chats\".\"parent_id\""},
+	IsPrivate:       whereHelpernull_Bool{field: "\"chats\".\"is_private\""},
+	OwnerID:         whereHelpernull_String{field: "\"chats\".\"owner_id\""},
+	DeletedAt:       whereHelpernull_Time{field: "\"chats\".\"deleted_at\""},
+	GameID:          whereHelpernull_Int64{field: "\"chats\".\"game_id\""},
+	ArchivedAt:      whereHelpernull_Time{field: "\"chats\".\"archived_at\""},
+	Name:            whereHelpernull_String{field: "\"chats\".\"name\""},
+	LegacyResource:  whereHelpernull_String{field: "\"chats\".\"legacy_resource\""},
+	DMKey:           whereHelpernull_String{field: "\"chats\".\"dm_key\""},
+	CreatedAt:       whereHelpertime_Time{field: "\"chats\".\"created_at\""},
+	LastMessageAt:   whereHelpernull_Time{field: "\"chats\".\"last_message_at\""},
+	UpdatedAt:       whereHelpertime_Time{field: "\"chats\".\"updated_at\""},
+	Topic:           whereHelpernull_String{field: "\"chats\".\"topic\""},
+	AvatarUpdatedAt: whereHelpernull_Time{field: "\"chats\".\"avatar_updated_at\""},
 }
 
 // ChatRels is where relationship names are stored.
 var ChatRels = struct {
-	Owner       string
-	Parent      string
-	ChatUsers   string
-	ParentChats string
+	Owner                 string
+	Parent                string
+	ChatAvatar            string
+	ChatBots              string
+	ChatInvitations       string
+	ChatInviteLinks       string
+	ChatModerationReports string
+	ChatPins              string
+	ChatReadCursors       string
+	ChatUsers             string
+	ChatWebhooks          string
+	ParentChats           string
 }{
-	Owner:       "Owner",
-	Parent:      "Parent",
-	ChatUsers:   "ChatUsers",
-	ParentChats: "ParentChats",
+	Owner:                 "Owner",
+	Parent:                "Parent",
+	ChatAvatar:            "ChatAvatar",
+	ChatBots:              "ChatBots",
+	ChatInvitations:       "ChatInvitations",
+	ChatInviteLinks:       "ChatInviteLinks",
+	ChatModerationReports: "ChatModerationReports",
+	ChatPins:              "ChatPins",
+	ChatReadCursors:       "ChatReadCursors",
+	ChatUsers:             "ChatUsers",
+	ChatWebhooks:          "ChatWebhooks",
+	ParentChats:           "ParentChats",
 }
 
 // chatR is where relationships are stored.
 type chatR struct {
-	Owner       *User         `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
-	Parent      *Chat         `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
-	ChatUsers   ChatUserSlice `boil:"ChatUsers" json:"ChatUsers" toml:"ChatUsers" yaml:"ChatUsers"`
-	ParentChats ChatSlice     `boil:"ParentChats" json:"ParentChats" toml:"ParentChats" yaml:"ParentChats"`
+	Owner                 *User                     `boil:"Owner" json:"Owner" toml:"Owner" yaml:"Owner"`
+	Parent                *Chat                     `boil:"Parent" json:"Parent" toml:"Parent" yaml:"Parent"`
+	ChatAvatar            *ChatAvatar               `boil:"ChatAvatar" json:"ChatAvatar" toml:"ChatAvatar" yaml:"ChatAvatar"`
+	ChatBots              ChatBotSlice              `boil:"ChatBots" json:"ChatBots" toml:"ChatBots" yaml:"ChatBots"`
+	ChatInvitations       ChatInvitationSlice       `boil:"ChatInvitations" json:"ChatInvitations" toml:"ChatInvitations" yaml:"ChatInvitations"`
+	ChatInviteLinks       ChatInviteLinkSlice       `boil:"ChatInviteLinks" json:"ChatInviteLinks" toml:"ChatInviteLinks" yaml:"ChatInviteLinks"`
+	ChatModerationReports ChatModerationReportSlice `boil:"ChatModerationReports" json:"ChatModerationReports" toml:"ChatModerationReports" yaml:"ChatModerationReports"`
+	ChatPins              ChatPinSlice              `boil:"ChatPins" json:"ChatPins" toml:"ChatPins" yaml:"ChatPins"`
+	ChatReadCursors       ChatReadCursorSlice       `boil:"ChatReadCursors" json:"ChatReadCursors" toml:"ChatReadCursors" yaml:"ChatReadCursors"`
+	ChatUsers             ChatUserSlice             `boil:"ChatUsers" json:"ChatUsers" toml:"ChatUsers" yaml:"ChatUsers"`
+	ChatWebhooks          ChatWebhookSlice          `boil:"ChatWebhooks" json:"ChatWebhooks" toml:"ChatWebhooks" yaml:"ChatWebhooks"`
+	ParentChats           ChatSlice                 `boil:"ParentChats" json:"ParentChats" toml:"ParentChats" yaml:"ParentChats"`
 }
 
 // NewStruct creates a new relationship struct
@@ -206,6 +295,55 @@ func (r *chatR) GetParent() *Chat {
 	return r.Parent
 }
 
+func (r *chatR) GetChatAvatar() *ChatAvatar {
+	if r == nil {
+		return nil
+	}
+	return r.ChatAvatar
+}
+
+func (r *chatR) GetChatBots() ChatBotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatBots
+}
+
+func (r *chatR) GetChatInvitations() ChatInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatInvitations
+}
+
+func (r *chatR) GetChatInviteLinks() ChatInviteLinkSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatInviteLinks
+}
+
+func (r *chatR) GetChatModerationReports() ChatModerationReportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatModerationReports
+}
+
+func (r *chatR) GetChatPins() ChatPinSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatPins
+}
+
+func (r *chatR) GetChatReadCursors() ChatReadCursorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatReadCursors
+}
+
 func (r *chatR) GetChatUsers() ChatUserSlice {
 	if r == nil {
 		return nil
@@ -213,6 +351,13 @@ func (r *chatR) GetChatUsers() ChatUserSlice {
 	return r.ChatUsers
 }
 
+func (r *chatR) GetChatWebhooks() ChatWebhookSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatWebhooks
+}
+
 func (r *chatR) GetParentChats() ChatSlice {
 	if r == nil {
 		return nil
@@ -224,9 +369,9 @@ func (r *chatR) GetParentChats() ChatSlice {
 type chatL struct{}
 
 var (
-	chatAllColumns            = []string{"id", "resource", "summary", "title", "parent_id", "is_private", "owner_id"}
+	chatAllColumns            = []string{"id", "resource", "summary", "title", "parent_id", "is_private", "owner_id", "deleted_at", "game_id", "archived_at", "name", "legacy_resource", "dm_key", "created_at", "last_message_at", "updated_at", "topic", "avatar_updated_at"}
 	chatColumnsWithoutDefault = []string{"resource", "title"}
-	chatColumnsWithDefault    = []string{"id", "summary", "parent_id", "is_private", "owner_id"}
+	chatColumnsWithDefault    = []string{"id", "summary", "parent_id", "is_private", "owner_id", "deleted_at", "game_id", "archived_at", "name", "legacy_resource", "dm_key", "created_at", "last_message_at", "updated_at", "topic", "avatar_updated_at"}
 	chatPrimaryKeyColumns     = []string{"id"}
 	chatGeneratedColumns      = []string{}
 )
@@ -551,6 +696,101 @@ func (o *Chat) Parent(mods ...qm.QueryMod) chatQuery {
 	return Chats(queryMods...)
 }
 
+// ChatAvatar pointed to by the foreign key.
+func (o *Chat) ChatAvatar(mods ...qm.QueryMod) chatAvatarQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"chat_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return ChatAvatars(queryMods...)
+}
+
+// ChatBots retrieves all the chat_bot's ChatBots with an executor.
+func (o *Chat) ChatBots(mods ...qm.QueryMod) chatBotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_bots\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatBots(queryMods...)
+}
+
+// ChatInvitations retrieves all the chat_invitation's ChatInvitations with an executor.
+func (o *Chat) ChatInvitations(mods ...qm.QueryMod) chatInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_invitations\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatInvitations(queryMods...)
+}
+
+// ChatInviteLinks retrieves all the chat_invite_link's ChatInviteLinks with an executor.
+func (o *Chat) ChatInviteLinks(mods ...qm.QueryMod) chatInviteLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_invite_links\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatInviteLinks(queryMods...)
+}
+
+// ChatModerationReports retrieves all the chat_moderation_report's ChatModerationReports with an executor.
+func (o *Chat) ChatModerationReports(mods ...qm.QueryMod) chatModerationReportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_moderation_reports\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatModerationReports(queryMods...)
+}
+
+// ChatPins retrieves all the chat_pin's ChatPins with an executor.
+func (o *Chat) ChatPins(mods ...qm.QueryMod) chatPinQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_pins\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatPins(queryMods...)
+}
+
+// ChatReadCursors retrieves all the chat_read_cursor's ChatReadCursors with an executor.
+func (o *Chat) ChatReadCursors(mods ...qm.QueryMod) chatReadCursorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_read_cursors\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatReadCursors(queryMods...)
+}
+
 // ChatUsers retrieves all the chat_user's ChatUsers with an executor.
 func (o *Chat) ChatUsers(mods ...qm.QueryMod) chatUserQuery {
 	var queryMods []qm.QueryMod
@@ -565,6 +805,20 @@ func (o *Chat) ChatUsers(mods ...qm.QueryMod) chatUserQuery {
 	return ChatUsers(queryMods...)
 }
 
+// ChatWebhooks retrieves all the chat_webhook's ChatWebhooks with an executor.
+func (o *Chat) ChatWebhooks(mods ...qm.QueryMod) chatWebhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_webhooks\".\"chat_id\"=?", o.ID),
+	)
+
+	return ChatWebhooks(queryMods...)
+}
+
 // ParentChats retrieves all the chat's Chats with an executor via parent_id column.
 func (o *Chat) ParentChats(mods ...qm.QueryMod) chatQuery {
 	var queryMods []qm.QueryMod
@@ -767,6 +1021,7 @@ func (chatL) LoadParent(ctx context.Context, e boil.ContextExecutor, singular bo
 	query := NewQuery(
 		qm.From(`chats`),
 		qm.WhereIn(`chats.id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -827,9 +1082,9 @@ func (chatL) LoadParent(ctx context.Context, e boil.ContextExecutor, singular bo
 	return nil
 }
 
-// LoadChatUsers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (chatL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+// LoadChatAvatar allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (chatL) LoadChatAvatar(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
 	var slice []*Chat
 	var object *Chat
 
@@ -883,8 +1138,8 @@ func (chatL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`chat_user`),
-		qm.WhereIn(`chat_user.chat_id in ?`, args...),
+		qm.From(`chat_avatars`),
+		qm.WhereIn(`chat_avatars.chat_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -892,45 +1147,48 @@ func (chatL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load chat_user")
+		return errors.Wrap(err, "failed to eager load ChatAvatar")
 	}
 
-	var resultSlice []*ChatUser
+	var resultSlice []*ChatAvatar
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice chat_user")
+		return errors.Wrap(err, "failed to bind eager loaded slice ChatAvatar")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on chat_user")
+		return errors.Wrap(err, "failed to close results of eager load for chat_avatars")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_user")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_avatars")
 	}
 
-	if len(chatUserAfterSelectHooks) != 0 {
+	if len(chatAvatarAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ChatUsers = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &chatUserR{}
-			}
-			foreign.R.Chat = object
+		foreign := resultSlice[0]
+		object.R.ChatAvatar = foreign
+		if foreign.R == nil {
+			foreign.R = &chatAvatarR{}
 		}
-		return nil
+		foreign.R.Chat = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.ChatID {
-				local.R.ChatUsers = append(local.R.ChatUsers, foreign)
+				local.R.ChatAvatar = foreign
 				if foreign.R == nil {
-					foreign.R = &chatUserR{}
+					foreign.R = &chatAvatarR{}
 				}
 				foreign.R.Chat = local
 				break
@@ -941,9 +1199,9 @@ func (chatL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadParentChats allows an eager lookup of values, cached into the
+// LoadChatBots allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+func (chatL) LoadChatBots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
 	var slice []*Chat
 	var object *Chat
 
@@ -983,7 +1241,7 @@ func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singul
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -997,8 +1255,8 @@ func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singul
 	}
 
 	query := NewQuery(
-		qm.From(`chats`),
-		qm.WhereIn(`chats.parent_id in ?`, args...),
+		qm.From(`chat_bots`),
+		qm.WhereIn(`chat_bots.chat_id in ?`, args...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -1006,22 +1264,22 @@ func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singul
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load chats")
+		return errors.Wrap(err, "failed to eager load chat_bots")
 	}
 
-	var resultSlice []*Chat
+	var resultSlice []*ChatBot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice chats")
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_bots")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on chats")
+		return errors.Wrap(err, "failed to close results in eager load on chat_bots")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_bots")
 	}
 
-	if len(chatAfterSelectHooks) != 0 {
+	if len(chatBotAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -1029,24 +1287,24 @@ func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singul
 		}
 	}
 	if singular {
-		object.R.ParentChats = resultSlice
+		object.R.ChatBots = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &chatR{}
+				foreign.R = &chatBotR{}
 			}
-			foreign.R.Parent = object
+			foreign.R.Chat = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.ParentID) {
-				local.R.ParentChats = append(local.R.ParentChats, foreign)
+			if local.ID == foreign.ChatID {
+				local.R.ChatBots = append(local.R.ChatBots, foreign)
 				if foreign.R == nil {
-					foreign.R = &chatR{}
+					foreign.R = &chatBotR{}
 				}
-				foreign.R.Parent = local
+				foreign.R.Chat = local
 				break
 			}
 		}
@@ -1055,30 +1313,1040 @@ func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
-// SetOwnerG of the chat to the related item.
-// Sets o.R.Owner to related.
-// Adds o to related.R.OwnerChats.
-// Uses the global database handle.
-func (o *Chat) SetOwnerG(ctx context.Context, insert bool, related *User) error {
-	return o.SetOwner(ctx, boil.GetContextDB(), insert, related)
-}
+// LoadChatInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
 
-// SetOwner of the chat to the related item.
-// Sets o.R.Owner to related.
-// Adds o to related.R.OwnerChats.
-func (o *Chat) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
-	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
 		}
 	}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"chats\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
-		strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
-	)
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_invitations`),
+		qm.WhereIn(`chat_invitations.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_invitations")
+	}
+
+	var resultSlice []*ChatInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_invitations")
+	}
+
+	if len(chatInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatInvitationR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatInvitations = append(local.R.ChatInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatInvitationR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatInviteLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatInviteLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_invite_links`),
+		qm.WhereIn(`chat_invite_links.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_invite_links")
+	}
+
+	var resultSlice []*ChatInviteLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_invite_links")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_invite_links")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_invite_links")
+	}
+
+	if len(chatInviteLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatInviteLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatInviteLinkR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatInviteLinks = append(local.R.ChatInviteLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatInviteLinkR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatModerationReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatModerationReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_moderation_reports`),
+		qm.WhereIn(`chat_moderation_reports.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_moderation_reports")
+	}
+
+	var resultSlice []*ChatModerationReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_moderation_reports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_moderation_reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_moderation_reports")
+	}
+
+	if len(chatModerationReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatModerationReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatModerationReportR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatModerationReports = append(local.R.ChatModerationReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatModerationReportR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatPins allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatPins(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_pins`),
+		qm.WhereIn(`chat_pins.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_pins")
+	}
+
+	var resultSlice []*ChatPin
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_pins")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_pins")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_pins")
+	}
+
+	if len(chatPinAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatPins = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatPinR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatPins = append(local.R.ChatPins, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatPinR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatReadCursors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatReadCursors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_read_cursors`),
+		qm.WhereIn(`chat_read_cursors.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_read_cursors")
+	}
+
+	var resultSlice []*ChatReadCursor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_read_cursors")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_read_cursors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_read_cursors")
+	}
+
+	if len(chatReadCursorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatReadCursors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatReadCursorR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatReadCursors = append(local.R.ChatReadCursors, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatReadCursorR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_user`),
+		qm.WhereIn(`chat_user.chat_id in ?`, args...),
+		qmhelper.WhereIsNull(`chat_user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_user")
+	}
+
+	var resultSlice []*ChatUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_user")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_user")
+	}
+
+	if len(chatUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatUserR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatUsers = append(local.R.ChatUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatUserR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadChatWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_webhooks`),
+		qm.WhereIn(`chat_webhooks.chat_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_webhooks")
+	}
+
+	var resultSlice []*ChatWebhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_webhooks")
+	}
+
+	if len(chatWebhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatWebhookR{}
+			}
+			foreign.R.Chat = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ChatID {
+				local.R.ChatWebhooks = append(local.R.ChatWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatWebhookR{}
+				}
+				foreign.R.Chat = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadParentChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (chatL) LoadParentChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeChat interface{}, mods queries.Applicator) error {
+	var slice []*Chat
+	var object *Chat
+
+	if singular {
+		var ok bool
+		object, ok = maybeChat.(*Chat)
+		if !ok {
+			object = new(Chat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeChat))
+			}
+		}
+	} else {
+		s, ok := maybeChat.(*[]*Chat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeChat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeChat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &chatR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &chatR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.parent_id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chats")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ParentChats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatR{}
+			}
+			foreign.R.Parent = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ParentID) {
+				local.R.ParentChats = append(local.R.ParentChats, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.Parent = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetOwnerG of the chat to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerChats.
+// Uses the global database handle.
+func (o *Chat) SetOwnerG(ctx context.Context, insert bool, related *User) error {
+	return o.SetOwner(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetOwner of the chat to the related item.
+// Sets o.R.Owner to related.
+// Adds o to related.R.OwnerChats.
+func (o *Chat) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.OwnerID, related.ID)
+	if o.R == nil {
+		o.R = &chatR{
+			Owner: related,
+		}
+	} else {
+		o.R.Owner = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			OwnerChats: ChatSlice{o},
+		}
+	} else {
+		related.R.OwnerChats = append(related.R.OwnerChats, o)
+	}
+
+	return nil
+}
+
+// RemoveOwnerG relationship.
+// Sets o.R.Owner to nil.
+// Removes o from all passed in related items' relationships struct.
+// Uses the global database handle.
+func (o *Chat) RemoveOwnerG(ctx context.Context, related *User) error {
+	return o.RemoveOwner(ctx, boil.GetContextDB(), related)
+}
+
+// RemoveOwner relationship.
+// Sets o.R.Owner to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *Chat) RemoveOwner(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.OwnerID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("owner_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Owner = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.OwnerChats {
+		if queries.Equal(o.OwnerID, ri.OwnerID) {
+			continue
+		}
+
+		ln := len(related.R.OwnerChats)
+		if ln > 1 && i < ln-1 {
+			related.R.OwnerChats[i] = related.R.OwnerChats[ln-1]
+		}
+		related.R.OwnerChats = related.R.OwnerChats[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetParentG of the chat to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentChats.
+// Uses the global database handle.
+func (o *Chat) SetParentG(ctx context.Context, insert bool, related *Chat) error {
+	return o.SetParent(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetParent of the chat to the related item.
+// Sets o.R.Parent to related.
+// Adds o to related.R.ParentChats.
+func (o *Chat) SetParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"chats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
+		strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
+	)
 	values := []interface{}{related.ID, o.ID}
 
 	if boil.IsDebug(ctx) {
@@ -1090,159 +2358,493 @@ func (o *Chat) SetOwner(ctx context.Context, exec boil.ContextExecutor, insert b
 		return errors.Wrap(err, "failed to update local table")
 	}
 
-	queries.Assign(&o.OwnerID, related.ID)
+	queries.Assign(&o.ParentID, related.ID)
 	if o.R == nil {
 		o.R = &chatR{
-			Owner: related,
+			Parent: related,
 		}
 	} else {
-		o.R.Owner = related
+		o.R.Parent = related
 	}
 
 	if related.R == nil {
-		related.R = &userR{
-			OwnerChats: ChatSlice{o},
+		related.R = &chatR{
+			ParentChats: ChatSlice{o},
 		}
 	} else {
-		related.R.OwnerChats = append(related.R.OwnerChats, o)
+		related.R.ParentChats = append(related.R.ParentChats, o)
 	}
 
 	return nil
 }
 
-// RemoveOwnerG relationship.
-// Sets o.R.Owner to nil.
+// RemoveParentG relationship.
+// Sets o.R.Parent to nil.
 // Removes o from all passed in related items' relationships struct.
 // Uses the global database handle.
-func (o *Chat) RemoveOwnerG(ctx context.Context, related *User) error {
-	return o.RemoveOwner(ctx, boil.GetContextDB(), related)
+func (o *Chat) RemoveParentG(ctx context.Context, related *Chat) error {
+	return o.RemoveParent(ctx, boil.GetContextDB(), related)
 }
 
-// RemoveOwner relationship.
-// Sets o.R.Owner to nil.
+// RemoveParent relationship.
+// Sets o.R.Parent to nil.
 // Removes o from all passed in related items' relationships struct.
-func (o *Chat) RemoveOwner(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+func (o *Chat) RemoveParent(ctx context.Context, exec boil.ContextExecutor, related *Chat) error {
+	var err error
+
+	queries.SetScanner(&o.ParentID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.Parent = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.ParentChats {
+		if queries.Equal(o.ParentID, ri.ParentID) {
+			continue
+		}
+
+		ln := len(related.R.ParentChats)
+		if ln > 1 && i < ln-1 {
+			related.R.ParentChats[i] = related.R.ParentChats[ln-1]
+		}
+		related.R.ParentChats = related.R.ParentChats[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetChatAvatarG of the chat to the related item.
+// Sets o.R.ChatAvatar to related.
+// Adds o to related.R.Chat.
+// Uses the global database handle.
+func (o *Chat) SetChatAvatarG(ctx context.Context, insert bool, related *ChatAvatar) error {
+	return o.SetChatAvatar(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetChatAvatar of the chat to the related item.
+// Sets o.R.ChatAvatar to related.
+// Adds o to related.R.Chat.
+func (o *Chat) SetChatAvatar(ctx context.Context, exec boil.ContextExecutor, insert bool, related *ChatAvatar) error {
+	var err error
+
+	if insert {
+		related.ChatID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"chat_avatars\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+			strmangle.WhereClause("\"", "\"", 2, chatAvatarPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ChatID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ChatID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			ChatAvatar: related,
+		}
+	} else {
+		o.R.ChatAvatar = related
+	}
+
+	if related.R == nil {
+		related.R = &chatAvatarR{
+			Chat: o,
+		}
+	} else {
+		related.R.Chat = o
+	}
+	return nil
+}
+
+// AddChatBotsG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatBots.
+// Sets related.R.Chat appropriately.
+// Uses the global database handle.
+func (o *Chat) AddChatBotsG(ctx context.Context, insert bool, related ...*ChatBot) error {
+	return o.AddChatBots(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatBots adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatBots.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatBots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatBot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_bots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			ChatBots: related,
+		}
+	} else {
+		o.R.ChatBots = append(o.R.ChatBots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatBotR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
+// AddChatInvitationsG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatInvitations.
+// Sets related.R.Chat appropriately.
+// Uses the global database handle.
+func (o *Chat) AddChatInvitationsG(ctx context.Context, insert bool, related ...*ChatInvitation) error {
+	return o.AddChatInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatInvitations adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatInvitations.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			ChatInvitations: related,
+		}
+	} else {
+		o.R.ChatInvitations = append(o.R.ChatInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatInvitationR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
+// AddChatInviteLinksG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatInviteLinks.
+// Sets related.R.Chat appropriately.
+// Uses the global database handle.
+func (o *Chat) AddChatInviteLinksG(ctx context.Context, insert bool, related ...*ChatInviteLink) error {
+	return o.AddChatInviteLinks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatInviteLinks adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatInviteLinks.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatInviteLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInviteLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_invite_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatInviteLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			ChatInviteLinks: related,
+		}
+	} else {
+		o.R.ChatInviteLinks = append(o.R.ChatInviteLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatInviteLinkR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
+// AddChatModerationReportsG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatModerationReports.
+// Sets related.R.Chat appropriately.
+// Uses the global database handle.
+func (o *Chat) AddChatModerationReportsG(ctx context.Context, insert bool, related ...*ChatModerationReport) error {
+	return o.AddChatModerationReports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatModerationReports adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatModerationReports.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatModerationReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatModerationReport) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	queries.SetScanner(&o.OwnerID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("owner_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.Owner = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.ChatID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.OwnerChats {
-		if queries.Equal(o.OwnerID, ri.OwnerID) {
-			continue
+	if o.R == nil {
+		o.R = &chatR{
+			ChatModerationReports: related,
 		}
+	} else {
+		o.R.ChatModerationReports = append(o.R.ChatModerationReports, related...)
+	}
 
-		ln := len(related.R.OwnerChats)
-		if ln > 1 && i < ln-1 {
-			related.R.OwnerChats[i] = related.R.OwnerChats[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatModerationReportR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
 		}
-		related.R.OwnerChats = related.R.OwnerChats[:ln-1]
-		break
 	}
 	return nil
 }
 
-// SetParentG of the chat to the related item.
-// Sets o.R.Parent to related.
-// Adds o to related.R.ParentChats.
+// AddChatPinsG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatPins.
+// Sets related.R.Chat appropriately.
 // Uses the global database handle.
-func (o *Chat) SetParentG(ctx context.Context, insert bool, related *Chat) error {
-	return o.SetParent(ctx, boil.GetContextDB(), insert, related)
+func (o *Chat) AddChatPinsG(ctx context.Context, insert bool, related ...*ChatPin) error {
+	return o.AddChatPins(ctx, boil.GetContextDB(), insert, related...)
 }
 
-// SetParent of the chat to the related item.
-// Sets o.R.Parent to related.
-// Adds o to related.R.ParentChats.
-func (o *Chat) SetParent(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Chat) error {
+// AddChatPins adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatPins.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatPins(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatPin) error {
 	var err error
-	if insert {
-		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
-			return errors.Wrap(err, "failed to insert into foreign table")
-		}
-	}
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_pins\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatPinPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
 
-	updateQuery := fmt.Sprintf(
-		"UPDATE \"chats\" SET %s WHERE %s",
-		strmangle.SetParamNames("\"", "\"", 1, []string{"parent_id"}),
-		strmangle.WhereClause("\"", "\"", 2, chatPrimaryKeyColumns),
-	)
-	values := []interface{}{related.ID, o.ID}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if boil.IsDebug(ctx) {
-		writer := boil.DebugWriterFrom(ctx)
-		fmt.Fprintln(writer, updateQuery)
-		fmt.Fprintln(writer, values)
-	}
-	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-		return errors.Wrap(err, "failed to update local table")
+			rel.ChatID = o.ID
+		}
 	}
 
-	queries.Assign(&o.ParentID, related.ID)
 	if o.R == nil {
 		o.R = &chatR{
-			Parent: related,
+			ChatPins: related,
 		}
 	} else {
-		o.R.Parent = related
+		o.R.ChatPins = append(o.R.ChatPins, related...)
 	}
 
-	if related.R == nil {
-		related.R = &chatR{
-			ParentChats: ChatSlice{o},
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatPinR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
 		}
-	} else {
-		related.R.ParentChats = append(related.R.ParentChats, o)
 	}
-
 	return nil
 }
 
-// RemoveParentG relationship.
-// Sets o.R.Parent to nil.
-// Removes o from all passed in related items' relationships struct.
+// AddChatReadCursorsG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatReadCursors.
+// Sets related.R.Chat appropriately.
 // Uses the global database handle.
-func (o *Chat) RemoveParentG(ctx context.Context, related *Chat) error {
-	return o.RemoveParent(ctx, boil.GetContextDB(), related)
+func (o *Chat) AddChatReadCursorsG(ctx context.Context, insert bool, related ...*ChatReadCursor) error {
+	return o.AddChatReadCursors(ctx, boil.GetContextDB(), insert, related...)
 }
 
-// RemoveParent relationship.
-// Sets o.R.Parent to nil.
-// Removes o from all passed in related items' relationships struct.
-func (o *Chat) RemoveParent(ctx context.Context, exec boil.ContextExecutor, related *Chat) error {
+// AddChatReadCursors adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatReadCursors.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatReadCursors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatReadCursor) error {
 	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatReadCursorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ChatID, rel.UserID}
 
-	queries.SetScanner(&o.ParentID, nil)
-	if _, err = o.Update(ctx, exec, boil.Whitelist("parent_id")); err != nil {
-		return errors.Wrap(err, "failed to update local table")
-	}
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
 
-	if o.R != nil {
-		o.R.Parent = nil
-	}
-	if related == nil || related.R == nil {
-		return nil
+			rel.ChatID = o.ID
+		}
 	}
 
-	for i, ri := range related.R.ParentChats {
-		if queries.Equal(o.ParentID, ri.ParentID) {
-			continue
+	if o.R == nil {
+		o.R = &chatR{
+			ChatReadCursors: related,
 		}
+	} else {
+		o.R.ChatReadCursors = append(o.R.ChatReadCursors, related...)
+	}
 
-		ln := len(related.R.ParentChats)
-		if ln > 1 && i < ln-1 {
-			related.R.ParentChats[i] = related.R.ParentChats[ln-1]
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatReadCursorR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
 		}
-		related.R.ParentChats = related.R.ParentChats[:ln-1]
-		break
 	}
 	return nil
 }
@@ -1309,6 +2911,68 @@ func (o *Chat) AddChatUsers(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddChatWebhooksG adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatWebhooks.
+// Sets related.R.Chat appropriately.
+// Uses the global database handle.
+func (o *Chat) AddChatWebhooksG(ctx context.Context, insert bool, related ...*ChatWebhook) error {
+	return o.AddChatWebhooks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatWebhooks adds the given related objects to the existing relationships
+// of the chat, optionally inserting them as new records.
+// Appends related to o.R.ChatWebhooks.
+// Sets related.R.Chat appropriately.
+func (o *Chat) AddChatWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatWebhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ChatID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"chat_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatWebhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ChatID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &chatR{
+			ChatWebhooks: related,
+		}
+	} else {
+		o.R.ChatWebhooks = append(o.R.ChatWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatWebhookR{
+				Chat: o,
+			}
+		} else {
+			rel.R.Chat = o
+		}
+	}
+	return nil
+}
+
 // AddParentChatsG adds the given related objects to the existing relationships
 // of the chat, optionally inserting them as new records.
 // Appends related to o.R.ParentChats.
@@ -1466,7 +3130,7 @@ func (o *Chat) RemoveParentChats(ctx context.Context, exec boil.ContextExecutor,
 
 // Chats retrieves all the records using an executor.
 func Chats(mods ...qm.QueryMod) chatQuery {
-	mods = append(mods, qm.From("\"chats\""))
+	mods = append(mods, qm.From("\"chats\""), qmhelper.WhereIsNull("\"chats\".\"deleted_at\""))
 	q := NewQuery(mods...)
 	if len(queries.GetSelect(q)) == 0 {
 		queries.SetSelect(q, []string{"\"chats\".*"})
@@ -1490,7 +3154,7 @@ func FindChat(ctx context.Context, exec boil.ContextExecutor, iD string, selectC
 		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
 	}
 	query := fmt.Sprintf(
-		"select %s from \"chats\" where \"id\"=$1", sel,
+		"select %s from \"chats\" where \"id\"=$1 and \"deleted_at\" is null", sel,
 	)
 
 	q := queries.Raw(query, iD)
@@ -1523,6 +3187,16 @@ func (o *Chat) Insert(ctx context.Context, exec boil.ContextExecutor, columns bo
 	}
 
 	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
 
 	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
 		return err
@@ -1604,6 +3278,12 @@ func (o *Chat) UpdateG(ctx context.Context, columns boil.Columns) (int64, error)
 // See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
 // Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
 func (o *Chat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
 	var err error
 	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
 		return 0, err
@@ -1749,6 +3429,14 @@ func (o *Chat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 	if o == nil {
 		return errors.New("models: no chats provided for upsert")
 	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
 
 	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
 		return err
@@ -1861,13 +3549,13 @@ func (o *Chat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnCo
 
 // DeleteG deletes a single Chat record.
 // DeleteG will match against the primary key column to find the record to delete.
-func (o *Chat) DeleteG(ctx context.Context) (int64, error) {
-	return o.Delete(ctx, boil.GetContextDB())
+func (o *Chat) DeleteG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // Delete deletes a single Chat record with an executor.
 // Delete will match against the primary key column to find the record to delete.
-func (o *Chat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o *Chat) Delete(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if o == nil {
 		return 0, errors.New("models: no Chat provided for delete")
 	}
@@ -1876,8 +3564,26 @@ func (o *Chat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 		return 0, err
 	}
 
-	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatPrimaryKeyMapping)
-	sql := "DELETE FROM \"chats\" WHERE \"id\"=$1"
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), chatPrimaryKeyMapping)
+		sql = "DELETE FROM \"chats\" WHERE \"id\"=$1"
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		o.DeletedAt = null.TimeFrom(currTime)
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"chats\" SET %s WHERE \"id\"=$2",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		valueMapping, err := queries.BindMapping(chatType, chatMapping, append(wl, chatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+		args = queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), valueMapping)
+	}
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1901,17 +3607,22 @@ func (o *Chat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, er
 	return rowsAff, nil
 }
 
-func (q chatQuery) DeleteAllG(ctx context.Context) (int64, error) {
-	return q.DeleteAll(ctx, boil.GetContextDB())
+func (q chatQuery) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all matching rows.
-func (q chatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (q chatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if q.Query == nil {
 		return 0, errors.New("models: no chatQuery provided for delete all")
 	}
 
-	queries.SetDelete(q.Query)
+	if hardDelete {
+		queries.SetDelete(q.Query)
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		queries.SetUpdate(q.Query, M{"deleted_at": currTime})
+	}
 
 	result, err := q.Query.ExecContext(ctx, exec)
 	if err != nil {
@@ -1927,12 +3638,12 @@ func (q chatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 }
 
 // DeleteAllG deletes all rows in the slice.
-func (o ChatSlice) DeleteAllG(ctx context.Context) (int64, error) {
-	return o.DeleteAll(ctx, boil.GetContextDB())
+func (o ChatSlice) DeleteAllG(ctx context.Context, hardDelete bool) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB(), hardDelete)
 }
 
 // DeleteAll deletes all rows in the slice, using an executor.
-func (o ChatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+func (o ChatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor, hardDelete bool) (int64, error) {
 	if len(o) == 0 {
 		return 0, nil
 	}
@@ -1945,15 +3656,32 @@ func (o ChatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (in
 		}
 	}
 
-	var args []interface{}
-	for _, obj := range o {
-		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
-		args = append(args, pkeyArgs...)
+	var (
+		sql  string
+		args []interface{}
+	)
+	if hardDelete {
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+		}
+		sql = "DELETE FROM \"chats\" WHERE " +
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPrimaryKeyColumns, len(o))
+	} else {
+		currTime := time.Now().In(boil.GetLocation())
+		for _, obj := range o {
+			pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), chatPrimaryKeyMapping)
+			args = append(args, pkeyArgs...)
+			obj.DeletedAt = null.TimeFrom(currTime)
+		}
+		wl := []string{"deleted_at"}
+		sql = fmt.Sprintf("UPDATE \"chats\" SET %s WHERE "+
+			strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 2, chatPrimaryKeyColumns, len(o)),
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+		)
+		args = append([]interface{}{currTime}, args...)
 	}
 
-	sql := "DELETE FROM \"chats\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPrimaryKeyColumns, len(o))
-
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
 		fmt.Fprintln(writer, sql)
@@ -2026,7 +3754,8 @@ func (o *ChatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) er
 	}
 
 	sql := "SELECT \"chats\".* FROM \"chats\" WHERE " +
-		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPrimaryKeyColumns, len(*o))
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, chatPrimaryKeyColumns, len(*o)) +
+		"and \"deleted_at\" is null"
 
 	q := queries.Raw(sql, args...)
 
@@ -2048,7 +3777,7 @@ func ChatExistsG(ctx context.Context, iD string) (bool, error) {
 // ChatExists checks if the Chat row exists.
 func ChatExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
 	var exists bool
-	sql := "select exists(select 1 from \"chats\" where \"id\"=$1 limit 1)"
+	sql := "select exists(select 1 from \"chats\" where \"id\"=$1 and \"deleted_at\" is null limit 1)"
 
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -0,0 +1,992 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GameDay is an object representing the database table.
+type GameDay struct {
+	Day          time.Time `boil:"day" json:"day" toml:"day" yaml:"day"`
+	SyncedAt     time.Time `boil:"synced_at" json:"synced_at" toml:"synced_at" yaml:"synced_at"`
+	TournamentID int       `boil:"tournament_id" json:"tournament_id" toml:"tournament_id" yaml:"tournament_id"`
+
+	R *gameDayR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gameDayL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GameDayColumns = struct {
+	Day          string
+	SyncedAt     string
+	TournamentID string
+}{
+	Day:          "day",
+	SyncedAt:     "synced_at",
+	TournamentID: "tournament_id",
+}
+
+var GameDayTableColumns = struct {
+	Day          string
+	SyncedAt     string
+	TournamentID string
+}{
+	Day:          "game_days.day",
+	SyncedAt:     "game_days.synced_at",
+	TournamentID: "game_days.tournament_id",
+}
+
+// Generated where
+
+var GameDayWhere = struct {
+	Day          whereHelpertime_Time
+	SyncedAt     whereHelpertime_Time
+	TournamentID whereHelperint
+}{
+	Day:          whereHelpertime_Time{field: "\"game_days\".\"day\""},
+	SyncedAt:     whereHelpertime_Time{field: "\"game_days\".\"synced_at\""},
+	TournamentID: whereHelperint{field: "\"game_days\".\"tournament_id\""},
+}
+
+// GameDayRels is where relationship names are stored.
+var GameDayRels = struct {
+}{}
+
+// gameDayR is where relationships are stored.
+type gameDayR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*gameDayR) NewStruct() *gameDayR {
+	return &gameDayR{}
+}
+
+// gameDayL is where Load methods for each relationship are stored.
+type gameDayL struct{}
+
+var (
+	gameDayAllColumns            = []string{"day", "synced_at", "tournament_id"}
+	gameDayColumnsWithoutDefault = []string{"day", "tournament_id"}
+	gameDayColumnsWithDefault    = []string{"synced_at"}
+	gameDayPrimaryKeyColumns     = []string{"tournament_id", "day"}
+	gameDayGeneratedColumns      = []string{}
+)
+
+type (
+	// GameDaySlice is an alias for a slice of pointers to GameDay.
+	// This should almost always be used instead of []GameDay.
+	GameDaySlice []*GameDay
+	// GameDayHook is the signature for custom GameDay hook methods
+	GameDayHook func(context.Context, boil.ContextExecutor, *GameDay) error
+
+	gameDayQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gameDayType                 = reflect.TypeOf(&GameDay{})
+	gameDayMapping              = queries.MakeStructMapping(gameDayType)
+	gameDayPrimaryKeyMapping, _ = queries.BindMapping(gameDayType, gameDayMapping, gameDayPrimaryKeyColumns)
+	gameDayInsertCacheMut       sync.RWMutex
+	gameDayInsertCache          = make(map[string]insertCache)
+	gameDayUpdateCacheMut       sync.RWMutex
+	gameDayUpdateCache          = make(map[string]updateCache)
+	gameDayUpsertCacheMut       sync.RWMutex
+	gameDayUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gameDayAfterSelectHooks []GameDayHook
+
+var gameDayBeforeInsertHooks []GameDayHook
+var gameDayAfterInsertHooks []GameDayHook
+
+var gameDayBeforeUpdateHooks []GameDayHook
+var gameDayAfterUpdateHooks []GameDayHook
+
+var gameDayBeforeDeleteHooks []GameDayHook
+var gameDayAfterDeleteHooks []GameDayHook
+
+var gameDayBeforeUpsertHooks []GameDayHook
+var gameDayAfterUpsertHooks []GameDayHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GameDay) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GameDay) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GameDay) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GameDay) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GameDay) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GameDay) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GameDay) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GameDay) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GameDay) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameDayAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGameDayHook registers your hook function for all future operations.
+func AddGameDayHook(hookPoint boil.HookPoint, gameDayHook GameDayHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		gameDayAfterSelectHooks = append(gameDayAfterSelectHooks, gameDayHook)
+	case boil.BeforeInsertHook:
+		gameDayBeforeInsertHooks = append(gameDayBeforeInsertHooks, gameDayHook)
+	case boil.AfterInsertHook:
+		gameDayAfterInsertHooks = append(gameDayAfterInsertHooks, gameDayHook)
+	case boil.BeforeUpdateHook:
+		gameDayBeforeUpdateHooks = append(gameDayBeforeUpdateHooks, gameDayHook)
+	case boil.AfterUpdateHook:
+		gameDayAfterUpdateHooks = append(gameDayAfterUpdateHooks, gameDayHook)
+	case boil.BeforeDeleteHook:
+		gameDayBeforeDeleteHooks = append(gameDayBeforeDeleteHooks, gameDayHook)
+	case boil.AfterDeleteHook:
+		gameDayAfterDeleteHooks = append(gameDayAfterDeleteHooks, gameDayHook)
+	case boil.BeforeUpsertHook:
+		gameDayBeforeUpsertHooks = append(gameDayBeforeUpsertHooks, gameDayHook)
+	case boil.AfterUpsertHook:
+		gameDayAfterUpsertHooks = append(gameDayAfterUpsertHooks, gameDayHook)
+	}
+}
+
+// OneG returns a single gameDay record from the query using the global executor.
+func (q gameDayQuery) OneG(ctx context.Context) (*GameDay, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single gameDay record from the query.
+func (q gameDayQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GameDay, error) {
+	o := &GameDay{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for game_days")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all GameDay records from the query using the global executor.
+func (q gameDayQuery) AllG(ctx context.Context) (GameDaySlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all GameDay records from the query.
+func (q gameDayQuery) All(ctx context.Context, exec boil.ContextExecutor) (GameDaySlice, error) {
+	var o []*GameDay
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to GameDay slice")
+	}
+
+	if len(gameDayAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all GameDay records in the query using the global executor
+func (q gameDayQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all GameDay records in the query.
+func (q gameDayQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count game_days rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q gameDayQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q gameDayQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if game_days exists")
+	}
+
+	return count > 0, nil
+}
+
+// GameDays retrieves all the records using an executor.
+func GameDays(mods ...qm.QueryMod) gameDayQuery {
+	mods = append(mods, qm.From("\"game_days\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"game_days\".*"})
+	}
+
+	return gameDayQuery{q}
+}
+
+// FindGameDayG retrieves a single record by ID.
+func FindGameDayG(ctx context.Context, tournamentID int, day time.Time, selectCols ...string) (*GameDay, error) {
+	return FindGameDay(ctx, boil.GetContextDB(), tournamentID, day, selectCols...)
+}
+
+// FindGameDay retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGameDay(ctx context.Context, exec boil.ContextExecutor, tournamentID int, day time.Time, selectCols ...string) (*GameDay, error) {
+	gameDayObj := &GameDay{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"game_days\" where \"tournament_id\"=$1 AND \"day\"=$2", sel,
+	)
+
+	q := queries.Raw(query, tournamentID, day)
+
+	err := q.Bind(ctx, exec, gameDayObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from game_days")
+	}
+
+	if err = gameDayObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return gameDayObj, err
+	}
+
+	return gameDayObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *GameDay) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GameDay) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_days provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameDayColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gameDayInsertCacheMut.RLock()
+	cache, cached := gameDayInsertCache[key]
+	gameDayInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gameDayAllColumns,
+			gameDayColumnsWithDefault,
+			gameDayColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gameDayType, gameDayMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gameDayType, gameDayMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"game_days\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"game_days\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into game_days")
+	}
+
+	if !cached {
+		gameDayInsertCacheMut.Lock()
+		gameDayInsertCache[key] = cache
+		gameDayInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single GameDay record using the global executor.
+// See Update for more documentation.
+func (o *GameDay) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the GameDay.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GameDay) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gameDayUpdateCacheMut.RLock()
+	cache, cached := gameDayUpdateCache[key]
+	gameDayUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gameDayAllColumns,
+			gameDayPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update game_days, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"game_days\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gameDayPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gameDayType, gameDayMapping, append(wl, gameDayPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update game_days row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for game_days")
+	}
+
+	if !cached {
+		gameDayUpdateCacheMut.Lock()
+		gameDayUpdateCache[key] = cache
+		gameDayUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q gameDayQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gameDayQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for game_days")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for game_days")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GameDaySlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GameDaySlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameDayPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"game_days\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gameDayPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in gameDay slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all gameDay")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *GameDay) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GameDay) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_days provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameDayColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gameDayUpsertCacheMut.RLock()
+	cache, cached := gameDayUpsertCache[key]
+	gameDayUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gameDayAllColumns,
+			gameDayColumnsWithDefault,
+			gameDayColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			gameDayAllColumns,
+			gameDayPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert game_days, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gameDayPrimaryKeyColumns))
+			copy(conflict, gameDayPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"game_days\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gameDayType, gameDayMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gameDayType, gameDayMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert game_days")
+	}
+
+	if !cached {
+		gameDayUpsertCacheMut.Lock()
+		gameDayUpsertCache[key] = cache
+		gameDayUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single GameDay record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *GameDay) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single GameDay record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GameDay) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no GameDay provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gameDayPrimaryKeyMapping)
+	sql := "DELETE FROM \"game_days\" WHERE \"tournament_id\"=$1 AND \"day\"=$2"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from game_days")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for game_days")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q gameDayQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q gameDayQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no gameDayQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from game_days")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_days")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GameDaySlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GameDaySlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gameDayBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameDayPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"game_days\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gameDayPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from gameDay slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_days")
+	}
+
+	if len(gameDayAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *GameDay) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no GameDay provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GameDay) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGameDay(ctx, exec, o.TournamentID, o.Day)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameDaySlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty GameDaySlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameDaySlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GameDaySlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameDayPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"game_days\".* FROM \"game_days\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gameDayPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GameDaySlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GameDayExistsG checks if the GameDay row exists.
+func GameDayExistsG(ctx context.Context, tournamentID int, day time.Time) (bool, error) {
+	return GameDayExists(ctx, boil.GetContextDB(), tournamentID, day)
+}
+
+// GameDayExists checks if the GameDay row exists.
+func GameDayExists(ctx context.Context, exec boil.ContextExecutor, tournamentID int, day time.Time) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"game_days\" where \"tournament_id\"=$1 AND \"day\"=$2 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, tournamentID, day)
+	}
+	row := exec.QueryRowContext(ctx, sql, tournamentID, day)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if game_days exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GameDay row exists.
+func (o *GameDay) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GameDayExists(ctx, exec, o.TournamentID, o.Day)
+}
@@ -0,0 +1,1329 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GamePlayerStat is an object representing the database table.
+type GamePlayerStat struct {
+	ID                 string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GameID             int    `boil:"game_id" json:"game_id" toml:"game_id" yaml:"game_id"`
+	TeamID             int    `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	PlayerID           int    `boil:"player_id" json:"player_id" toml:"player_id" yaml:"player_id"`
+	PlayerName         string `boil:"player_name" json:"player_name" toml:"player_name" yaml:"player_name"`
+	IsHome             bool   `boil:"is_home" json:"is_home" toml:"is_home" yaml:"is_home"`
+	Position           int    `boil:"position" json:"position" toml:"position" yaml:"position"`
+	SecondsPlayed      int    `boil:"seconds_played" json:"seconds_played" toml:"seconds_played" yaml:"seconds_played"`
+	FieldGoalsMade     int    `boil:"field_goals_made" json:"field_goals_made" toml:"field_goals_made" yaml:"field_goals_made"`
+	FieldGoalAttempts  int    `boil:"field_goal_attempts" json:"field_goal_attempts" toml:"field_goal_attempts" yaml:"field_goal_attempts"`
+	ThreePointsMade    int    `boil:"three_points_made" json:"three_points_made" toml:"three_points_made" yaml:"three_points_made"`
+	ThreePointAttempts int    `boil:"three_point_attempts" json:"three_point_attempts" toml:"three_point_attempts" yaml:"three_point_attempts"`
+	FreeThrowsMade     int    `boil:"free_throws_made" json:"free_throws_made" toml:"free_throws_made" yaml:"free_throws_made"`
+	FreeThrowAttempts  int    `boil:"free_throw_attempts" json:"free_throw_attempts" toml:"free_throw_attempts" yaml:"free_throw_attempts"`
+	OffensiveRebounds  int    `boil:"offensive_rebounds" json:"offensive_rebounds" toml:"offensive_rebounds" yaml:"offensive_rebounds"`
+	DefensiveRebounds  int    `boil:"defensive_rebounds" json:"defensive_rebounds" toml:"defensive_rebounds" yaml:"defensive_rebounds"`
+	Rebounds           int    `boil:"rebounds" json:"rebounds" toml:"rebounds" yaml:"rebounds"`
+	Assists            int    `boil:"assists" json:"assists" toml:"assists" yaml:"assists"`
+	Steals             int    `boil:"steals" json:"steals" toml:"steals" yaml:"steals"`
+	Blocks             int    `boil:"blocks" json:"blocks" toml:"blocks" yaml:"blocks"`
+	Turnovers          int    `boil:"turnovers" json:"turnovers" toml:"turnovers" yaml:"turnovers"`
+	PersonalFouls      int    `boil:"personal_fouls" json:"personal_fouls" toml:"personal_fouls" yaml:"personal_fouls"`
+	Points             int    `boil:"points" json:"points" toml:"points" yaml:"points"`
+
+	R *gamePlayerStatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gamePlayerStatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GamePlayerStatColumns = struct {
+	ID                 string
+	GameID             string
+	TeamID             string
+	PlayerID           string
+	PlayerName         string
+	IsHome             string
+	Position           string
+	SecondsPlayed      string
+	FieldGoalsMade     string
+	FieldGoalAttempts  string
+	ThreePointsMade    string
+	ThreePointAttempts string
+	FreeThrowsMade     string
+	FreeThrowAttempts  string
+	OffensiveRebounds  string
+	DefensiveRebounds  string
+	Rebounds           string
+	Assists            string
+	Steals             string
+	Blocks             string
+	Turnovers          string
+	PersonalFouls      string
+	Points             string
+}{
+	ID:                 "id",
+	GameID:             "game_id",
+	TeamID:             "team_id",
+	PlayerID:           "player_id",
+	PlayerName:         "player_name",
+	IsHome:             "is_home",
+	Position:           "position",
+	SecondsPlayed:      "seconds_played",
+	FieldGoalsMade:     "field_goals_made",
+	FieldGoalAttempts:  "field_goal_attempts",
+	ThreePointsMade:    "three_points_made",
+	ThreePointAttempts: "three_point_attempts",
+	FreeThrowsMade:     "free_throws_made",
+	FreeThrowAttempts:  "free_throw_attempts",
+	OffensiveRebounds:  "offensive_rebounds",
+	DefensiveRebounds:  "defensive_rebounds",
+	Rebounds:           "rebounds",
+	Assists:            "assists",
+	Steals:             "steals",
+	Blocks:             "blocks",
+	Turnovers:          "turnovers",
+	PersonalFouls:      "personal_fouls",
+	Points:             "points",
+}
+
+var GamePlayerStatTableColumns = struct {
+	ID                 string
+	GameID             string
+	TeamID             string
+	PlayerID           string
+	PlayerName         string
+	IsHome             string
+	Position           string
+	SecondsPlayed      string
+	FieldGoalsMade     string
+	FieldGoalAttempts  string
+	ThreePointsMade    string
+	ThreePointAttempts string
+	FreeThrowsMade     string
+	FreeThrowAttempts  string
+	OffensiveRebounds  string
+	DefensiveRebounds  string
+	Rebounds           string
+	Assists            string
+	Steals             string
+	Blocks             string
+	Turnovers          string
+	PersonalFouls      string
+	Points             string
+}{
+	ID:                 "game_player_stats.id",
+	GameID:             "game_player_stats.game_id",
+	TeamID:             "game_player_stats.team_id",
+	PlayerID:           "game_player_stats.player_id",
+	PlayerName:         "game_player_stats.player_name",
+	IsHome:             "game_player_stats.is_home",
+	Position:           "game_player_stats.position",
+	SecondsPlayed:      "game_player_stats.seconds_played",
+	FieldGoalsMade:     "game_player_stats.field_goals_made",
+	FieldGoalAttempts:  "game_player_stats.field_goal_attempts",
+	ThreePointsMade:    "game_player_stats.three_points_made",
+	ThreePointAttempts: "game_player_stats.three_point_attempts",
+	FreeThrowsMade:     "game_player_stats.free_throws_made",
+	FreeThrowAttempts:  "game_player_stats.free_throw_attempts",
+	OffensiveRebounds:  "game_player_stats.offensive_rebounds",
+	DefensiveRebounds:  "game_player_stats.defensive_rebounds",
+	Rebounds:           "game_player_stats.rebounds",
+	Assists:            "game_player_stats.assists",
+	Steals:             "game_player_stats.steals",
+	Blocks:             "game_player_stats.blocks",
+	Turnovers:          "game_player_stats.turnovers",
+	PersonalFouls:      "game_player_stats.personal_fouls",
+	Points:             "game_player_stats.points",
+}
+
+// Generated where
+
+var GamePlayerStatWhere = struct {
+	ID                 whereHelperstring
+	GameID             whereHelperint
+	TeamID             whereHelperint
+	PlayerID           whereHelperint
+	PlayerName         whereHelperstring
+	IsHome             whereHelperbool
+	Position           whereHelperint
+	SecondsPlayed      whereHelperint
+	FieldGoalsMade     whereHelperint
+	FieldGoalAttempts  whereHelperint
+	ThreePointsMade    whereHelperint
+	ThreePointAttempts whereHelperint
+	FreeThrowsMade     whereHelperint
+	FreeThrowAttempts  whereHelperint
+	OffensiveRebounds  whereHelperint
+	DefensiveRebounds  whereHelperint
+	Rebounds           whereHelperint
+	Assists            whereHelperint
+	Steals             whereHelperint
+	Blocks             whereHelperint
+	Turnovers          whereHelperint
+	PersonalFouls      whereHelperint
+	Points             whereHelperint
+}{
+	ID:                 whereHelperstring{field: "\"game_player_stats\".\"id\""},
+	GameID:             whereHelperint{field: "\"game_player_stats\".\"game_id\""},
+	TeamID:             whereHelperint{field: "\"game_player_stats\".\"team_id\""},
+	PlayerID:           whereHelperint{field: "\"game_player_stats\".\"player_id\""},
+	PlayerName:         whereHelperstring{field: "\"game_player_stats\".\"player_name\""},
+	IsHome:             whereHelperbool{field: "\"game_player_stats\".\"is_home\""},
+	Position:           whereHelperint{field: "\"game_player_stats\".\"position\""},
+	SecondsPlayed:      whereHelperint{field: "\"game_player_stats\".\"seconds_played\""},
+	FieldGoalsMade:     whereHelperint{field: "\"game_player_stats\".\"field_goals_made\""},
+	FieldGoalAttempts:  whereHelperint{field: "\"game_player_stats\".\"field_goal_attempts\""},
+	ThreePointsMade:    whereHelperint{field: "\"game_player_stats\".\"three_points_made\""},
+	ThreePointAttempts: whereHelperint{field: "\"game_player_stats\".\"three_point_attempts\""},
+	FreeThrowsMade:     whereHelperint{field: "\"game_player_stats\".\"free_throws_made\""},
+	FreeThrowAttempts:  whereHelperint{field: "\"game_player_stats\".\"free_throw_attempts\""},
+	OffensiveRebounds:  whereHelperint{field: "\"game_player_stats\".\"offensive_rebounds\""},
+	DefensiveRebounds:  whereHelperint{field: "\"game_player_stats\".\"defensive_rebounds\""},
+	Rebounds:           whereHelperint{field: "\"game_player_stats\".\"rebounds\""},
+	Assists:            whereHelperint{field: "\"game_player_stats\".\"assists\""},
+	Steals:             whereHelperint{field: "\"game_player_stats\".\"steals\""},
+	Blocks:             whereHelperint{field: "\"game_player_stats\".\"blocks\""},
+	Turnovers:          whereHelperint{field: "\"game_player_stats\".\"turnovers\""},
+	PersonalFouls:      whereHelperint{field: "\"game_player_stats\".\"personal_fouls\""},
+	Points:             whereHelperint{field: "\"game_player_stats\".\"points\""},
+}
+
+// GamePlayerStatRels is where relationship names are stored.
+var GamePlayerStatRels = struct {
+	Game string
+}{
+	Game: "Game",
+}
+
+// gamePlayerStatR is where relationships are stored.
+type gamePlayerStatR struct {
+	Game *Game `boil:"Game" json:"Game" toml:"Game" yaml:"Game"`
+}
+
+// NewStruct creates a new relationship struct
+func (*gamePlayerStatR) NewStruct() *gamePlayerStatR {
+	return &gamePlayerStatR{}
+}
+
+func (r *gamePlayerStatR) GetGame() *Game {
+	if r == nil {
+		return nil
+	}
+	return r.Game
+}
+
+// gamePlayerStatL is where Load methods for each relationship are stored.
+type gamePlayerStatL struct{}
+
+var (
+	gamePlayerStatAllColumns            = []string{"id", "game_id", "team_id", "player_id", "player_name", "is_home", "position", "seconds_played", "field_goals_made", "field_goal_attempts", "three_points_made", "three_point_attempts", "free_throws_made", "free_throw_attempts", "offensive_rebounds", "defensive_rebounds", "rebounds", "assists", "steals", "blocks", "turnovers", "personal_fouls", "points"}
+	gamePlayerStatColumnsWithoutDefault = []string{"game_id", "team_id", "player_id", "player_name", "is_home"}
+	gamePlayerStatColumnsWithDefault    = []string{"id", "position", "seconds_played", "field_goals_made", "field_goal_attempts", "three_points_made", "three_point_attempts", "free_throws_made", "free_throw_attempts", "offensive_rebounds", "defensive_rebounds", "rebounds", "assists", "steals", "blocks", "turnovers", "personal_fouls", "points"}
+	gamePlayerStatPrimaryKeyColumns     = []string{"id"}
+	gamePlayerStatGeneratedColumns      = []string{}
+)
+
+type (
+	// GamePlayerStatSlice is an alias for a slice of pointers to GamePlayerStat.
+	// This should almost always be used instead of []GamePlayerStat.
+	GamePlayerStatSlice []*GamePlayerStat
+	// GamePlayerStatHook is the signature for custom GamePlayerStat hook methods
+	GamePlayerStatHook func(context.Context, boil.ContextExecutor, *GamePlayerStat) error
+
+	gamePlayerStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gamePlayerStatType                 = reflect.TypeOf(&GamePlayerStat{})
+	gamePlayerStatMapping              = queries.MakeStructMapping(gamePlayerStatType)
+	gamePlayerStatPrimaryKeyMapping, _ = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, gamePlayerStatPrimaryKeyColumns)
+	gamePlayerStatInsertCacheMut       sync.RWMutex
+	gamePlayerStatInsertCache          = make(map[string]insertCache)
+	gamePlayerStatUpdateCacheMut       sync.RWMutex
+	gamePlayerStatUpdateCache          = make(map[string]updateCache)
+	gamePlayerStatUpsertCacheMut       sync.RWMutex
+	gamePlayerStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gamePlayerStatAfterSelectHooks []GamePlayerStatHook
+
+var gamePlayerStatBeforeInsertHooks []GamePlayerStatHook
+var gamePlayerStatAfterInsertHooks []GamePlayerStatHook
+
+var gamePlayerStatBeforeUpdateHooks []GamePlayerStatHook
+var gamePlayerStatAfterUpdateHooks []GamePlayerStatHook
+
+var gamePlayerStatBeforeDeleteHooks []GamePlayerStatHook
+var gamePlayerStatAfterDeleteHooks []GamePlayerStatHook
+
+var gamePlayerStatBeforeUpsertHooks []GamePlayerStatHook
+var gamePlayerStatAfterUpsertHooks []GamePlayerStatHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GamePlayerStat) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GamePlayerStat) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GamePlayerStat) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GamePlayerStat) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GamePlayerStat) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GamePlayerStat) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GamePlayerStat) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GamePlayerStat) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GamePlayerStat) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gamePlayerStatAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGamePlayerStatHook registers your hook function for all future operations.
+func AddGamePlayerStatHook(hookPoint boil.HookPoint, gamePlayerStatHook GamePlayerStatHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		gamePlayerStatAfterSelectHooks = append(gamePlayerStatAfterSelectHooks, gamePlayerStatHook)
+	case boil.BeforeInsertHook:
+		gamePlayerStatBeforeInsertHooks = append(gamePlayerStatBeforeInsertHooks, gamePlayerStatHook)
+	case boil.AfterInsertHook:
+		gamePlayerStatAfterInsertHooks = append(gamePlayerStatAfterInsertHooks, gamePlayerStatHook)
+	case boil.BeforeUpdateHook:
+		gamePlayerStatBeforeUpdateHooks = append(gamePlayerStatBeforeUpdateHooks, gamePlayerStatHook)
+	case boil.AfterUpdateHook:
+		gamePlayerStatAfterUpdateHooks = append(gamePlayerStatAfterUpdateHooks, gamePlayerStatHook)
+	case boil.BeforeDeleteHook:
+		gamePlayerStatBeforeDeleteHooks = append(gamePlayerStatBeforeDeleteHooks, gamePlayerStatHook)
+	case boil.AfterDeleteHook:
+		gamePlayerStatAfterDeleteHooks = append(gamePlayerStatAfterDeleteHooks, gamePlayerStatHook)
+	case boil.BeforeUpsertHook:
+		gamePlayerStatBeforeUpsertHooks = append(gamePlayerStatBeforeUpsertHooks, gamePlayerStatHook)
+	case boil.AfterUpsertHook:
+		gamePlayerStatAfterUpsertHooks = append(gamePlayerStatAfterUpsertHooks, gamePlayerStatHook)
+	}
+}
+
+// OneG returns a single gamePlayerStat record from the query using the global executor.
+func (q gamePlayerStatQuery) OneG(ctx context.Context) (*GamePlayerStat, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single gamePlayerStat record from the query.
+func (q gamePlayerStatQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GamePlayerStat, error) {
+	o := &GamePlayerStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for game_player_stats")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all GamePlayerStat records from the query using the global executor.
+func (q gamePlayerStatQuery) AllG(ctx context.Context) (GamePlayerStatSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all GamePlayerStat records from the query.
+func (q gamePlayerStatQuery) All(ctx context.Context, exec boil.ContextExecutor) (GamePlayerStatSlice, error) {
+	var o []*GamePlayerStat
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to GamePlayerStat slice")
+	}
+
+	if len(gamePlayerStatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all GamePlayerStat records in the query using the global executor
+func (q gamePlayerStatQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all GamePlayerStat records in the query.
+func (q gamePlayerStatQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count game_player_stats rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q gamePlayerStatQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q gamePlayerStatQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if game_player_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// Game pointed to by the foreign key.
+func (o *GamePlayerStat) Game(mods ...qm.QueryMod) gameQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Games(queryMods...)
+}
+
+// LoadGame allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gamePlayerStatL) LoadGame(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGamePlayerStat interface{}, mods queries.Applicator) error {
+	var slice []*GamePlayerStat
+	var object *GamePlayerStat
+
+	if singular {
+		var ok bool
+		object, ok = maybeGamePlayerStat.(*GamePlayerStat)
+		if !ok {
+			object = new(GamePlayerStat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGamePlayerStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGamePlayerStat))
+			}
+		}
+	} else {
+		s, ok := maybeGamePlayerStat.(*[]*GamePlayerStat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGamePlayerStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGamePlayerStat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gamePlayerStatR{}
+		}
+		args = append(args, object.GameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gamePlayerStatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`games`),
+		qm.WhereIn(`games.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Game")
+	}
+
+	var resultSlice []*Game
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Game")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for games")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for games")
+	}
+
+	if len(gameAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Game = foreign
+		if foreign.R == nil {
+			foreign.R = &gameR{}
+		}
+		foreign.R.GamePlayerStats = append(foreign.R.GamePlayerStats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GameID == foreign.ID {
+				local.R.Game = foreign
+				if foreign.R == nil {
+					foreign.R = &gameR{}
+				}
+				foreign.R.GamePlayerStats = append(foreign.R.GamePlayerStats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGameG of the gamePlayerStat to the related item.
+// Sets o.R.Game to related.
+// Adds o to related.R.GamePlayerStats.
+// Uses the global database handle.
+func (o *GamePlayerStat) SetGameG(ctx context.Context, insert bool, related *Game) error {
+	return o.SetGame(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetGame of the gamePlayerStat to the related item.
+// Sets o.R.Game to related.
+// Adds o to related.R.GamePlayerStats.
+func (o *GamePlayerStat) SetGame(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Game) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"game_player_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"game_id"}),
+		strmangle.WhereClause("\"", "\"", 2, gamePlayerStatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GameID = related.ID
+	if o.R == nil {
+		o.R = &gamePlayerStatR{
+			Game: related,
+		}
+	} else {
+		o.R.Game = related
+	}
+
+	if related.R == nil {
+		related.R = &gameR{
+			GamePlayerStats: GamePlayerStatSlice{o},
+		}
+	} else {
+		related.R.GamePlayerStats = append(related.R.GamePlayerStats, o)
+	}
+
+	return nil
+}
+
+// GamePlayerStats retrieves all the records using an executor.
+func GamePlayerStats(mods ...qm.QueryMod) gamePlayerStatQuery {
+	mods = append(mods, qm.From("\"game_player_stats\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"game_player_stats\".*"})
+	}
+
+	return gamePlayerStatQuery{q}
+}
+
+// FindGamePlayerStatG retrieves a single record by ID.
+func FindGamePlayerStatG(ctx context.Context, iD string, selectCols ...string) (*GamePlayerStat, error) {
+	return FindGamePlayerStat(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindGamePlayerStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGamePlayerStat(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*GamePlayerStat, error) {
+	gamePlayerStatObj := &GamePlayerStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"game_player_stats\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, gamePlayerStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from game_player_stats")
+	}
+
+	if err = gamePlayerStatObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return gamePlayerStatObj, err
+	}
+
+	return gamePlayerStatObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *GamePlayerStat) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GamePlayerStat) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_player_stats provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gamePlayerStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gamePlayerStatInsertCacheMut.RLock()
+	cache, cached := gamePlayerStatInsertCache[key]
+	gamePlayerStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gamePlayerStatAllColumns,
+			gamePlayerStatColumnsWithDefault,
+			gamePlayerStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"game_player_stats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"game_player_stats\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into game_player_stats")
+	}
+
+	if !cached {
+		gamePlayerStatInsertCacheMut.Lock()
+		gamePlayerStatInsertCache[key] = cache
+		gamePlayerStatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single GamePlayerStat record using the global executor.
+// See Update for more documentation.
+func (o *GamePlayerStat) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the GamePlayerStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GamePlayerStat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gamePlayerStatUpdateCacheMut.RLock()
+	cache, cached := gamePlayerStatUpdateCache[key]
+	gamePlayerStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gamePlayerStatAllColumns,
+			gamePlayerStatPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update game_player_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"game_player_stats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gamePlayerStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, append(wl, gamePlayerStatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update game_player_stats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for game_player_stats")
+	}
+
+	if !cached {
+		gamePlayerStatUpdateCacheMut.Lock()
+		gamePlayerStatUpdateCache[key] = cache
+		gamePlayerStatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q gamePlayerStatQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gamePlayerStatQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for game_player_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for game_player_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GamePlayerStatSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GamePlayerStatSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePlayerStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"game_player_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gamePlayerStatPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in gamePlayerStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all gamePlayerStat")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *GamePlayerStat) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GamePlayerStat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_player_stats provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gamePlayerStatColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gamePlayerStatUpsertCacheMut.RLock()
+	cache, cached := gamePlayerStatUpsertCache[key]
+	gamePlayerStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gamePlayerStatAllColumns,
+			gamePlayerStatColumnsWithDefault,
+			gamePlayerStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			gamePlayerStatAllColumns,
+			gamePlayerStatPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert game_player_stats, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gamePlayerStatPrimaryKeyColumns))
+			copy(conflict, gamePlayerStatPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"game_player_stats\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gamePlayerStatType, gamePlayerStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert game_player_stats")
+	}
+
+	if !cached {
+		gamePlayerStatUpsertCacheMut.Lock()
+		gamePlayerStatUpsertCache[key] = cache
+		gamePlayerStatUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single GamePlayerStat record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *GamePlayerStat) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single GamePlayerStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GamePlayerStat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no GamePlayerStat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gamePlayerStatPrimaryKeyMapping)
+	sql := "DELETE FROM \"game_player_stats\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from game_player_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for game_player_stats")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q gamePlayerStatQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q gamePlayerStatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no gamePlayerStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from game_player_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_player_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GamePlayerStatSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GamePlayerStatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gamePlayerStatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePlayerStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"game_player_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gamePlayerStatPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from gamePlayerStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_player_stats")
+	}
+
+	if len(gamePlayerStatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *GamePlayerStat) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no GamePlayerStat provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GamePlayerStat) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGamePlayerStat(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GamePlayerStatSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty GamePlayerStatSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GamePlayerStatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GamePlayerStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePlayerStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"game_player_stats\".* FROM \"game_player_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gamePlayerStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GamePlayerStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GamePlayerStatExistsG checks if the GamePlayerStat row exists.
+func GamePlayerStatExistsG(ctx context.Context, iD string) (bool, error) {
+	return GamePlayerStatExists(ctx, boil.GetContextDB(), iD)
+}
+
+// GamePlayerStatExists checks if the GamePlayerStat row exists.
+func GamePlayerStatExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"game_player_stats\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if game_player_stats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GamePlayerStat row exists.
+func (o *GamePlayerStat) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GamePlayerStatExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1280 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// GameTeamStat is an object representing the database table.
+type GameTeamStat struct {
+	ID                 string `boil:"id" json:"id" toml:"id" yaml:"id"`
+	GameID             int    `boil:"game_id" json:"game_id" toml:"game_id" yaml:"game_id"`
+	TeamID             int    `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	IsHome             bool   `boil:"is_home" json:"is_home" toml:"is_home" yaml:"is_home"`
+	Rebounds           int    `boil:"rebounds" json:"rebounds" toml:"rebounds" yaml:"rebounds"`
+	Assists            int    `boil:"assists" json:"assists" toml:"assists" yaml:"assists"`
+	Steals             int    `boil:"steals" json:"steals" toml:"steals" yaml:"steals"`
+	Blocks             int    `boil:"blocks" json:"blocks" toml:"blocks" yaml:"blocks"`
+	Turnovers          int    `boil:"turnovers" json:"turnovers" toml:"turnovers" yaml:"turnovers"`
+	Fouls              int    `boil:"fouls" json:"fouls" toml:"fouls" yaml:"fouls"`
+	FieldGoalsMade     int    `boil:"field_goals_made" json:"field_goals_made" toml:"field_goals_made" yaml:"field_goals_made"`
+	FieldGoalAttempts  int    `boil:"field_goal_attempts" json:"field_goal_attempts" toml:"field_goal_attempts" yaml:"field_goal_attempts"`
+	ThreePointsMade    int    `boil:"three_points_made" json:"three_points_made" toml:"three_points_made" yaml:"three_points_made"`
+	ThreePointAttempts int    `boil:"three_point_attempts" json:"three_point_attempts" toml:"three_point_attempts" yaml:"three_point_attempts"`
+	FreeThrowsMade     int    `boil:"free_throws_made" json:"free_throws_made" toml:"free_throws_made" yaml:"free_throws_made"`
+	FreeThrowAttempts  int    `boil:"free_throw_attempts" json:"free_throw_attempts" toml:"free_throw_attempts" yaml:"free_throw_attempts"`
+
+	R *gameTeamStatR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gameTeamStatL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GameTeamStatColumns = struct {
+	ID                 string
+	GameID             string
+	TeamID             string
+	IsHome             string
+	Rebounds           string
+	Assists            string
+	Steals             string
+	Blocks             string
+	Turnovers          string
+	Fouls              string
+	FieldGoalsMade     string
+	FieldGoalAttempts  string
+	ThreePointsMade    string
+	ThreePointAttempts string
+	FreeThrowsMade     string
+	FreeThrowAttempts  string
+}{
+	ID:                 "id",
+	GameID:             "game_id",
+	TeamID:             "team_id",
+	IsHome:             "is_home",
+	Rebounds:           "rebounds",
+	Assists:            "assists",
+	Steals:             "steals",
+	Blocks:             "blocks",
+	Turnovers:          "turnovers",
+	Fouls:              "fouls",
+	FieldGoalsMade:     "field_goals_made",
+	FieldGoalAttempts:  "field_goal_attempts",
+	ThreePointsMade:    "three_points_made",
+	ThreePointAttempts: "three_point_attempts",
+	FreeThrowsMade:     "free_throws_made",
+	FreeThrowAttempts:  "free_throw_attempts",
+}
+
+var GameTeamStatTableColumns = struct {
+	ID                 string
+	GameID             string
+	TeamID             string
+	IsHome             string
+	Rebounds           string
+	Assists            string
+	Steals             string
+	Blocks             string
+	Turnovers          string
+	Fouls              string
+	FieldGoalsMade     string
+	FieldGoalAttempts  string
+	ThreePointsMade    string
+	ThreePointAttempts string
+	FreeThrowsMade     string
+	FreeThrowAttempts  string
+}{
+	ID:                 "game_team_stats.id",
+	GameID:             "game_team_stats.game_id",
+	TeamID:             "game_team_stats.team_id",
+	IsHome:             "game_team_stats.is_home",
+	Rebounds:           "game_team_stats.rebounds",
+	Assists:            "game_team_stats.assists",
+	Steals:             "game_team_stats.steals",
+	Blocks:             "game_team_stats.blocks",
+	Turnovers:          "game_team_stats.turnovers",
+	Fouls:              "game_team_stats.fouls",
+	FieldGoalsMade:     "game_team_stats.field_goals_made",
+	FieldGoalAttempts:  "game_team_stats.field_goal_attempts",
+	ThreePointsMade:    "game_team_stats.three_points_made",
+	ThreePointAttempts: "game_team_stats.three_point_attempts",
+	FreeThrowsMade:     "game_team_stats.free_throws_made",
+	FreeThrowAttempts:  "game_team_stats.free_throw_attempts",
+}
+
+// Generated where
+
+var GameTeamStatWhere = struct {
+	ID                 whereHelperstring
+	GameID             whereHelperint
+	TeamID             whereHelperint
+	IsHome             whereHelperbool
+	Rebounds           whereHelperint
+	Assists            whereHelperint
+	Steals             whereHelperint
+	Blocks             whereHelperint
+	Turnovers          whereHelperint
+	Fouls              whereHelperint
+	FieldGoalsMade     whereHelperint
+	FieldGoalAttempts  whereHelperint
+	ThreePointsMade    whereHelperint
+	ThreePointAttempts whereHelperint
+	FreeThrowsMade     whereHelperint
+	FreeThrowAttempts  whereHelperint
+}{
+	ID:                 whereHelperstring{field: "\"game_team_stats\".\"id\""},
+	GameID:             whereHelperint{field: "\"game_team_stats\".\"game_id\""},
+	TeamID:             whereHelperint{field: "\"game_team_stats\".\"team_id\""},
+	IsHome:             whereHelperbool{field: "\"game_team_stats\".\"is_home\""},
+	Rebounds:           whereHelperint{field: "\"game_team_stats\".\"rebounds\""},
+	Assists:            whereHelperint{field: "\"game_team_stats\".\"assists\""},
+	Steals:             whereHelperint{field: "\"game_team_stats\".\"steals\""},
+	Blocks:             whereHelperint{field: "\"game_team_stats\".\"blocks\""},
+	Turnovers:          whereHelperint{field: "\"game_team_stats\".\"turnovers\""},
+	Fouls:              whereHelperint{field: "\"game_team_stats\".\"fouls\""},
+	FieldGoalsMade:     whereHelperint{field: "\"game_team_stats\".\"field_goals_made\""},
+	FieldGoalAttempts:  whereHelperint{field: "\"game_team_stats\".\"field_goal_attempts\""},
+	ThreePointsMade:    whereHelperint{field: "\"game_team_stats\".\"three_points_made\""},
+	ThreePointAttempts: whereHelperint{field: "\"game_team_stats\".\"three_point_attempts\""},
+	FreeThrowsMade:     whereHelperint{field: "\"game_team_stats\".\"free_throws_made\""},
+	FreeThrowAttempts:  whereHelperint{field: "\"game_team_stats\".\"free_throw_attempts\""},
+}
+
+// GameTeamStatRels is where relationship names are stored.
+var GameTeamStatRels = struct {
+	Game string
+}{
+	Game: "Game",
+}
+
+// gameTeamStatR is where relationships are stored.
+type gameTeamStatR struct {
+	Game *Game `boil:"Game" json:"Game" toml:"Game" yaml:"Game"`
+}
+
+// NewStruct creates a new relationship struct
+func (*gameTeamStatR) NewStruct() *gameTeamStatR {
+	return &gameTeamStatR{}
+}
+
+func (r *gameTeamStatR) GetGame() *Game {
+	if r == nil {
+		return nil
+	}
+	return r.Game
+}
+
+// gameTeamStatL is where Load methods for each relationship are stored.
+type gameTeamStatL struct{}
+
+var (
+	gameTeamStatAllColumns            = []string{"id", "game_id", "team_id", "is_home", "rebounds", "assists", "steals", "blocks", "turnovers", "fouls", "field_goals_made", "field_goal_attempts", "three_points_made", "three_point_attempts", "free_throws_made", "free_throw_attempts"}
+	gameTeamStatColumnsWithoutDefault = []string{"game_id", "team_id", "is_home"}
+	gameTeamStatColumnsWithDefault    = []string{"id", "rebounds", "assists", "steals", "blocks", "turnovers", "fouls", "field_goals_made", "field_goal_attempts", "three_points_made", "three_point_attempts", "free_throws_made", "free_throw_attempts"}
+	gameTeamStatPrimaryKeyColumns     = []string{"id"}
+	gameTeamStatGeneratedColumns      = []string{}
+)
+
+type (
+	// GameTeamStatSlice is an alias for a slice of pointers to GameTeamStat.
+	// This should almost always be used instead of []GameTeamStat.
+	GameTeamStatSlice []*GameTeamStat
+	// GameTeamStatHook is the signature for custom GameTeamStat hook methods
+	GameTeamStatHook func(context.Context, boil.ContextExecutor, *GameTeamStat) error
+
+	gameTeamStatQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gameTeamStatType                 = reflect.TypeOf(&GameTeamStat{})
+	gameTeamStatMapping              = queries.MakeStructMapping(gameTeamStatType)
+	gameTeamStatPrimaryKeyMapping, _ = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, gameTeamStatPrimaryKeyColumns)
+	gameTeamStatInsertCacheMut       sync.RWMutex
+	gameTeamStatInsertCache          = make(map[string]insertCache)
+	gameTeamStatUpdateCacheMut       sync.RWMutex
+	gameTeamStatUpdateCache          = make(map[string]updateCache)
+	gameTeamStatUpsertCacheMut       sync.RWMutex
+	gameTeamStatUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gameTeamStatAfterSelectHooks []GameTeamStatHook
+
+var gameTeamStatBeforeInsertHooks []GameTeamStatHook
+var gameTeamStatAfterInsertHooks []GameTeamStatHook
+
+var gameTeamStatBeforeUpdateHooks []GameTeamStatHook
+var gameTeamStatAfterUpdateHooks []GameTeamStatHook
+
+var gameTeamStatBeforeDeleteHooks []GameTeamStatHook
+var gameTeamStatAfterDeleteHooks []GameTeamStatHook
+
+var gameTeamStatBeforeUpsertHooks []GameTeamStatHook
+var gameTeamStatAfterUpsertHooks []GameTeamStatHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *GameTeamStat) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *GameTeamStat) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *GameTeamStat) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *GameTeamStat) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *GameTeamStat) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *GameTeamStat) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *GameTeamStat) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *GameTeamStat) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *GameTeamStat) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameTeamStatAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGameTeamStatHook registers your hook function for all future operations.
+func AddGameTeamStatHook(hookPoint boil.HookPoint, gameTeamStatHook GameTeamStatHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		gameTeamStatAfterSelectHooks = append(gameTeamStatAfterSelectHooks, gameTeamStatHook)
+	case boil.BeforeInsertHook:
+		gameTeamStatBeforeInsertHooks = append(gameTeamStatBeforeInsertHooks, gameTeamStatHook)
+	case boil.AfterInsertHook:
+		gameTeamStatAfterInsertHooks = append(gameTeamStatAfterInsertHooks, gameTeamStatHook)
+	case boil.BeforeUpdateHook:
+		gameTeamStatBeforeUpdateHooks = append(gameTeamStatBeforeUpdateHooks, gameTeamStatHook)
+	case boil.AfterUpdateHook:
+		gameTeamStatAfterUpdateHooks = append(gameTeamStatAfterUpdateHooks, gameTeamStatHook)
+	case boil.BeforeDeleteHook:
+		gameTeamStatBeforeDeleteHooks = append(gameTeamStatBeforeDeleteHooks, gameTeamStatHook)
+	case boil.AfterDeleteHook:
+		gameTeamStatAfterDeleteHooks = append(gameTeamStatAfterDeleteHooks, gameTeamStatHook)
+	case boil.BeforeUpsertHook:
+		gameTeamStatBeforeUpsertHooks = append(gameTeamStatBeforeUpsertHooks, gameTeamStatHook)
+	case boil.AfterUpsertHook:
+		gameTeamStatAfterUpsertHooks = append(gameTeamStatAfterUpsertHooks, gameTeamStatHook)
+	}
+}
+
+// OneG returns a single gameTeamStat record from the query using the global executor.
+func (q gameTeamStatQuery) OneG(ctx context.Context) (*GameTeamStat, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single gameTeamStat record from the query.
+func (q gameTeamStatQuery) One(ctx context.Context, exec boil.ContextExecutor) (*GameTeamStat, error) {
+	o := &GameTeamStat{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for game_team_stats")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all GameTeamStat records from the query using the global executor.
+func (q gameTeamStatQuery) AllG(ctx context.Context) (GameTeamStatSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all GameTeamStat records from the query.
+func (q gameTeamStatQuery) All(ctx context.Context, exec boil.ContextExecutor) (GameTeamStatSlice, error) {
+	var o []*GameTeamStat
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to GameTeamStat slice")
+	}
+
+	if len(gameTeamStatAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all GameTeamStat records in the query using the global executor
+func (q gameTeamStatQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all GameTeamStat records in the query.
+func (q gameTeamStatQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count game_team_stats rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q gameTeamStatQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q gameTeamStatQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if game_team_stats exists")
+	}
+
+	return count > 0, nil
+}
+
+// Game pointed to by the foreign key.
+func (o *GameTeamStat) Game(mods ...qm.QueryMod) gameQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.GameID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Games(queryMods...)
+}
+
+// LoadGame allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (gameTeamStatL) LoadGame(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGameTeamStat interface{}, mods queries.Applicator) error {
+	var slice []*GameTeamStat
+	var object *GameTeamStat
+
+	if singular {
+		var ok bool
+		object, ok = maybeGameTeamStat.(*GameTeamStat)
+		if !ok {
+			object = new(GameTeamStat)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGameTeamStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGameTeamStat))
+			}
+		}
+	} else {
+		s, ok := maybeGameTeamStat.(*[]*GameTeamStat)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGameTeamStat)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGameTeamStat))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gameTeamStatR{}
+		}
+		args = append(args, object.GameID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameTeamStatR{}
+			}
+
+			for _, a := range args {
+				if a == obj.GameID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.GameID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`games`),
+		qm.WhereIn(`games.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load Game")
+	}
+
+	var resultSlice []*Game
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice Game")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for games")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for games")
+	}
+
+	if len(gameAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.Game = foreign
+		if foreign.R == nil {
+			foreign.R = &gameR{}
+		}
+		foreign.R.GameTeamStats = append(foreign.R.GameTeamStats, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.GameID == foreign.ID {
+				local.R.Game = foreign
+				if foreign.R == nil {
+					foreign.R = &gameR{}
+				}
+				foreign.R.GameTeamStats = append(foreign.R.GameTeamStats, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetGameG of the gameTeamStat to the related item.
+// Sets o.R.Game to related.
+// Adds o to related.R.GameTeamStats.
+// Uses the global database handle.
+func (o *GameTeamStat) SetGameG(ctx context.Context, insert bool, related *Game) error {
+	return o.SetGame(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetGame of the gameTeamStat to the related item.
+// Sets o.R.Game to related.
+// Adds o to related.R.GameTeamStats.
+func (o *GameTeamStat) SetGame(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Game) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"game_team_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"game_id"}),
+		strmangle.WhereClause("\"", "\"", 2, gameTeamStatPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.GameID = related.ID
+	if o.R == nil {
+		o.R = &gameTeamStatR{
+			Game: related,
+		}
+	} else {
+		o.R.Game = related
+	}
+
+	if related.R == nil {
+		related.R = &gameR{
+			GameTeamStats: GameTeamStatSlice{o},
+		}
+	} else {
+		related.R.GameTeamStats = append(related.R.GameTeamStats, o)
+	}
+
+	return nil
+}
+
+// GameTeamStats retrieves all the records using an executor.
+func GameTeamStats(mods ...qm.QueryMod) gameTeamStatQuery {
+	mods = append(mods, qm.From("\"game_team_stats\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"game_team_stats\".*"})
+	}
+
+	return gameTeamStatQuery{q}
+}
+
+// FindGameTeamStatG retrieves a single record by ID.
+func FindGameTeamStatG(ctx context.Context, iD string, selectCols ...string) (*GameTeamStat, error) {
+	return FindGameTeamStat(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindGameTeamStat retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGameTeamStat(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*GameTeamStat, error) {
+	gameTeamStatObj := &GameTeamStat{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"game_team_stats\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, gameTeamStatObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from game_team_stats")
+	}
+
+	if err = gameTeamStatObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return gameTeamStatObj, err
+	}
+
+	return gameTeamStatObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *GameTeamStat) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *GameTeamStat) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_team_stats provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameTeamStatColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gameTeamStatInsertCacheMut.RLock()
+	cache, cached := gameTeamStatInsertCache[key]
+	gameTeamStatInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gameTeamStatAllColumns,
+			gameTeamStatColumnsWithDefault,
+			gameTeamStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"game_team_stats\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"game_team_stats\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into game_team_stats")
+	}
+
+	if !cached {
+		gameTeamStatInsertCacheMut.Lock()
+		gameTeamStatInsertCache[key] = cache
+		gameTeamStatInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single GameTeamStat record using the global executor.
+// See Update for more documentation.
+func (o *GameTeamStat) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the GameTeamStat.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *GameTeamStat) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gameTeamStatUpdateCacheMut.RLock()
+	cache, cached := gameTeamStatUpdateCache[key]
+	gameTeamStatUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gameTeamStatAllColumns,
+			gameTeamStatPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update game_team_stats, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"game_team_stats\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gameTeamStatPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, append(wl, gameTeamStatPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update game_team_stats row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for game_team_stats")
+	}
+
+	if !cached {
+		gameTeamStatUpdateCacheMut.Lock()
+		gameTeamStatUpdateCache[key] = cache
+		gameTeamStatUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q gameTeamStatQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gameTeamStatQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for game_team_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for game_team_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GameTeamStatSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GameTeamStatSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameTeamStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"game_team_stats\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gameTeamStatPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in gameTeamStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all gameTeamStat")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *GameTeamStat) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *GameTeamStat) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no game_team_stats provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameTeamStatColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gameTeamStatUpsertCacheMut.RLock()
+	cache, cached := gameTeamStatUpsertCache[key]
+	gameTeamStatUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gameTeamStatAllColumns,
+			gameTeamStatColumnsWithDefault,
+			gameTeamStatColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			gameTeamStatAllColumns,
+			gameTeamStatPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert game_team_stats, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gameTeamStatPrimaryKeyColumns))
+			copy(conflict, gameTeamStatPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"game_team_stats\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gameTeamStatType, gameTeamStatMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert game_team_stats")
+	}
+
+	if !cached {
+		gameTeamStatUpsertCacheMut.Lock()
+		gameTeamStatUpsertCache[key] = cache
+		gameTeamStatUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single GameTeamStat record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *GameTeamStat) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single GameTeamStat record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *GameTeamStat) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no GameTeamStat provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gameTeamStatPrimaryKeyMapping)
+	sql := "DELETE FROM \"game_team_stats\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from game_team_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for game_team_stats")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q gameTeamStatQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q gameTeamStatQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no gameTeamStatQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from game_team_stats")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_team_stats")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GameTeamStatSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GameTeamStatSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gameTeamStatBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameTeamStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"game_team_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gameTeamStatPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from gameTeamStat slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for game_team_stats")
+	}
+
+	if len(gameTeamStatAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *GameTeamStat) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no GameTeamStat provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *GameTeamStat) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGameTeamStat(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameTeamStatSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty GameTeamStatSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameTeamStatSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GameTeamStatSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gameTeamStatPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"game_team_stats\".* FROM \"game_team_stats\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gameTeamStatPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GameTeamStatSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GameTeamStatExistsG checks if the GameTeamStat row exists.
+func GameTeamStatExistsG(ctx context.Context, iD string) (bool, error) {
+	return GameTeamStatExists(ctx, boil.GetContextDB(), iD)
+}
+
+// GameTeamStatExists checks if the GameTeamStat row exists.
+func GameTeamStatExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"game_team_stats\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if game_team_stats exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the GameTeamStat row exists.
+func (o *GameTeamStat) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GameTeamStatExists(ctx, exec, o.ID)
+}
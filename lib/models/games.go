@@ -0,0 +1,1531 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// Game is an object representing the database table.
+type Game struct {
+	ID                int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Tournament        string     `boil:"tournament" json:"tournament" toml:"tournament" yaml:"tournament"`
+	StatusCode        int        `boil:"status_code" json:"status_code" toml:"status_code" yaml:"status_code"`
+	StatusDescription string     `boil:"status_description" json:"status_description" toml:"status_description" yaml:"status_description"`
+	StatusType        string     `boil:"status_type" json:"status_type" toml:"status_type" yaml:"status_type"`
+	HomeTeamID        int        `boil:"home_team_id" json:"home_team_id" toml:"home_team_id" yaml:"home_team_id"`
+	AwayTeamID        int        `boil:"away_team_id" json:"away_team_id" toml:"away_team_id" yaml:"away_team_id"`
+	HomeScore         null.Int   `boil:"home_score" json:"home_score,omitempty" toml:"home_score" yaml:"home_score,omitempty"`
+	AwayScore         null.Int   `boil:"away_score" json:"away_score,omitempty" toml:"away_score" yaml:"away_score,omitempty"`
+	StartAt           time.Time  `boil:"start_at" json:"start_at" toml:"start_at" yaml:"start_at"`
+	Event             types.JSON `boil:"event" json:"event" toml:"event" yaml:"event"`
+	BoxScoreAt        null.Time  `boil:"box_score_at" json:"box_score_at,omitempty" toml:"box_score_at" yaml:"box_score_at,omitempty"`
+	CreatedAt         time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt         time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	SeasonID          null.Int   `boil:"season_id" json:"season_id,omitempty" toml:"season_id" yaml:"season_id,omitempty"`
+	TournamentID      null.Int   `boil:"tournament_id" json:"tournament_id,omitempty" toml:"tournament_id" yaml:"tournament_id,omitempty"`
+
+	R *gameR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L gameL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var GameColumns = struct {
+	ID                string
+	Tournament        string
+	StatusCode        string
+	StatusDescription string
+	StatusType        string
+	HomeTeamID        string
+	AwayTeamID        string
+	HomeScore         string
+	AwayScore         string
+	StartAt           string
+	Event             string
+	BoxScoreAt        string
+	CreatedAt         string
+	UpdatedAt         string
+	SeasonID          string
+	TournamentID      string
+}{
+	ID:                "id",
+	Tournament:        "tournament",
+	StatusCode:        "status_code",
+	StatusDescription: "status_description",
+	StatusType:        "status_type",
+	HomeTeamID:        "home_team_id",
+	AwayTeamID:        "away_team_id",
+	HomeScore:         "home_score",
+	AwayScore:         "away_score",
+	StartAt:           "start_at",
+	Event:             "event",
+	BoxScoreAt:        "box_score_at",
+	CreatedAt:         "created_at",
+	UpdatedAt:         "updated_at",
+	SeasonID:          "season_id",
+	TournamentID:      "tournament_id",
+}
+
+var GameTableColumns = struct {
+	ID                string
+	Tournament        string
+	StatusCode        string
+	StatusDescription string
+	StatusType        string
+	HomeTeamID        string
+	AwayTeamID        string
+	HomeScore         string
+	AwayScore         string
+	StartAt           string
+	Event             string
+	BoxScoreAt        string
+	CreatedAt         string
+	UpdatedAt         string
+	SeasonID          string
+	TournamentID      string
+}{
+	ID:                "games.id",
+	Tournament:        "games.tournament",
+	StatusCode:        "games.status_code",
+	StatusDescription: "games.status_description",
+	StatusType:        "games.status_type",
+	HomeTeamID:        "games.home_team_id",
+	AwayTeamID:        "games.away_team_id",
+	HomeScore:         "games.home_score",
+	AwayScore:         "games.away_score",
+	StartAt:           "games.start_at",
+	Event:             "games.event",
+	BoxScoreAt:        "games.box_score_at",
+	CreatedAt:         "games.created_at",
+	UpdatedAt:         "games.updated_at",
+	SeasonID:          "games.season_id",
+	TournamentID:      "games.tournament_id",
+}
+
+// Generated where
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+var GameWhere = struct {
+	ID                whereHelperint
+	Tournament        whereHelperstring
+	StatusCode        whereHelperint
+	StatusDescription whereHelperstring
+	StatusType        whereHelperstring
+	HomeTeamID        whereHelperint
+	AwayTeamID        whereHelperint
+	HomeScore         whereHelpernull_Int
+	AwayScore         whereHelpernull_Int
+	StartAt           whereHelpertime_Time
+	Event             whereHelpertypes_JSON
+	BoxScoreAt        whereHelpernull_Time
+	CreatedAt         whereHelpertime_Time
+	UpdatedAt         whereHelpertime_Time
+	SeasonID          whereHelpernull_Int
+	TournamentID      whereHelpernull_Int
+}{
+	ID:                whereHelperint{field: "\"games\".\"id\""},
+	Tournament:        whereHelperstring{field: "\"games\".\"tournament\""},
+	StatusCode:        whereHelperint{field: "\"games\".\"status_code\""},
+	StatusDescription: whereHelperstring{field: "\"games\".\"status_description\""},
+	StatusType:        whereHelperstring{field: "\"games\".\"status_type\""},
+	HomeTeamID:        whereHelperint{field: "\"games\".\"home_team_id\""},
+	AwayTeamID:        whereHelperint{field: "\"games\".\"away_team_id\""},
+	HomeScore:         whereHelpernull_Int{field: "\"games\".\"home_score\""},
+	AwayScore:         whereHelpernull_Int{field: "\"games\".\"away_score\""},
+	StartAt:           whereHelpertime_Time{field: "\"games\".\"start_at\""},
+	Event:             whereHelpertypes_JSON{field: "\"games\".\"event\""},
+	BoxScoreAt:        whereHelpernull_Time{field: "\"games\".\"box_score_at\""},
+	CreatedAt:         whereHelpertime_Time{field: "\"games\".\"created_at\""},
+	UpdatedAt:         whereHelpertime_Time{field: "\"games\".\"updated_at\""},
+	SeasonID:          whereHelpernull_Int{field: "\"games\".\"season_id\""},
+	TournamentID:      whereHelpernull_Int{field: "\"games\".\"tournament_id\""},
+}
+
+// GameRels is where relationship names are stored.
+var GameRels = struct {
+	GamePlayerStats string
+	GameTeamStats   string
+}{
+	GamePlayerStats: "GamePlayerStats",
+	GameTeamStats:   "GameTeamStats",
+}
+
+// gameR is where relationships are stored.
+type gameR struct {
+	GamePlayerStats GamePlayerStatSlice `boil:"GamePlayerStats" json:"GamePlayerStats" toml:"GamePlayerStats" yaml:"GamePlayerStats"`
+	GameTeamStats   GameTeamStatSlice   `boil:"GameTeamStats" json:"GameTeamStats" toml:"GameTeamStats" yaml:"GameTeamStats"`
+}
+
+// NewStruct creates a new relationship struct
+func (*gameR) NewStruct() *gameR {
+	return &gameR{}
+}
+
+func (r *gameR) GetGamePlayerStats() GamePlayerStatSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GamePlayerStats
+}
+
+func (r *gameR) GetGameTeamStats() GameTeamStatSlice {
+	if r == nil {
+		return nil
+	}
+	return r.GameTeamStats
+}
+
+// gameL is where Load methods for each relationship are stored.
+type gameL struct{}
+
+var (
+	gameAllColumns            = []string{"id", "tournament", "status_code", "status_description", "status_type", "home_team_id", "away_team_id", "home_score", "away_score", "start_at", "event", "box_score_at", "created_at", "updated_at", "season_id", "tournament_id"}
+	gameColumnsWithoutDefault = []string{"id", "tournament", "status_code", "status_description", "status_type", "home_team_id", "away_team_id", "start_at", "event"}
+	gameColumnsWithDefault    = []string{"home_score", "away_score", "box_score_at", "created_at", "updated_at", "season_id", "tournament_id"}
+	gamePrimaryKeyColumns     = []string{"id"}
+	gameGeneratedColumns      = []string{}
+)
+
+type (
+	// GameSlice is an alias for a slice of pointers to Game.
+	// This should almost always be used instead of []Game.
+	GameSlice []*Game
+	// GameHook is the signature for custom Game hook methods
+	GameHook func(context.Context, boil.ContextExecutor, *Game) error
+
+	gameQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	gameType                 = reflect.TypeOf(&Game{})
+	gameMapping              = queries.MakeStructMapping(gameType)
+	gamePrimaryKeyMapping, _ = queries.BindMapping(gameType, gameMapping, gamePrimaryKeyColumns)
+	gameInsertCacheMut       sync.RWMutex
+	gameInsertCache          = make(map[string]insertCache)
+	gameUpdateCacheMut       sync.RWMutex
+	gameUpdateCache          = make(map[string]updateCache)
+	gameUpsertCacheMut       sync.RWMutex
+	gameUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var gameAfterSelectHooks []GameHook
+
+var gameBeforeInsertHooks []GameHook
+var gameAfterInsertHooks []GameHook
+
+var gameBeforeUpdateHooks []GameHook
+var gameAfterUpdateHooks []GameHook
+
+var gameBeforeDeleteHooks []GameHook
+var gameAfterDeleteHooks []GameHook
+
+var gameBeforeUpsertHooks []GameHook
+var gameAfterUpsertHooks []GameHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Game) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Game) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Game) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Game) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Game) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Game) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Game) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Game) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Game) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range gameAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddGameHook registers your hook function for all future operations.
+func AddGameHook(hookPoint boil.HookPoint, gameHook GameHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		gameAfterSelectHooks = append(gameAfterSelectHooks, gameHook)
+	case boil.BeforeInsertHook:
+		gameBeforeInsertHooks = append(gameBeforeInsertHooks, gameHook)
+	case boil.AfterInsertHook:
+		gameAfterInsertHooks = append(gameAfterInsertHooks, gameHook)
+	case boil.BeforeUpdateHook:
+		gameBeforeUpdateHooks = append(gameBeforeUpdateHooks, gameHook)
+	case boil.AfterUpdateHook:
+		gameAfterUpdateHooks = append(gameAfterUpdateHooks, gameHook)
+	case boil.BeforeDeleteHook:
+		gameBeforeDeleteHooks = append(gameBeforeDeleteHooks, gameHook)
+	case boil.AfterDeleteHook:
+		gameAfterDeleteHooks = append(gameAfterDeleteHooks, gameHook)
+	case boil.BeforeUpsertHook:
+		gameBeforeUpsertHooks = append(gameBeforeUpsertHooks, gameHook)
+	case boil.AfterUpsertHook:
+		gameAfterUpsertHooks = append(gameAfterUpsertHooks, gameHook)
+	}
+}
+
+// OneG returns a single game record from the query using the global executor.
+func (q gameQuery) OneG(ctx context.Context) (*Game, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single game record from the query.
+func (q gameQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Game, error) {
+	o := &Game{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for games")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Game records from the query using the global executor.
+func (q gameQuery) AllG(ctx context.Context) (GameSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Game records from the query.
+func (q gameQuery) All(ctx context.Context, exec boil.ContextExecutor) (GameSlice, error) {
+	var o []*Game
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Game slice")
+	}
+
+	if len(gameAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Game records in the query using the global executor
+func (q gameQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Game records in the query.
+func (q gameQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count games rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q gameQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q gameQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if games exists")
+	}
+
+	return count > 0, nil
+}
+
+// GamePlayerStats retrieves all the game_player_stat's GamePlayerStats with an executor.
+func (o *Game) GamePlayerStats(mods ...qm.QueryMod) gamePlayerStatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"game_player_stats\".\"game_id\"=?", o.ID),
+	)
+
+	return GamePlayerStats(queryMods...)
+}
+
+// GameTeamStats retrieves all the game_team_stat's GameTeamStats with an executor.
+func (o *Game) GameTeamStats(mods ...qm.QueryMod) gameTeamStatQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"game_team_stats\".\"game_id\"=?", o.ID),
+	)
+
+	return GameTeamStats(queryMods...)
+}
+
+// LoadGamePlayerStats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (gameL) LoadGamePlayerStats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`game_player_stats`),
+		qm.WhereIn(`game_player_stats.game_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load game_player_stats")
+	}
+
+	var resultSlice []*GamePlayerStat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice game_player_stats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on game_player_stats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for game_player_stats")
+	}
+
+	if len(gamePlayerStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GamePlayerStats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &gamePlayerStatR{}
+			}
+			foreign.R.Game = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GameID {
+				local.R.GamePlayerStats = append(local.R.GamePlayerStats, foreign)
+				if foreign.R == nil {
+					foreign.R = &gamePlayerStatR{}
+				}
+				foreign.R.Game = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadGameTeamStats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (gameL) LoadGameTeamStats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeGame interface{}, mods queries.Applicator) error {
+	var slice []*Game
+	var object *Game
+
+	if singular {
+		var ok bool
+		object, ok = maybeGame.(*Game)
+		if !ok {
+			object = new(Game)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeGame))
+			}
+		}
+	} else {
+		s, ok := maybeGame.(*[]*Game)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeGame)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeGame))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &gameR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &gameR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`game_team_stats`),
+		qm.WhereIn(`game_team_stats.game_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load game_team_stats")
+	}
+
+	var resultSlice []*GameTeamStat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice game_team_stats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on game_team_stats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for game_team_stats")
+	}
+
+	if len(gameTeamStatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.GameTeamStats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &gameTeamStatR{}
+			}
+			foreign.R.Game = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.GameID {
+				local.R.GameTeamStats = append(local.R.GameTeamStats, foreign)
+				if foreign.R == nil {
+					foreign.R = &gameTeamStatR{}
+				}
+				foreign.R.Game = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddGamePlayerStatsG adds the given related objects to the existing relationships
+// of the game, optionally inserting them as new records.
+// Appends related to o.R.GamePlayerStats.
+// Sets related.R.Game appropriately.
+// Uses the global database handle.
+func (o *Game) AddGamePlayerStatsG(ctx context.Context, insert bool, related ...*GamePlayerStat) error {
+	return o.AddGamePlayerStats(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddGamePlayerStats adds the given related objects to the existing relationships
+// of the game, optionally inserting them as new records.
+// Appends related to o.R.GamePlayerStats.
+// Sets related.R.Game appropriately.
+func (o *Game) AddGamePlayerStats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GamePlayerStat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"game_player_stats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"game_id"}),
+				strmangle.WhereClause("\"", "\"", 2, gamePlayerStatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &gameR{
+			GamePlayerStats: related,
+		}
+	} else {
+		o.R.GamePlayerStats = append(o.R.GamePlayerStats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &gamePlayerStatR{
+				Game: o,
+			}
+		} else {
+			rel.R.Game = o
+		}
+	}
+	return nil
+}
+
+// AddGameTeamStatsG adds the given related objects to the existing relationships
+// of the game, optionally inserting them as new records.
+// Appends related to o.R.GameTeamStats.
+// Sets related.R.Game appropriately.
+// Uses the global database handle.
+func (o *Game) AddGameTeamStatsG(ctx context.Context, insert bool, related ...*GameTeamStat) error {
+	return o.AddGameTeamStats(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddGameTeamStats adds the given related objects to the existing relationships
+// of the game, optionally inserting them as new records.
+// Appends related to o.R.GameTeamStats.
+// Sets related.R.Game appropriately.
+func (o *Game) AddGameTeamStats(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*GameTeamStat) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.GameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"game_team_stats\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"game_id"}),
+				strmangle.WhereClause("\"", "\"", 2, gameTeamStatPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.GameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &gameR{
+			GameTeamStats: related,
+		}
+	} else {
+		o.R.GameTeamStats = append(o.R.GameTeamStats, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &gameTeamStatR{
+				Game: o,
+			}
+		} else {
+			rel.R.Game = o
+		}
+	}
+	return nil
+}
+
+// Games retrieves all the records using an executor.
+func Games(mods ...qm.QueryMod) gameQuery {
+	mods = append(mods, qm.From("\"games\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"games\".*"})
+	}
+
+	return gameQuery{q}
+}
+
+// FindGameG retrieves a single record by ID.
+func FindGameG(ctx context.Context, iD int, selectCols ...string) (*Game, error) {
+	return FindGame(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindGame retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindGame(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*Game, error) {
+	gameObj := &Game{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"games\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, gameObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from games")
+	}
+
+	if err = gameObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return gameObj, err
+	}
+
+	return gameObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Game) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Game) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no games provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	gameInsertCacheMut.RLock()
+	cache, cached := gameInsertCache[key]
+	gameInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			gameAllColumns,
+			gameColumnsWithDefault,
+			gameColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(gameType, gameMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(gameType, gameMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"games\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"games\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into games")
+	}
+
+	if !cached {
+		gameInsertCacheMut.Lock()
+		gameInsertCache[key] = cache
+		gameInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Game record using the global executor.
+// See Update for more documentation.
+func (o *Game) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Game.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Game) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	gameUpdateCacheMut.RLock()
+	cache, cached := gameUpdateCache[key]
+	gameUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			gameAllColumns,
+			gamePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update games, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"games\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, gamePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(gameType, gameMapping, append(wl, gamePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update games row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for games")
+	}
+
+	if !cached {
+		gameUpdateCacheMut.Lock()
+		gameUpdateCache[key] = cache
+		gameUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q gameQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q gameQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for games")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for games")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o GameSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o GameSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"games\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, gamePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in game slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all game")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Game) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Game) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no games provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(gameColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	gameUpsertCacheMut.RLock()
+	cache, cached := gameUpsertCache[key]
+	gameUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			gameAllColumns,
+			gameColumnsWithDefault,
+			gameColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			gameAllColumns,
+			gamePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert games, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(gamePrimaryKeyColumns))
+			copy(conflict, gamePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"games\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(gameType, gameMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(gameType, gameMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert games")
+	}
+
+	if !cached {
+		gameUpsertCacheMut.Lock()
+		gameUpsertCache[key] = cache
+		gameUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Game record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Game) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Game record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Game) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Game provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), gamePrimaryKeyMapping)
+	sql := "DELETE FROM \"games\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from games")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for games")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q gameQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q gameQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no gameQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from games")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for games")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o GameSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o GameSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(gameBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"games\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gamePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from game slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for games")
+	}
+
+	if len(gameAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Game) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Game provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Game) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindGame(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty GameSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *GameSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := GameSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), gamePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"games\".* FROM \"games\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, gamePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in GameSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// GameExistsG checks if the Game row exists.
+func GameExistsG(ctx context.Context, iD int) (bool, error) {
+	return GameExists(ctx, boil.GetContextDB(), iD)
+}
+
+// GameExists checks if the Game row exists.
+func GameExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"games\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if games exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Game row exists.
+func (o *Game) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return GameExists(ctx, exec, o.ID)
+}
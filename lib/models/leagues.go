@@ -0,0 +1,1252 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// League is an object representing the database table.
+type League struct {
+	ID           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name         string    `boil:"name" json:"name" toml:"name" yaml:"name"`
+	Tournament   string    `boil:"tournament" json:"tournament" toml:"tournament" yaml:"tournament"`
+	TournamentID int       `boil:"tournament_id" json:"tournament_id" toml:"tournament_id" yaml:"tournament_id"`
+	SeasonID     int       `boil:"season_id" json:"season_id" toml:"season_id" yaml:"season_id"`
+	Enabled      bool      `boil:"enabled" json:"enabled" toml:"enabled" yaml:"enabled"`
+	CreatedAt    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *leagueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L leagueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LeagueColumns = struct {
+	ID           string
+	Name         string
+	Tournament   string
+	TournamentID string
+	SeasonID     string
+	Enabled      string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "id",
+	Name:         "name",
+	Tournament:   "tournament",
+	TournamentID: "tournament_id",
+	SeasonID:     "season_id",
+	Enabled:      "enabled",
+	CreatedAt:    "created_at",
+	UpdatedAt:    "updated_at",
+}
+
+var LeagueTableColumns = struct {
+	ID           string
+	Name         string
+	Tournament   string
+	TournamentID string
+	SeasonID     string
+	Enabled      string
+	CreatedAt    string
+	UpdatedAt    string
+}{
+	ID:           "leagues.id",
+	Name:         "leagues.name",
+	Tournament:   "leagues.tournament",
+	TournamentID: "leagues.tournament_id",
+	SeasonID:     "leagues.season_id",
+	Enabled:      "leagues.enabled",
+	CreatedAt:    "leagues.created_at",
+	UpdatedAt:    "leagues.updated_at",
+}
+
+// Generated where
+
+var LeagueWhere = struct {
+	ID           whereHelperstring
+	Name         whereHelperstring
+	Tournament   whereHelperstring
+	TournamentID whereHelperint
+	SeasonID     whereHelperint
+	Enabled      whereHelperbool
+	CreatedAt    whereHelpertime_Time
+	UpdatedAt    whereHelpertime_Time
+}{
+	ID:           whereHelperstring{field: "\"leagues\".\"id\""},
+	Name:         whereHelperstring{field: "\"leagues\".\"name\""},
+	Tournament:   whereHelperstring{field: "\"leagues\".\"tournament\""},
+	TournamentID: whereHelperint{field: "\"leagues\".\"tournament_id\""},
+	SeasonID:     whereHelperint{field: "\"leagues\".\"season_id\""},
+	Enabled:      whereHelperbool{field: "\"leagues\".\"enabled\""},
+	CreatedAt:    whereHelpertime_Time{field: "\"leagues\".\"created_at\""},
+	UpdatedAt:    whereHelpertime_Time{field: "\"leagues\".\"updated_at\""},
+}
+
+// LeagueRels is where relationship names are stored.
+var LeagueRels = struct {
+	TeamInfos string
+}{
+	TeamInfos: "TeamInfos",
+}
+
+// leagueR is where relationships are stored.
+type leagueR struct {
+	TeamInfos TeamInfoSlice `boil:"TeamInfos" json:"TeamInfos" toml:"TeamInfos" yaml:"TeamInfos"`
+}
+
+// NewStruct creates a new relationship struct
+func (*leagueR) NewStruct() *leagueR {
+	return &leagueR{}
+}
+
+func (r *leagueR) GetTeamInfos() TeamInfoSlice {
+	if r == nil {
+		return nil
+	}
+	return r.TeamInfos
+}
+
+// leagueL is where Load methods for each relationship are stored.
+type leagueL struct{}
+
+var (
+	leagueAllColumns            = []string{"id", "name", "tournament", "tournament_id", "season_id", "enabled", "created_at", "updated_at"}
+	leagueColumnsWithoutDefault = []string{"id", "name", "tournament", "tournament_id", "season_id"}
+	leagueColumnsWithDefault    = []string{"enabled", "created_at", "updated_at"}
+	leaguePrimaryKeyColumns     = []string{"id"}
+	leagueGeneratedColumns      = []string{}
+)
+
+type (
+	// LeagueSlice is an alias for a slice of pointers to League.
+	// This should almost always be used instead of []League.
+	LeagueSlice []*League
+	// LeagueHook is the signature for custom League hook methods
+	LeagueHook func(context.Context, boil.ContextExecutor, *League) error
+
+	leagueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	leagueType                 = reflect.TypeOf(&League{})
+	leagueMapping              = queries.MakeStructMapping(leagueType)
+	leaguePrimaryKeyMapping, _ = queries.BindMapping(leagueType, leagueMapping, leaguePrimaryKeyColumns)
+	leagueInsertCacheMut       sync.RWMutex
+	leagueInsertCache          = make(map[string]insertCache)
+	leagueUpdateCacheMut       sync.RWMutex
+	leagueUpdateCache          = make(map[string]updateCache)
+	leagueUpsertCacheMut       sync.RWMutex
+	leagueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var leagueAfterSelectHooks []LeagueHook
+
+var leagueBeforeInsertHooks []LeagueHook
+var leagueAfterInsertHooks []LeagueHook
+
+var leagueBeforeUpdateHooks []LeagueHook
+var leagueAfterUpdateHooks []LeagueHook
+
+var leagueBeforeDeleteHooks []LeagueHook
+var leagueAfterDeleteHooks []LeagueHook
+
+var leagueBeforeUpsertHooks []LeagueHook
+var leagueAfterUpsertHooks []LeagueHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *League) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *League) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *League) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *League) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *League) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *League) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *League) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *League) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *League) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range leagueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLeagueHook registers your hook function for all future operations.
+func AddLeagueHook(hookPoint boil.HookPoint, leagueHook LeagueHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		leagueAfterSelectHooks = append(leagueAfterSelectHooks, leagueHook)
+	case boil.BeforeInsertHook:
+		leagueBeforeInsertHooks = append(leagueBeforeInsertHooks, leagueHook)
+	case boil.AfterInsertHook:
+		leagueAfterInsertHooks = append(leagueAfterInsertHooks, leagueHook)
+	case boil.BeforeUpdateHook:
+		leagueBeforeUpdateHooks = append(leagueBeforeUpdateHooks, leagueHook)
+	case boil.AfterUpdateHook:
+		leagueAfterUpdateHooks = append(leagueAfterUpdateHooks, leagueHook)
+	case boil.BeforeDeleteHook:
+		leagueBeforeDeleteHooks = append(leagueBeforeDeleteHooks, leagueHook)
+	case boil.AfterDeleteHook:
+		leagueAfterDeleteHooks = append(leagueAfterDeleteHooks, leagueHook)
+	case boil.BeforeUpsertHook:
+		leagueBeforeUpsertHooks = append(leagueBeforeUpsertHooks, leagueHook)
+	case boil.AfterUpsertHook:
+		leagueAfterUpsertHooks = append(leagueAfterUpsertHooks, leagueHook)
+	}
+}
+
+// OneG returns a single league record from the query using the global executor.
+func (q leagueQuery) OneG(ctx context.Context) (*League, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single league record from the query.
+func (q leagueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*League, error) {
+	o := &League{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for leagues")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all League records from the query using the global executor.
+func (q leagueQuery) AllG(ctx context.Context) (LeagueSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all League records from the query.
+func (q leagueQuery) All(ctx context.Context, exec boil.ContextExecutor) (LeagueSlice, error) {
+	var o []*League
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to League slice")
+	}
+
+	if len(leagueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all League records in the query using the global executor
+func (q leagueQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all League records in the query.
+func (q leagueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count leagues rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q leagueQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q leagueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if leagues exists")
+	}
+
+	return count > 0, nil
+}
+
+// TeamInfos retrieves all the team_info's TeamInfos with an executor.
+func (o *League) TeamInfos(mods ...qm.QueryMod) teamInfoQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"team_info\".\"league_id\"=?", o.ID),
+	)
+
+	return TeamInfos(queryMods...)
+}
+
+// LoadTeamInfos allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (leagueL) LoadTeamInfos(ctx context.Context, e boil.ContextExecutor, singular bool, maybeLeague interface{}, mods queries.Applicator) error {
+	var slice []*League
+	var object *League
+
+	if singular {
+		var ok bool
+		object, ok = maybeLeague.(*League)
+		if !ok {
+			object = new(League)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeLeague)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeLeague))
+			}
+		}
+	} else {
+		s, ok := maybeLeague.(*[]*League)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeLeague)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeLeague))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &leagueR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &leagueR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`team_info`),
+		qm.WhereIn(`team_info.league_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load team_info")
+	}
+
+	var resultSlice []*TeamInfo
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice team_info")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on team_info")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for team_info")
+	}
+
+	if len(teamInfoAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.TeamInfos = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &teamInfoR{}
+			}
+			foreign.R.League = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.LeagueID {
+				local.R.TeamInfos = append(local.R.TeamInfos, foreign)
+				if foreign.R == nil {
+					foreign.R = &teamInfoR{}
+				}
+				foreign.R.League = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddTeamInfosG adds the given related objects to the existing relationships
+// of the league, optionally inserting them as new records.
+// Appends related to o.R.TeamInfos.
+// Sets related.R.League appropriately.
+// Uses the global database handle.
+func (o *League) AddTeamInfosG(ctx context.Context, insert bool, related ...*TeamInfo) error {
+	return o.AddTeamInfos(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddTeamInfos adds the given related objects to the existing relationships
+// of the league, optionally inserting them as new records.
+// Appends related to o.R.TeamInfos.
+// Sets related.R.League appropriately.
+func (o *League) AddTeamInfos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TeamInfo) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.LeagueID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"team_info\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"league_id"}),
+				strmangle.WhereClause("\"", "\"", 2, teamInfoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.LeagueID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &leagueR{
+			TeamInfos: related,
+		}
+	} else {
+		o.R.TeamInfos = append(o.R.TeamInfos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &teamInfoR{
+				League: o,
+			}
+		} else {
+			rel.R.League = o
+		}
+	}
+	return nil
+}
+
+// Leagues retrieves all the records using an executor.
+func Leagues(mods ...qm.QueryMod) leagueQuery {
+	mods = append(mods, qm.From("\"leagues\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"leagues\".*"})
+	}
+
+	return leagueQuery{q}
+}
+
+// FindLeagueG retrieves a single record by ID.
+func FindLeagueG(ctx context.Context, iD string, selectCols ...string) (*League, error) {
+	return FindLeague(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLeague retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLeague(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*League, error) {
+	leagueObj := &League{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"leagues\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, leagueObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from leagues")
+	}
+
+	if err = leagueObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return leagueObj, err
+	}
+
+	return leagueObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *League) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *League) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leagues provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leagueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	leagueInsertCacheMut.RLock()
+	cache, cached := leagueInsertCache[key]
+	leagueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			leagueAllColumns,
+			leagueColumnsWithDefault,
+			leagueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(leagueType, leagueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(leagueType, leagueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"leagues\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"leagues\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into leagues")
+	}
+
+	if !cached {
+		leagueInsertCacheMut.Lock()
+		leagueInsertCache[key] = cache
+		leagueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single League record using the global executor.
+// See Update for more documentation.
+func (o *League) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the League.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *League) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	leagueUpdateCacheMut.RLock()
+	cache, cached := leagueUpdateCache[key]
+	leagueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			leagueAllColumns,
+			leaguePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update leagues, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"leagues\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, leaguePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(leagueType, leagueMapping, append(wl, leaguePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update leagues row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for leagues")
+	}
+
+	if !cached {
+		leagueUpdateCacheMut.Lock()
+		leagueUpdateCache[key] = cache
+		leagueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q leagueQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q leagueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for leagues")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for leagues")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LeagueSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LeagueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaguePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"leagues\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, leaguePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in league slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all league")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *League) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *League) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no leagues provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.CreatedAt.IsZero() {
+			o.CreatedAt = currTime
+		}
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(leagueColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	leagueUpsertCacheMut.RLock()
+	cache, cached := leagueUpsertCache[key]
+	leagueUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			leagueAllColumns,
+			leagueColumnsWithDefault,
+			leagueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			leagueAllColumns,
+			leaguePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert leagues, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(leaguePrimaryKeyColumns))
+			copy(conflict, leaguePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"leagues\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(leagueType, leagueMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(leagueType, leagueMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert leagues")
+	}
+
+	if !cached {
+		leagueUpsertCacheMut.Lock()
+		leagueUpsertCache[key] = cache
+		leagueUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single League record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *League) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single League record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *League) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no League provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), leaguePrimaryKeyMapping)
+	sql := "DELETE FROM \"leagues\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from leagues")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for leagues")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q leagueQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q leagueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no leagueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from leagues")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leagues")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LeagueSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LeagueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(leagueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaguePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"leagues\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, leaguePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from league slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for leagues")
+	}
+
+	if len(leagueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *League) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no League provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *League) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLeague(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LeagueSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LeagueSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LeagueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LeagueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), leaguePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"leagues\".* FROM \"leagues\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, leaguePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LeagueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LeagueExistsG checks if the League row exists.
+func LeagueExistsG(ctx context.Context, iD string) (bool, error) {
+	return LeagueExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LeagueExists checks if the League row exists.
+func LeagueExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"leagues\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if leagues exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the League row exists.
+func (o *League) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LeagueExists(ctx, exec, o.ID)
+}
@@ -0,0 +1,1026 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// LiveGameState is an object representing the database table.
+type LiveGameState struct {
+	GameID     int        `boil:"game_id" json:"game_id" toml:"game_id" yaml:"game_id"`
+	State      string     `boil:"state" json:"state" toml:"state" yaml:"state"`
+	Event      types.JSON `boil:"event" json:"event" toml:"event" yaml:"event"`
+	FinishedAt null.Time  `boil:"finished_at" json:"finished_at,omitempty" toml:"finished_at" yaml:"finished_at,omitempty"`
+	UpdatedAt  time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *liveGameStateR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L liveGameStateL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LiveGameStateColumns = struct {
+	GameID     string
+	State      string
+	Event      string
+	FinishedAt string
+	UpdatedAt  string
+}{
+	GameID:     "game_id",
+	State:      "state",
+	Event:      "event",
+	FinishedAt: "finished_at",
+	UpdatedAt:  "updated_at",
+}
+
+var LiveGameStateTableColumns = struct {
+	GameID     string
+	State      string
+	Event      string
+	FinishedAt string
+	UpdatedAt  string
+}{
+	GameID:     "live_game_states.game_id",
+	State:      "live_game_states.state",
+	Event:      "live_game_states.event",
+	FinishedAt: "live_game_states.finished_at",
+	UpdatedAt:  "live_game_states.updated_at",
+}
+
+// Generated where
+
+var LiveGameStateWhere = struct {
+	GameID     whereHelperint
+	State      whereHelperstring
+	Event      whereHelpertypes_JSON
+	FinishedAt whereHelpernull_Time
+	UpdatedAt  whereHelpertime_Time
+}{
+	GameID:     whereHelperint{field: "\"live_game_states\".\"game_id\""},
+	State:      whereHelperstring{field: "\"live_game_states\".\"state\""},
+	Event:      whereHelpertypes_JSON{field: "\"live_game_states\".\"event\""},
+	FinishedAt: whereHelpernull_Time{field: "\"live_game_states\".\"finished_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"live_game_states\".\"updated_at\""},
+}
+
+// LiveGameStateRels is where relationship names are stored.
+var LiveGameStateRels = struct {
+}{}
+
+// liveGameStateR is where relationships are stored.
+type liveGameStateR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*liveGameStateR) NewStruct() *liveGameStateR {
+	return &liveGameStateR{}
+}
+
+// liveGameStateL is where Load methods for each relationship are stored.
+type liveGameStateL struct{}
+
+var (
+	liveGameStateAllColumns            = []string{"game_id", "state", "event", "finished_at", "updated_at"}
+	liveGameStateColumnsWithoutDefault = []string{"game_id", "state", "event"}
+	liveGameStateColumnsWithDefault    = []string{"finished_at", "updated_at"}
+	liveGameStatePrimaryKeyColumns     = []string{"game_id"}
+	liveGameStateGeneratedColumns      = []string{}
+)
+
+type (
+	// LiveGameStateSlice is an alias for a slice of pointers to LiveGameState.
+	// This should almost always be used instead of []LiveGameState.
+	LiveGameStateSlice []*LiveGameState
+	// LiveGameStateHook is the signature for custom LiveGameState hook methods
+	LiveGameStateHook func(context.Context, boil.ContextExecutor, *LiveGameState) error
+
+	liveGameStateQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	liveGameStateType                 = reflect.TypeOf(&LiveGameState{})
+	liveGameStateMapping              = queries.MakeStructMapping(liveGameStateType)
+	liveGameStatePrimaryKeyMapping, _ = queries.BindMapping(liveGameStateType, liveGameStateMapping, liveGameStatePrimaryKeyColumns)
+	liveGameStateInsertCacheMut       sync.RWMutex
+	liveGameStateInsertCache          = make(map[string]insertCache)
+	liveGameStateUpdateCacheMut       sync.RWMutex
+	liveGameStateUpdateCache          = make(map[string]updateCache)
+	liveGameStateUpsertCacheMut       sync.RWMutex
+	liveGameStateUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var liveGameStateAfterSelectHooks []LiveGameStateHook
+
+var liveGameStateBeforeInsertHooks []LiveGameStateHook
+var liveGameStateAfterInsertHooks []LiveGameStateHook
+
+var liveGameStateBeforeUpdateHooks []LiveGameStateHook
+var liveGameStateAfterUpdateHooks []LiveGameStateHook
+
+var liveGameStateBeforeDeleteHooks []LiveGameStateHook
+var liveGameStateAfterDeleteHooks []LiveGameStateHook
+
+var liveGameStateBeforeUpsertHooks []LiveGameStateHook
+var liveGameStateAfterUpsertHooks []LiveGameStateHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LiveGameState) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LiveGameState) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LiveGameState) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LiveGameState) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LiveGameState) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LiveGameState) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LiveGameState) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LiveGameState) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LiveGameState) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveGameStateAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLiveGameStateHook registers your hook function for all future operations.
+func AddLiveGameStateHook(hookPoint boil.HookPoint, liveGameStateHook LiveGameStateHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		liveGameStateAfterSelectHooks = append(liveGameStateAfterSelectHooks, liveGameStateHook)
+	case boil.BeforeInsertHook:
+		liveGameStateBeforeInsertHooks = append(liveGameStateBeforeInsertHooks, liveGameStateHook)
+	case boil.AfterInsertHook:
+		liveGameStateAfterInsertHooks = append(liveGameStateAfterInsertHooks, liveGameStateHook)
+	case boil.BeforeUpdateHook:
+		liveGameStateBeforeUpdateHooks = append(liveGameStateBeforeUpdateHooks, liveGameStateHook)
+	case boil.AfterUpdateHook:
+		liveGameStateAfterUpdateHooks = append(liveGameStateAfterUpdateHooks, liveGameStateHook)
+	case boil.BeforeDeleteHook:
+		liveGameStateBeforeDeleteHooks = append(liveGameStateBeforeDeleteHooks, liveGameStateHook)
+	case boil.AfterDeleteHook:
+		liveGameStateAfterDeleteHooks = append(liveGameStateAfterDeleteHooks, liveGameStateHook)
+	case boil.BeforeUpsertHook:
+		liveGameStateBeforeUpsertHooks = append(liveGameStateBeforeUpsertHooks, liveGameStateHook)
+	case boil.AfterUpsertHook:
+		liveGameStateAfterUpsertHooks = append(liveGameStateAfterUpsertHooks, liveGameStateHook)
+	}
+}
+
+// OneG returns a single liveGameState record from the query using the global executor.
+func (q liveGameStateQuery) OneG(ctx context.Context) (*LiveGameState, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single liveGameState record from the query.
+func (q liveGameStateQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LiveGameState, error) {
+	o := &LiveGameState{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for live_game_states")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LiveGameState records from the query using the global executor.
+func (q liveGameStateQuery) AllG(ctx context.Context) (LiveGameStateSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LiveGameState records from the query.
+func (q liveGameStateQuery) All(ctx context.Context, exec boil.ContextExecutor) (LiveGameStateSlice, error) {
+	var o []*LiveGameState
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LiveGameState slice")
+	}
+
+	if len(liveGameStateAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LiveGameState records in the query using the global executor
+func (q liveGameStateQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LiveGameState records in the query.
+func (q liveGameStateQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count live_game_states rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q liveGameStateQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q liveGameStateQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if live_game_states exists")
+	}
+
+	return count > 0, nil
+}
+
+// LiveGameStates retrieves all the records using an executor.
+func LiveGameStates(mods ...qm.QueryMod) liveGameStateQuery {
+	mods = append(mods, qm.From("\"live_game_states\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"live_game_states\".*"})
+	}
+
+	return liveGameStateQuery{q}
+}
+
+// FindLiveGameStateG retrieves a single record by ID.
+func FindLiveGameStateG(ctx context.Context, gameID int, selectCols ...string) (*LiveGameState, error) {
+	return FindLiveGameState(ctx, boil.GetContextDB(), gameID, selectCols...)
+}
+
+// FindLiveGameState retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLiveGameState(ctx context.Context, exec boil.ContextExecutor, gameID int, selectCols ...string) (*LiveGameState, error) {
+	liveGameStateObj := &LiveGameState{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"live_game_states\" where \"game_id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, gameID)
+
+	err := q.Bind(ctx, exec, liveGameStateObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from live_game_states")
+	}
+
+	if err = liveGameStateObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return liveGameStateObj, err
+	}
+
+	return liveGameStateObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LiveGameState) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LiveGameState) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_game_states provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveGameStateColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	liveGameStateInsertCacheMut.RLock()
+	cache, cached := liveGameStateInsertCache[key]
+	liveGameStateInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			liveGameStateAllColumns,
+			liveGameStateColumnsWithDefault,
+			liveGameStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(liveGameStateType, liveGameStateMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(liveGameStateType, liveGameStateMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"live_game_states\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"live_game_states\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into live_game_states")
+	}
+
+	if !cached {
+		liveGameStateInsertCacheMut.Lock()
+		liveGameStateInsertCache[key] = cache
+		liveGameStateInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single LiveGameState record using the global executor.
+// See Update for more documentation.
+func (o *LiveGameState) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LiveGameState.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LiveGameState) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	liveGameStateUpdateCacheMut.RLock()
+	cache, cached := liveGameStateUpdateCache[key]
+	liveGameStateUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			liveGameStateAllColumns,
+			liveGameStatePrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update live_game_states, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"live_game_states\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, liveGameStatePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(liveGameStateType, liveGameStateMapping, append(wl, liveGameStatePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update live_game_states row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for live_game_states")
+	}
+
+	if !cached {
+		liveGameStateUpdateCacheMut.Lock()
+		liveGameStateUpdateCache[key] = cache
+		liveGameStateUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q liveGameStateQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q liveGameStateQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for live_game_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for live_game_states")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LiveGameStateSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LiveGameStateSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveGameStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"live_game_states\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, liveGameStatePrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in liveGameState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all liveGameState")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LiveGameState) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LiveGameState) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_game_states provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveGameStateColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	liveGameStateUpsertCacheMut.RLock()
+	cache, cached := liveGameStateUpsertCache[key]
+	liveGameStateUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			liveGameStateAllColumns,
+			liveGameStateColumnsWithDefault,
+			liveGameStateColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			liveGameStateAllColumns,
+			liveGameStatePrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert live_game_states, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(liveGameStatePrimaryKeyColumns))
+			copy(conflict, liveGameStatePrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"live_game_states\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(liveGameStateType, liveGameStateMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(liveGameStateType, liveGameStateMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert live_game_states")
+	}
+
+	if !cached {
+		liveGameStateUpsertCacheMut.Lock()
+		liveGameStateUpsertCache[key] = cache
+		liveGameStateUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single LiveGameState record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LiveGameState) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LiveGameState record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LiveGameState) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LiveGameState provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), liveGameStatePrimaryKeyMapping)
+	sql := "DELETE FROM \"live_game_states\" WHERE \"game_id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from live_game_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for live_game_states")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q liveGameStateQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q liveGameStateQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no liveGameStateQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from live_game_states")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_game_states")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LiveGameStateSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LiveGameStateSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(liveGameStateBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveGameStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"live_game_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveGameStatePrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from liveGameState slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_game_states")
+	}
+
+	if len(liveGameStateAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LiveGameState) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no LiveGameState provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LiveGameState) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLiveGameState(ctx, exec, o.GameID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveGameStateSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LiveGameStateSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveGameStateSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LiveGameStateSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveGameStatePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"live_game_states\".* FROM \"live_game_states\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveGameStatePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LiveGameStateSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LiveGameStateExistsG checks if the LiveGameState row exists.
+func LiveGameStateExistsG(ctx context.Context, gameID int) (bool, error) {
+	return LiveGameStateExists(ctx, boil.GetContextDB(), gameID)
+}
+
+// LiveGameStateExists checks if the LiveGameState row exists.
+func LiveGameStateExists(ctx context.Context, exec boil.ContextExecutor, gameID int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"live_game_states\" where \"game_id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, gameID)
+	}
+	row := exec.QueryRowContext(ctx, sql, gameID)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if live_game_states exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LiveGameState row exists.
+func (o *LiveGameState) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LiveGameStateExists(ctx, exec, o.GameID)
+}
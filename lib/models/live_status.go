@@ -0,0 +1,1011 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"github.com/volatiletech/strmangle"
+)
+
+// LiveStatus is an object representing the database table.
+type LiveStatus struct {
+	ID        int        `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Status    types.JSON `boil:"status" json:"status" toml:"status" yaml:"status"`
+	UpdatedAt time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *liveStatusR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L liveStatusL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var LiveStatusColumns = struct {
+	ID        string
+	Status    string
+	UpdatedAt string
+}{
+	ID:        "id",
+	Status:    "status",
+	UpdatedAt: "updated_at",
+}
+
+var LiveStatusTableColumns = struct {
+	ID        string
+	Status    string
+	UpdatedAt string
+}{
+	ID:        "live_status.id",
+	Status:    "live_status.status",
+	UpdatedAt: "live_status.updated_at",
+}
+
+// Generated where
+
+var LiveStatusWhere = struct {
+	ID        whereHelperint
+	Status    whereHelpertypes_JSON
+	UpdatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint{field: "\"live_status\".\"id\""},
+	Status:    whereHelpertypes_JSON{field: "\"live_status\".\"status\""},
+	UpdatedAt: whereHelpertime_Time{field: "\"live_status\".\"updated_at\""},
+}
+
+// LiveStatusRels is where relationship names are stored.
+var LiveStatusRels = struct {
+}{}
+
+// liveStatusR is where relationships are stored.
+type liveStatusR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*liveStatusR) NewStruct() *liveStatusR {
+	return &liveStatusR{}
+}
+
+// liveStatusL is where Load methods for each relationship are stored.
+type liveStatusL struct{}
+
+var (
+	liveStatusAllColumns            = []string{"id", "status", "updated_at"}
+	liveStatusColumnsWithoutDefault = []string{"status"}
+	liveStatusColumnsWithDefault    = []string{"id", "updated_at"}
+	liveStatusPrimaryKeyColumns     = []string{"id"}
+	liveStatusGeneratedColumns      = []string{}
+)
+
+type (
+	// LiveStatusSlice is an alias for a slice of pointers to LiveStatus.
+	// This should almost always be used instead of []LiveStatus.
+	LiveStatusSlice []*LiveStatus
+	// LiveStatusHook is the signature for custom LiveStatus hook methods
+	LiveStatusHook func(context.Context, boil.ContextExecutor, *LiveStatus) error
+
+	liveStatusQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	liveStatusType                 = reflect.TypeOf(&LiveStatus{})
+	liveStatusMapping              = queries.MakeStructMapping(liveStatusType)
+	liveStatusPrimaryKeyMapping, _ = queries.BindMapping(liveStatusType, liveStatusMapping, liveStatusPrimaryKeyColumns)
+	liveStatusInsertCacheMut       sync.RWMutex
+	liveStatusInsertCache          = make(map[string]insertCache)
+	liveStatusUpdateCacheMut       sync.RWMutex
+	liveStatusUpdateCache          = make(map[string]updateCache)
+	liveStatusUpsertCacheMut       sync.RWMutex
+	liveStatusUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var liveStatusAfterSelectHooks []LiveStatusHook
+
+var liveStatusBeforeInsertHooks []LiveStatusHook
+var liveStatusAfterInsertHooks []LiveStatusHook
+
+var liveStatusBeforeUpdateHooks []LiveStatusHook
+var liveStatusAfterUpdateHooks []LiveStatusHook
+
+var liveStatusBeforeDeleteHooks []LiveStatusHook
+var liveStatusAfterDeleteHooks []LiveStatusHook
+
+var liveStatusBeforeUpsertHooks []LiveStatusHook
+var liveStatusAfterUpsertHooks []LiveStatusHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *LiveStatus) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *LiveStatus) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *LiveStatus) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *LiveStatus) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *LiveStatus) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *LiveStatus) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *LiveStatus) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *LiveStatus) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *LiveStatus) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range liveStatusAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddLiveStatusHook registers your hook function for all future operations.
+func AddLiveStatusHook(hookPoint boil.HookPoint, liveStatusHook LiveStatusHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		liveStatusAfterSelectHooks = append(liveStatusAfterSelectHooks, liveStatusHook)
+	case boil.BeforeInsertHook:
+		liveStatusBeforeInsertHooks = append(liveStatusBeforeInsertHooks, liveStatusHook)
+	case boil.AfterInsertHook:
+		liveStatusAfterInsertHooks = append(liveStatusAfterInsertHooks, liveStatusHook)
+	case boil.BeforeUpdateHook:
+		liveStatusBeforeUpdateHooks = append(liveStatusBeforeUpdateHooks, liveStatusHook)
+	case boil.AfterUpdateHook:
+		liveStatusAfterUpdateHooks = append(liveStatusAfterUpdateHooks, liveStatusHook)
+	case boil.BeforeDeleteHook:
+		liveStatusBeforeDeleteHooks = append(liveStatusBeforeDeleteHooks, liveStatusHook)
+	case boil.AfterDeleteHook:
+		liveStatusAfterDeleteHooks = append(liveStatusAfterDeleteHooks, liveStatusHook)
+	case boil.BeforeUpsertHook:
+		liveStatusBeforeUpsertHooks = append(liveStatusBeforeUpsertHooks, liveStatusHook)
+	case boil.AfterUpsertHook:
+		liveStatusAfterUpsertHooks = append(liveStatusAfterUpsertHooks, liveStatusHook)
+	}
+}
+
+// OneG returns a single liveStatus record from the query using the global executor.
+func (q liveStatusQuery) OneG(ctx context.Context) (*LiveStatus, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single liveStatus record from the query.
+func (q liveStatusQuery) One(ctx context.Context, exec boil.ContextExecutor) (*LiveStatus, error) {
+	o := &LiveStatus{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for live_status")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all LiveStatus records from the query using the global executor.
+func (q liveStatusQuery) AllG(ctx context.Context) (LiveStatusSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all LiveStatus records from the query.
+func (q liveStatusQuery) All(ctx context.Context, exec boil.ContextExecutor) (LiveStatusSlice, error) {
+	var o []*LiveStatus
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to LiveStatus slice")
+	}
+
+	if len(liveStatusAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all LiveStatus records in the query using the global executor
+func (q liveStatusQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all LiveStatus records in the query.
+func (q liveStatusQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count live_status rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q liveStatusQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q liveStatusQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if live_status exists")
+	}
+
+	return count > 0, nil
+}
+
+// LiveStatuses retrieves all the records using an executor.
+func LiveStatuses(mods ...qm.QueryMod) liveStatusQuery {
+	mods = append(mods, qm.From("\"live_status\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"live_status\".*"})
+	}
+
+	return liveStatusQuery{q}
+}
+
+// FindLiveStatusG retrieves a single record by ID.
+func FindLiveStatusG(ctx context.Context, iD int, selectCols ...string) (*LiveStatus, error) {
+	return FindLiveStatus(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindLiveStatus retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindLiveStatus(ctx context.Context, exec boil.ContextExecutor, iD int, selectCols ...string) (*LiveStatus, error) {
+	liveStatusObj := &LiveStatus{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"live_status\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, liveStatusObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from live_status")
+	}
+
+	if err = liveStatusObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return liveStatusObj, err
+	}
+
+	return liveStatusObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *LiveStatus) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *LiveStatus) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_status provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveStatusColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	liveStatusInsertCacheMut.RLock()
+	cache, cached := liveStatusInsertCache[key]
+	liveStatusInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			liveStatusAllColumns,
+			liveStatusColumnsWithDefault,
+			liveStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(liveStatusType, liveStatusMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(liveStatusType, liveStatusMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"live_status\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"live_status\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into live_status")
+	}
+
+	if !cached {
+		liveStatusInsertCacheMut.Lock()
+		liveStatusInsertCache[key] = cache
+		liveStatusInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single LiveStatus record using the global executor.
+// See Update for more documentation.
+func (o *LiveStatus) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the LiveStatus.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *LiveStatus) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	liveStatusUpdateCacheMut.RLock()
+	cache, cached := liveStatusUpdateCache[key]
+	liveStatusUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			liveStatusAllColumns,
+			liveStatusPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update live_status, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"live_status\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, liveStatusPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(liveStatusType, liveStatusMapping, append(wl, liveStatusPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update live_status row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for live_status")
+	}
+
+	if !cached {
+		liveStatusUpdateCacheMut.Lock()
+		liveStatusUpdateCache[key] = cache
+		liveStatusUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q liveStatusQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q liveStatusQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for live_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for live_status")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o LiveStatusSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o LiveStatusSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"live_status\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, liveStatusPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in liveStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all liveStatus")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *LiveStatus) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *LiveStatus) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no live_status provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(liveStatusColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	liveStatusUpsertCacheMut.RLock()
+	cache, cached := liveStatusUpsertCache[key]
+	liveStatusUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			liveStatusAllColumns,
+			liveStatusColumnsWithDefault,
+			liveStatusColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			liveStatusAllColumns,
+			liveStatusPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert live_status, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(liveStatusPrimaryKeyColumns))
+			copy(conflict, liveStatusPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"live_status\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(liveStatusType, liveStatusMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(liveStatusType, liveStatusMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert live_status")
+	}
+
+	if !cached {
+		liveStatusUpsertCacheMut.Lock()
+		liveStatusUpsertCache[key] = cache
+		liveStatusUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single LiveStatus record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *LiveStatus) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single LiveStatus record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *LiveStatus) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no LiveStatus provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), liveStatusPrimaryKeyMapping)
+	sql := "DELETE FROM \"live_status\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from live_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for live_status")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q liveStatusQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q liveStatusQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no liveStatusQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from live_status")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_status")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o LiveStatusSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o LiveStatusSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(liveStatusBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"live_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveStatusPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from liveStatus slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for live_status")
+	}
+
+	if len(liveStatusAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *LiveStatus) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no LiveStatus provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *LiveStatus) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindLiveStatus(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveStatusSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty LiveStatusSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *LiveStatusSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := LiveStatusSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), liveStatusPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"live_status\".* FROM \"live_status\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, liveStatusPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in LiveStatusSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// LiveStatusExistsG checks if the LiveStatus row exists.
+func LiveStatusExistsG(ctx context.Context, iD int) (bool, error) {
+	return LiveStatusExists(ctx, boil.GetContextDB(), iD)
+}
+
+// LiveStatusExists checks if the LiveStatus row exists.
+func LiveStatusExists(ctx context.Context, exec boil.ContextExecutor, iD int) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"live_status\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if live_status exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the LiveStatus row exists.
+func (o *LiveStatus) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return LiveStatusExists(ctx, exec, o.ID)
+}
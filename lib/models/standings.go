@@ -0,0 +1,1110 @@
+// Code generated by SQLBoiler 4.15.0 (https://github.com/volatiletech/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package models
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/strmangle"
+)
+
+// Standing is an object representing the database table.
+type Standing struct {
+	ID            string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	TournamentID  int         `boil:"tournament_id" json:"tournament_id" toml:"tournament_id" yaml:"tournament_id"`
+	SeasonID      int         `boil:"season_id" json:"season_id" toml:"season_id" yaml:"season_id"`
+	TeamID        int         `boil:"team_id" json:"team_id" toml:"team_id" yaml:"team_id"`
+	Conference    string      `boil:"conference" json:"conference" toml:"conference" yaml:"conference"`
+	Division      null.String `boil:"division" json:"division,omitempty" toml:"division" yaml:"division,omitempty"`
+	Position      int         `boil:"position" json:"position" toml:"position" yaml:"position"`
+	Wins          int         `boil:"wins" json:"wins" toml:"wins" yaml:"wins"`
+	Losses        int         `boil:"losses" json:"losses" toml:"losses" yaml:"losses"`
+	WinPercentage float64     `boil:"win_percentage" json:"win_percentage" toml:"win_percentage" yaml:"win_percentage"`
+	GamesBack     float64     `boil:"games_back" json:"games_back" toml:"games_back" yaml:"games_back"`
+	Streak        string      `boil:"streak" json:"streak" toml:"streak" yaml:"streak"`
+	UpdatedAt     time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+
+	R *standingR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L standingL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var StandingColumns = struct {
+	ID            string
+	TournamentID  string
+	SeasonID      string
+	TeamID        string
+	Conference    string
+	Division      string
+	Position      string
+	Wins          string
+	Losses        string
+	WinPercentage string
+	GamesBack     string
+	Streak        string
+	UpdatedAt     string
+}{
+	ID:            "id",
+	TournamentID:  "tournament_id",
+	SeasonID:      "season_id",
+	TeamID:        "team_id",
+	Conference:    "conference",
+	Division:      "division",
+	Position:      "position",
+	Wins:          "wins",
+	Losses:        "losses",
+	WinPercentage: "win_percentage",
+	GamesBack:     "games_back",
+	Streak:        "streak",
+	UpdatedAt:     "updated_at",
+}
+
+var StandingTableColumns = struct {
+	ID            string
+	TournamentID  string
+	SeasonID      string
+	TeamID        string
+	Conference    string
+	Division      string
+	Position      string
+	Wins          string
+	Losses        string
+	WinPercentage string
+	GamesBack     string
+	Streak        string
+	UpdatedAt     string
+}{
+	ID:            "standings.id",
+	TournamentID:  "standings.tournament_id",
+	SeasonID:      "standings.season_id",
+	TeamID:        "standings.team_id",
+	Conference:    "standings.conference",
+	Division:      "standings.division",
+	Position:      "standings.position",
+	Wins:          "standings.wins",
+	Losses:        "standings.losses",
+	WinPercentage: "standings.win_percentage",
+	GamesBack:     "standings.games_back",
+	Streak:        "standings.streak",
+	UpdatedAt:     "standings.updated_at",
+}
+
+// Generated where
+
+type whereHelperfloat64 struct{ field string }
+
+func (w whereHelperfloat64) EQ(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperfloat64) NEQ(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelperfloat64) LT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperfloat64) LTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelperfloat64) GT(x float64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperfloat64) GTE(x float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelperfloat64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+var StandingWhere = struct {
+	ID            whereHelperstring
+	TournamentID  whereHelperint
+	SeasonID      whereHelperint
+	TeamID        whereHelperint
+	Conference    whereHelperstring
+	Division      whereHelpernull_String
+	Position      whereHelperint
+	Wins          whereHelperint
+	Losses        whereHelperint
+	WinPercentage whereHelperfloat64
+	GamesBack     whereHelperfloat64
+	Streak        whereHelperstring
+	UpdatedAt     whereHelpertime_Time
+}{
+	ID:            whereHelperstring{field: "\"standings\".\"id\""},
+	TournamentID:  whereHelperint{field: "\"standings\".\"tournament_id\""},
+	SeasonID:      whereHelperint{field: "\"standings\".\"season_id\""},
+	TeamID:        whereHelperint{field: "\"standings\".\"team_id\""},
+	Conference:    whereHelperstring{field: "\"standings\".\"conference\""},
+	Division:      whereHelpernull_String{field: "\"standings\".\"division\""},
+	Position:      whereHelperint{field: "\"standings\".\"position\""},
+	Wins:          whereHelperint{field: "\"standings\".\"wins\""},
+	Losses:        whereHelperint{field: "\"standings\".\"losses\""},
+	WinPercentage: whereHelperfloat64{field: "\"standings\".\"win_percentage\""},
+	GamesBack:     whereHelperfloat64{field: "\"standings\".\"games_back\""},
+	Streak:        whereHelperstring{field: "\"standings\".\"streak\""},
+	UpdatedAt:     whereHelpertime_Time{field: "\"standings\".\"updated_at\""},
+}
+
+// StandingRels is where relationship names are stored.
+var StandingRels = struct {
+}{}
+
+// standingR is where relationships are stored.
+type standingR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*standingR) NewStruct() *standingR {
+	return &standingR{}
+}
+
+// standingL is where Load methods for each relationship are stored.
+type standingL struct{}
+
+var (
+	standingAllColumns            = []string{"id", "tournament_id", "season_id", "team_id", "conference", "division", "position", "wins", "losses", "win_percentage", "games_back", "streak", "updated_at"}
+	standingColumnsWithoutDefault = []string{"tournament_id", "season_id", "team_id", "conference", "position", "wins", "losses", "win_percentage", "games_back"}
+	standingColumnsWithDefault    = []string{"id", "division", "streak", "updated_at"}
+	standingPrimaryKeyColumns     = []string{"id"}
+	standingGeneratedColumns      = []string{}
+)
+
+type (
+	// StandingSlice is an alias for a slice of pointers to Standing.
+	// This should almost always be used instead of []Standing.
+	StandingSlice []*Standing
+	// StandingHook is the signature for custom Standing hook methods
+	StandingHook func(context.Context, boil.ContextExecutor, *Standing) error
+
+	standingQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	standingType                 = reflect.TypeOf(&Standing{})
+	standingMapping              = queries.MakeStructMapping(standingType)
+	standingPrimaryKeyMapping, _ = queries.BindMapping(standingType, standingMapping, standingPrimaryKeyColumns)
+	standingInsertCacheMut       sync.RWMutex
+	standingInsertCache          = make(map[string]insertCache)
+	standingUpdateCacheMut       sync.RWMutex
+	standingUpdateCache          = make(map[string]updateCache)
+	standingUpsertCacheMut       sync.RWMutex
+	standingUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var standingAfterSelectHooks []StandingHook
+
+var standingBeforeInsertHooks []StandingHook
+var standingAfterInsertHooks []StandingHook
+
+var standingBeforeUpdateHooks []StandingHook
+var standingAfterUpdateHooks []StandingHook
+
+var standingBeforeDeleteHooks []StandingHook
+var standingAfterDeleteHooks []StandingHook
+
+var standingBeforeUpsertHooks []StandingHook
+var standingAfterUpsertHooks []StandingHook
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *Standing) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *Standing) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *Standing) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *Standing) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *Standing) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *Standing) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *Standing) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *Standing) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *Standing) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range standingAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddStandingHook registers your hook function for all future operations.
+func AddStandingHook(hookPoint boil.HookPoint, standingHook StandingHook) {
+	switch hookPoint {
+	case boil.AfterSelectHook:
+		standingAfterSelectHooks = append(standingAfterSelectHooks, standingHook)
+	case boil.BeforeInsertHook:
+		standingBeforeInsertHooks = append(standingBeforeInsertHooks, standingHook)
+	case boil.AfterInsertHook:
+		standingAfterInsertHooks = append(standingAfterInsertHooks, standingHook)
+	case boil.BeforeUpdateHook:
+		standingBeforeUpdateHooks = append(standingBeforeUpdateHooks, standingHook)
+	case boil.AfterUpdateHook:
+		standingAfterUpdateHooks = append(standingAfterUpdateHooks, standingHook)
+	case boil.BeforeDeleteHook:
+		standingBeforeDeleteHooks = append(standingBeforeDeleteHooks, standingHook)
+	case boil.AfterDeleteHook:
+		standingAfterDeleteHooks = append(standingAfterDeleteHooks, standingHook)
+	case boil.BeforeUpsertHook:
+		standingBeforeUpsertHooks = append(standingBeforeUpsertHooks, standingHook)
+	case boil.AfterUpsertHook:
+		standingAfterUpsertHooks = append(standingAfterUpsertHooks, standingHook)
+	}
+}
+
+// OneG returns a single standing record from the query using the global executor.
+func (q standingQuery) OneG(ctx context.Context) (*Standing, error) {
+	return q.One(ctx, boil.GetContextDB())
+}
+
+// One returns a single standing record from the query.
+func (q standingQuery) One(ctx context.Context, exec boil.ContextExecutor) (*Standing, error) {
+	o := &Standing{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: failed to execute a one query for standings")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// AllG returns all Standing records from the query using the global executor.
+func (q standingQuery) AllG(ctx context.Context) (StandingSlice, error) {
+	return q.All(ctx, boil.GetContextDB())
+}
+
+// All returns all Standing records from the query.
+func (q standingQuery) All(ctx context.Context, exec boil.ContextExecutor) (StandingSlice, error) {
+	var o []*Standing
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "models: failed to assign all query results to Standing slice")
+	}
+
+	if len(standingAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// CountG returns the count of all Standing records in the query using the global executor
+func (q standingQuery) CountG(ctx context.Context) (int64, error) {
+	return q.Count(ctx, boil.GetContextDB())
+}
+
+// Count returns the count of all Standing records in the query.
+func (q standingQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to count standings rows")
+	}
+
+	return count, nil
+}
+
+// ExistsG checks if the row exists in the table using the global executor.
+func (q standingQuery) ExistsG(ctx context.Context) (bool, error) {
+	return q.Exists(ctx, boil.GetContextDB())
+}
+
+// Exists checks if the row exists in the table.
+func (q standingQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "models: failed to check if standings exists")
+	}
+
+	return count > 0, nil
+}
+
+// Standings retrieves all the records using an executor.
+func Standings(mods ...qm.QueryMod) standingQuery {
+	mods = append(mods, qm.From("\"standings\""))
+	q := NewQuery(mods...)
+	if len(queries.GetSelect(q)) == 0 {
+		queries.SetSelect(q, []string{"\"standings\".*"})
+	}
+
+	return standingQuery{q}
+}
+
+// FindStandingG retrieves a single record by ID.
+func FindStandingG(ctx context.Context, iD string, selectCols ...string) (*Standing, error) {
+	return FindStanding(ctx, boil.GetContextDB(), iD, selectCols...)
+}
+
+// FindStanding retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindStanding(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*Standing, error) {
+	standingObj := &Standing{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"standings\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, standingObj)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "models: unable to select from standings")
+	}
+
+	if err = standingObj.doAfterSelectHooks(ctx, exec); err != nil {
+		return standingObj, err
+	}
+
+	return standingObj, nil
+}
+
+// InsertG a single record. See Insert for whitelist behavior description.
+func (o *Standing) InsertG(ctx context.Context, columns boil.Columns) error {
+	return o.Insert(ctx, boil.GetContextDB(), columns)
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *Standing) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no standings provided for insertion")
+	}
+
+	var err error
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		if o.UpdatedAt.IsZero() {
+			o.UpdatedAt = currTime
+		}
+	}
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(standingColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	standingInsertCacheMut.RLock()
+	cache, cached := standingInsertCache[key]
+	standingInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			standingAllColumns,
+			standingColumnsWithDefault,
+			standingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(standingType, standingMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(standingType, standingMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"standings\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"standings\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "models: unable to insert into standings")
+	}
+
+	if !cached {
+		standingInsertCacheMut.Lock()
+		standingInsertCache[key] = cache
+		standingInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// UpdateG a single Standing record using the global executor.
+// See Update for more documentation.
+func (o *Standing) UpdateG(ctx context.Context, columns boil.Columns) (int64, error) {
+	return o.Update(ctx, boil.GetContextDB(), columns)
+}
+
+// Update uses an executor to update the Standing.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *Standing) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	standingUpdateCacheMut.RLock()
+	cache, cached := standingUpdateCache[key]
+	standingUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			standingAllColumns,
+			standingPrimaryKeyColumns,
+		)
+
+		if !columns.IsWhitelist() {
+			wl = strmangle.SetComplement(wl, []string{"created_at"})
+		}
+		if len(wl) == 0 {
+			return 0, errors.New("models: unable to update standings, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"standings\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, standingPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(standingType, standingMapping, append(wl, standingPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, values)
+	}
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update standings row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by update for standings")
+	}
+
+	if !cached {
+		standingUpdateCacheMut.Lock()
+		standingUpdateCache[key] = cache
+		standingUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (q standingQuery) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return q.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q standingQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all for standings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected for standings")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAllG updates all rows with the specified column values.
+func (o StandingSlice) UpdateAllG(ctx context.Context, cols M) (int64, error) {
+	return o.UpdateAll(ctx, boil.GetContextDB(), cols)
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o StandingSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("models: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), standingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"standings\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, standingPrimaryKeyColumns, len(o)))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to update all in standing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to retrieve rows affected all in update all standing")
+	}
+	return rowsAff, nil
+}
+
+// UpsertG attempts an insert, and does an update or ignore on conflict.
+func (o *Standing) UpsertG(ctx context.Context, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	return o.Upsert(ctx, boil.GetContextDB(), updateOnConflict, conflictColumns, updateColumns, insertColumns)
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *Standing) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("models: no standings provided for upsert")
+	}
+	if !boil.TimestampsAreSkipped(ctx) {
+		currTime := time.Now().In(boil.GetLocation())
+
+		o.UpdatedAt = currTime
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(standingColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	standingUpsertCacheMut.RLock()
+	cache, cached := standingUpsertCache[key]
+	standingUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			standingAllColumns,
+			standingColumnsWithDefault,
+			standingColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		update := updateColumns.UpdateColumnSet(
+			standingAllColumns,
+			standingPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("models: unable to upsert standings, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(standingPrimaryKeyColumns))
+			copy(conflict, standingPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"standings\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(standingType, standingMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(standingType, standingMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, cache.query)
+		fmt.Fprintln(writer, vals)
+	}
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if errors.Is(err, sql.ErrNoRows) {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "models: unable to upsert standings")
+	}
+
+	if !cached {
+		standingUpsertCacheMut.Lock()
+		standingUpsertCache[key] = cache
+		standingUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// DeleteG deletes a single Standing record.
+// DeleteG will match against the primary key column to find the record to delete.
+func (o *Standing) DeleteG(ctx context.Context) (int64, error) {
+	return o.Delete(ctx, boil.GetContextDB())
+}
+
+// Delete deletes a single Standing record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *Standing) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("models: no Standing provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), standingPrimaryKeyMapping)
+	sql := "DELETE FROM \"standings\" WHERE \"id\"=$1"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args...)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete from standings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by delete for standings")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+func (q standingQuery) DeleteAllG(ctx context.Context) (int64, error) {
+	return q.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all matching rows.
+func (q standingQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("models: no standingQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from standings")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for standings")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAllG deletes all rows in the slice.
+func (o StandingSlice) DeleteAllG(ctx context.Context) (int64, error) {
+	return o.DeleteAll(ctx, boil.GetContextDB())
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o StandingSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(standingBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), standingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"standings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, standingPrimaryKeyColumns, len(o))
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, args)
+	}
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "models: unable to delete all from standing slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "models: failed to get rows affected by deleteall for standings")
+	}
+
+	if len(standingAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// ReloadG refetches the object from the database using the primary keys.
+func (o *Standing) ReloadG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: no Standing provided for reload")
+	}
+
+	return o.Reload(ctx, boil.GetContextDB())
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *Standing) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindStanding(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAllG refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StandingSlice) ReloadAllG(ctx context.Context) error {
+	if o == nil {
+		return errors.New("models: empty StandingSlice provided for reload all")
+	}
+
+	return o.ReloadAll(ctx, boil.GetContextDB())
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *StandingSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := StandingSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), standingPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"standings\".* FROM \"standings\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, standingPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "models: unable to reload all in StandingSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// StandingExistsG checks if the Standing row exists.
+func StandingExistsG(ctx context.Context, iD string) (bool, error) {
+	return StandingExists(ctx, boil.GetContextDB(), iD)
+}
+
+// StandingExists checks if the Standing row exists.
+func StandingExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"standings\" where \"id\"=$1 limit 1)"
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, sql)
+		fmt.Fprintln(writer, iD)
+	}
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "models: unable to check if standings exists")
+	}
+
+	return exists, nil
+}
+
+// Exists checks if the Standing row exists.
+func (o *Standing) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	return StandingExists(ctx, exec, o.ID)
+}
@@ -34,6 +34,7 @@ type TeamInfo struct {
 	Color          string      `boil:"color" json:"color" toml:"color" yaml:"color"`
 	SecondaryColor string      `boil:"secondary_color" json:"secondary_color" toml:"secondary_color" yaml:"secondary_color"`
 	Logo           null.String `boil:"logo" json:"logo,omitempty" toml:"logo" yaml:"logo,omitempty"`
+	LeagueID       string      `boil:"league_id" json:"league_id" toml:"league_id" yaml:"league_id"`
 
 	R *teamInfoR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L teamInfoL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -50,6 +51,7 @@ var TeamInfoColumns = struct {
 	Color          string
 	SecondaryColor string
 	Logo           string
+	LeagueID       string
 }{
 	ID:             "id",
 	Name:           "name",
@@ -61,6 +63,7 @@ var TeamInfoColumns = struct {
 	Color:          "color",
 	SecondaryColor: "secondary_color",
 	Logo:           "logo",
+	LeagueID:       "league_id",
 }
 
 var TeamInfoTableColumns = struct {
@@ -74,6 +77,7 @@ var TeamInfoTableColumns = struct {
 	Color          string
 	SecondaryColor string
 	Logo           string
+	LeagueID       string
 }{
 	ID:             "team_info.id",
 	Name:           "team_info.name",
@@ -85,6 +89,7 @@ var TeamInfoTableColumns = struct {
 	Color:          "team_info.color",
 	SecondaryColor: "team_info.secondary_color",
 	Logo:           "team_info.logo",
+	LeagueID:       "team_info.league_id",
 }
 
 // Generated where
@@ -100,6 +105,7 @@ var TeamInfoWhere = struct {
 	Color          whereHelperstring
 	SecondaryColor whereHelperstring
 	Logo           whereHelpernull_String
+	LeagueID       whereHelperstring
 }{
 	ID:             whereHelperint{field: "\"team_info\".\"id\""},
 	Name:           whereHelperstring{field: "\"team_info\".\"name\""},
@@ -111,14 +117,19 @@ var TeamInfoWhere = struct {
 	Color:          whereHelperstring{field: "\"team_info\".\"color\""},
 	SecondaryColor: whereHelperstring{field: "\"team_info\".\"secondary_color\""},
 	Logo:           whereHelpernull_String{field: "\"team_info\".\"logo\""},
+	LeagueID:       whereHelperstring{field: "\"team_info\".\"league_id\""},
 }
 
 // TeamInfoRels is where relationship names are stored.
 var TeamInfoRels = struct {
-}{}
+	League string
+}{
+	League: "League",
+}
 
 // teamInfoR is where relationships are stored.
 type teamInfoR struct {
+	League *League `boil:"League" json:"League" toml:"League" yaml:"League"`
 }
 
 // NewStruct creates a new relationship struct
@@ -126,12 +137,19 @@ func (*teamInfoR) NewStruct() *teamInfoR {
 	return &teamInfoR{}
 }
 
+func (r *teamInfoR) GetLeague() *League {
+	if r == nil {
+		return nil
+	}
+	return r.League
+}
+
 // teamInfoL is where Load methods for each relationship are stored.
 type teamInfoL struct{}
 
 var (
-	teamInfoAllColumns            = []string{"id", "name", "slug", "short_name", "abbr", "arena_name", "arena_size", "color", "secondary_color", "logo"}
-	teamInfoColumnsWithoutDefault = []string{"id", "name", "slug", "short_name", "abbr", "arena_name", "arena_size", "color", "secondary_color"}
+	teamInfoAllColumns            = []string{"id", "name", "slug", "short_name", "abbr", "arena_name", "arena_size", "color", "secondary_color", "logo", "league_id"}
+	teamInfoColumnsWithoutDefault = []string{"id", "name", "slug", "short_name", "abbr", "arena_name", "arena_size", "color", "secondary_color", "league_id"}
 	teamInfoColumnsWithDefault    = []string{"logo"}
 	teamInfoPrimaryKeyColumns     = []string{"id"}
 	teamInfoGeneratedColumns      = []string{}
@@ -435,6 +453,192 @@ func (q teamInfoQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (b
 	return count > 0, nil
 }
 
+// League pointed to by the foreign key.
+func (o *TeamInfo) League(mods ...qm.QueryMod) leagueQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.LeagueID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Leagues(queryMods...)
+}
+
+// LoadLeague allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (teamInfoL) LoadLeague(ctx context.Context, e boil.ContextExecutor, singular bool, maybeTeamInfo interface{}, mods queries.Applicator) error {
+	var slice []*TeamInfo
+	var object *TeamInfo
+
+	if singular {
+		var ok bool
+		object, ok = maybeTeamInfo.(*TeamInfo)
+		if !ok {
+			object = new(TeamInfo)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeTeamInfo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeTeamInfo))
+			}
+		}
+	} else {
+		s, ok := maybeTeamInfo.(*[]*TeamInfo)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeTeamInfo)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeTeamInfo))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &teamInfoR{}
+		}
+		args = append(args, object.LeagueID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &teamInfoR{}
+			}
+
+			for _, a := range args {
+				if a == obj.LeagueID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.LeagueID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`leagues`),
+		qm.WhereIn(`leagues.id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load League")
+	}
+
+	var resultSlice []*League
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice League")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for leagues")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for leagues")
+	}
+
+	if len(leagueAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.League = foreign
+		if foreign.R == nil {
+			foreign.R = &leagueR{}
+		}
+		foreign.R.TeamInfos = append(foreign.R.TeamInfos, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.LeagueID == foreign.ID {
+				local.R.League = foreign
+				if foreign.R == nil {
+					foreign.R = &leagueR{}
+				}
+				foreign.R.TeamInfos = append(foreign.R.TeamInfos, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetLeagueG of the teamInfo to the related item.
+// Sets o.R.League to related.
+// Adds o to related.R.TeamInfos.
+// Uses the global database handle.
+func (o *TeamInfo) SetLeagueG(ctx context.Context, insert bool, related *League) error {
+	return o.SetLeague(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetLeague of the teamInfo to the related item.
+// Sets o.R.League to related.
+// Adds o to related.R.TeamInfos.
+func (o *TeamInfo) SetLeague(ctx context.Context, exec boil.ContextExecutor, insert bool, related *League) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"team_info\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, []string{"league_id"}),
+		strmangle.WhereClause("\"", "\"", 2, teamInfoPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.LeagueID = related.ID
+	if o.R == nil {
+		o.R = &teamInfoR{
+			League: related,
+		}
+	} else {
+		o.R.League = related
+	}
+
+	if related.R == nil {
+		related.R = &leagueR{
+			TeamInfos: TeamInfoSlice{o},
+		}
+	} else {
+		related.R.TeamInfos = append(related.R.TeamInfos, o)
+	}
+
+	return nil
+}
+
 // TeamInfos retrieves all the records using an executor.
 func TeamInfos(mods ...qm.QueryMod) teamInfoQuery {
 	mods = append(mods, qm.From("\"team_info\""))
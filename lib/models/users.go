@@ -94,27 +94,6 @@ var UserTableColumns = struct {
 
 // Generated where
 
-type whereHelpertime_Time struct{ field string }
-
-func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.EQ, x)
-}
-func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.NEQ, x)
-}
-func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LT, x)
-}
-func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.LTE, x)
-}
-func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GT, x)
-}
-func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
-	return qmhelper.Where(w.field, qmhelper.GTE, x)
-}
-
 type whereHelpernull_Bytes struct{ field string }
 
 func (w whereHelpernull_Bytes) EQ(x null.Bytes) qm.QueryMod {
@@ -167,17 +146,56 @@ var UserWhere = struct {
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	ChatUsers  string
-	OwnerChats string
+	Bot                             string
+	OwnerBots                       string
+	AddedByChatBots                 string
+	InviteeChatInvitations          string
+	InvitorChatInvitations          string
+	CreatorChatInviteLinks          string
+	ChatModerationReports           string
+	ResolvedByChatModerationReports string
+	PinnedByChatPins                string
+	ChatReadCursors                 string
+	ChatUsers                       string
+	CreatedByChatWebhooks           string
+	OwnerChats                      string
+	UserBlocks                      string
+	BlockedUserUserBlocks           string
 }{
-	ChatUsers:  "ChatUsers",
-	OwnerChats: "OwnerChats",
+	Bot:                             "Bot",
+	OwnerBots:                       "OwnerBots",
+	AddedByChatBots:                 "AddedByChatBots",
+	InviteeChatInvitations:          "InviteeChatInvitations",
+	InvitorChatInvitations:          "InvitorChatInvitations",
+	CreatorChatInviteLinks:          "CreatorChatInviteLinks",
+	ChatModerationReports:           "ChatModerationReports",
+	ResolvedByChatModerationReports: "ResolvedByChatModerationReports",
+	PinnedByChatPins:                "PinnedByChatPins",
+	ChatReadCursors:                 "ChatReadCursors",
+	ChatUsers:                       "ChatUsers",
+	CreatedByChatWebhooks:           "CreatedByChatWebhooks",
+	OwnerChats:                      "OwnerChats",
+	UserBlocks:                      "UserBlocks",
+	BlockedUserUserBlocks:           "BlockedUserUserBlocks",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	ChatUsers  ChatUserSlice `boil:"ChatUsers" json:"ChatUsers" toml:"ChatUsers" yaml:"ChatUsers"`
-	OwnerChats ChatSlice     `boil:"OwnerChats" json:"OwnerChats" toml:"OwnerChats" yaml:"OwnerChats"`
+	Bot                             *Bot                      `boil:"Bot" json:"Bot" toml:"Bot" yaml:"Bot"`
+	OwnerBots                       BotSlice                  `boil:"OwnerBots" json:"OwnerBots" toml:"OwnerBots" yaml:"OwnerBots"`
+	AddedByChatBots                 ChatBotSlice              `boil:"AddedByChatBots" json:"AddedByChatBots" toml:"AddedByChatBots" yaml:"AddedByChatBots"`
+	InviteeChatInvitations          ChatInvitationSlice       `boil:"InviteeChatInvitations" json:"InviteeChatInvitations" toml:"InviteeChatInvitations" yaml:"InviteeChatInvitations"`
+	InvitorChatInvitations          ChatInvitationSlice       `boil:"InvitorChatInvitations" json:"InvitorChatInvitations" toml:"InvitorChatInvitations" yaml:"InvitorChatInvitations"`
+	CreatorChatInviteLinks          ChatInviteLinkSlice       `boil:"CreatorChatInviteLinks" json:"CreatorChatInviteLinks" toml:"CreatorChatInviteLinks" yaml:"CreatorChatInviteLinks"`
+	ChatModerationReports           ChatModerationReportSlice `boil:"ChatModerationReports" json:"ChatModerationReports" toml:"ChatModerationReports" yaml:"ChatModerationReports"`
+	ResolvedByChatModerationReports ChatModerationReportSlice `boil:"ResolvedByChatModerationReports" json:"ResolvedByChatModerationReports" toml:"ResolvedByChatModerationReports" yaml:"ResolvedByChatModerationReports"`
+	PinnedByChatPins                ChatPinSlice              `boil:"PinnedByChatPins" json:"PinnedByChatPins" toml:"PinnedByChatPins" yaml:"PinnedByChatPins"`
+	ChatReadCursors                 ChatReadCursorSlice       `boil:"ChatReadCursors" json:"ChatReadCursors" toml:"ChatReadCursors" yaml:"ChatReadCursors"`
+	ChatUsers                       ChatUserSlice             `boil:"ChatUsers" json:"ChatUsers" toml:"ChatUsers" yaml:"ChatUsers"`
+	CreatedByChatWebhooks           ChatWebhookSlice          `boil:"CreatedByChatWebhooks" json:"CreatedByChatWebhooks" toml:"CreatedByChatWebhooks" yaml:"CreatedByChatWebhooks"`
+	OwnerChats                      ChatSlice                 `boil:"OwnerChats" json:"OwnerChats" toml:"OwnerChats" yaml:"OwnerChats"`
+	UserBlocks                      UserBlockSlice            `boil:"UserBlocks" json:"UserBlocks" toml:"UserBlocks" yaml:"UserBlocks"`
+	BlockedUserUserBlocks           UserBlockSlice            `boil:"BlockedUserUserBlocks" json:"BlockedUserUserBlocks" toml:"BlockedUserUserBlocks" yaml:"BlockedUserUserBlocks"`
 }
 
 // NewStruct creates a new relationship struct
@@ -185,6 +203,76 @@ func (*userR) NewStruct() *userR {
 	return &userR{}
 }
 
+func (r *userR) GetBot() *Bot {
+	if r == nil {
+		return nil
+	}
+	return r.Bot
+}
+
+func (r *userR) GetOwnerBots() BotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.OwnerBots
+}
+
+func (r *userR) GetAddedByChatBots() ChatBotSlice {
+	if r == nil {
+		return nil
+	}
+	return r.AddedByChatBots
+}
+
+func (r *userR) GetInviteeChatInvitations() ChatInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.InviteeChatInvitations
+}
+
+func (r *userR) GetInvitorChatInvitations() ChatInvitationSlice {
+	if r == nil {
+		return nil
+	}
+	return r.InvitorChatInvitations
+}
+
+func (r *userR) GetCreatorChatInviteLinks() ChatInviteLinkSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatorChatInviteLinks
+}
+
+func (r *userR) GetChatModerationReports() ChatModerationReportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatModerationReports
+}
+
+func (r *userR) GetResolvedByChatModerationReports() ChatModerationReportSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ResolvedByChatModerationReports
+}
+
+func (r *userR) GetPinnedByChatPins() ChatPinSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PinnedByChatPins
+}
+
+func (r *userR) GetChatReadCursors() ChatReadCursorSlice {
+	if r == nil {
+		return nil
+	}
+	return r.ChatReadCursors
+}
+
 func (r *userR) GetChatUsers() ChatUserSlice {
 	if r == nil {
 		return nil
@@ -192,6 +280,13 @@ func (r *userR) GetChatUsers() ChatUserSlice {
 	return r.ChatUsers
 }
 
+func (r *userR) GetCreatedByChatWebhooks() ChatWebhookSlice {
+	if r == nil {
+		return nil
+	}
+	return r.CreatedByChatWebhooks
+}
+
 func (r *userR) GetOwnerChats() ChatSlice {
 	if r == nil {
 		return nil
@@ -199,6 +294,20 @@ func (r *userR) GetOwnerChats() ChatSlice {
 	return r.OwnerChats
 }
 
+func (r *userR) GetUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.UserBlocks
+}
+
+func (r *userR) GetBlockedUserUserBlocks() UserBlockSlice {
+	if r == nil {
+		return nil
+	}
+	return r.BlockedUserUserBlocks
+}
+
 // userL is where Load methods for each relationship are stored.
 type userL struct{}
 
@@ -508,6 +617,143 @@ func (q userQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool,
 	return count > 0, nil
 }
 
+// Bot pointed to by the foreign key.
+func (o *User) Bot(mods ...qm.QueryMod) botQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"user_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Bots(queryMods...)
+}
+
+// OwnerBots retrieves all the bot's Bots with an executor via owner_id column.
+func (o *User) OwnerBots(mods ...qm.QueryMod) botQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"bots\".\"owner_id\"=?", o.ID),
+	)
+
+	return Bots(queryMods...)
+}
+
+// AddedByChatBots retrieves all the chat_bot's ChatBots with an executor via added_by column.
+func (o *User) AddedByChatBots(mods ...qm.QueryMod) chatBotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_bots\".\"added_by\"=?", o.ID),
+	)
+
+	return ChatBots(queryMods...)
+}
+
+// InviteeChatInvitations retrieves all the chat_invitation's ChatInvitations with an executor via invitee_id column.
+func (o *User) InviteeChatInvitations(mods ...qm.QueryMod) chatInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_invitations\".\"invitee_id\"=?", o.ID),
+	)
+
+	return ChatInvitations(queryMods...)
+}
+
+// InvitorChatInvitations retrieves all the chat_invitation's ChatInvitations with an executor via invitor_id column.
+func (o *User) InvitorChatInvitations(mods ...qm.QueryMod) chatInvitationQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_invitations\".\"invitor_id\"=?", o.ID),
+	)
+
+	return ChatInvitations(queryMods...)
+}
+
+// CreatorChatInviteLinks retrieves all the chat_invite_link's ChatInviteLinks with an executor via creator_id column.
+func (o *User) CreatorChatInviteLinks(mods ...qm.QueryMod) chatInviteLinkQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_invite_links\".\"creator_id\"=?", o.ID),
+	)
+
+	return ChatInviteLinks(queryMods...)
+}
+
+// ChatModerationReports retrieves all the chat_moderation_report's ChatModerationReports with an executor.
+func (o *User) ChatModerationReports(mods ...qm.QueryMod) chatModerationReportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_moderation_reports\".\"user_id\"=?", o.ID),
+	)
+
+	return ChatModerationReports(queryMods...)
+}
+
+// ResolvedByChatModerationReports retrieves all the chat_moderation_report's ChatModerationReports with an executor via resolved_by column.
+func (o *User) ResolvedByChatModerationReports(mods ...qm.QueryMod) chatModerationReportQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_moderation_reports\".\"resolved_by\"=?", o.ID),
+	)
+
+	return ChatModerationReports(queryMods...)
+}
+
+// PinnedByChatPins retrieves all the chat_pin's ChatPins with an executor via pinned_by column.
+func (o *User) PinnedByChatPins(mods ...qm.QueryMod) chatPinQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_pins\".\"pinned_by\"=?", o.ID),
+	)
+
+	return ChatPins(queryMods...)
+}
+
+// ChatReadCursors retrieves all the chat_read_cursor's ChatReadCursors with an executor.
+func (o *User) ChatReadCursors(mods ...qm.QueryMod) chatReadCursorQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_read_cursors\".\"user_id\"=?", o.ID),
+	)
+
+	return ChatReadCursors(queryMods...)
+}
+
 // ChatUsers retrieves all the chat_user's ChatUsers with an executor.
 func (o *User) ChatUsers(mods ...qm.QueryMod) chatUserQuery {
 	var queryMods []qm.QueryMod
@@ -522,6 +768,20 @@ func (o *User) ChatUsers(mods ...qm.QueryMod) chatUserQuery {
 	return ChatUsers(queryMods...)
 }
 
+// CreatedByChatWebhooks retrieves all the chat_webhook's ChatWebhooks with an executor via created_by column.
+func (o *User) CreatedByChatWebhooks(mods ...qm.QueryMod) chatWebhookQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"chat_webhooks\".\"created_by\"=?", o.ID),
+	)
+
+	return ChatWebhooks(queryMods...)
+}
+
 // OwnerChats retrieves all the chat's Chats with an executor via owner_id column.
 func (o *User) OwnerChats(mods ...qm.QueryMod) chatQuery {
 	var queryMods []qm.QueryMod
@@ -536,9 +796,37 @@ func (o *User) OwnerChats(mods ...qm.QueryMod) chatQuery {
 	return Chats(queryMods...)
 }
 
-// LoadChatUsers allows an eager lookup of values, cached into the
-// loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+// UserBlocks retrieves all the user_block's UserBlocks with an executor.
+func (o *User) UserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_blocks\".\"user_id\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// BlockedUserUserBlocks retrieves all the user_block's UserBlocks with an executor via blocked_user_id column.
+func (o *User) BlockedUserUserBlocks(mods ...qm.QueryMod) userBlockQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"user_blocks\".\"blocked_user_id\"=?", o.ID),
+	)
+
+	return UserBlocks(queryMods...)
+}
+
+// LoadBot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (userL) LoadBot(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -592,8 +880,9 @@ func (userL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 	}
 
 	query := NewQuery(
-		qm.From(`chat_user`),
-		qm.WhereIn(`chat_user.user_id in ?`, args...),
+		qm.From(`bots`),
+		qm.WhereIn(`bots.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`bots.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -601,45 +890,48 @@ func (userL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load chat_user")
+		return errors.Wrap(err, "failed to eager load Bot")
 	}
 
-	var resultSlice []*ChatUser
+	var resultSlice []*Bot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice chat_user")
+		return errors.Wrap(err, "failed to bind eager loaded slice Bot")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on chat_user")
+		return errors.Wrap(err, "failed to close results of eager load for bots")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_user")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bots")
 	}
 
-	if len(chatUserAfterSelectHooks) != 0 {
+	if len(botAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
 			}
 		}
 	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
 	if singular {
-		object.R.ChatUsers = resultSlice
-		for _, foreign := range resultSlice {
-			if foreign.R == nil {
-				foreign.R = &chatUserR{}
-			}
-			foreign.R.User = object
+		foreign := resultSlice[0]
+		object.R.Bot = foreign
+		if foreign.R == nil {
+			foreign.R = &botR{}
 		}
-		return nil
+		foreign.R.User = object
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
 			if local.ID == foreign.UserID {
-				local.R.ChatUsers = append(local.R.ChatUsers, foreign)
+				local.R.Bot = foreign
 				if foreign.R == nil {
-					foreign.R = &chatUserR{}
+					foreign.R = &botR{}
 				}
 				foreign.R.User = local
 				break
@@ -650,9 +942,9 @@ func (userL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular
 	return nil
 }
 
-// LoadOwnerChats allows an eager lookup of values, cached into the
+// LoadOwnerBots allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadOwnerBots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -692,7 +984,7 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -706,8 +998,9 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 	}
 
 	query := NewQuery(
-		qm.From(`chats`),
-		qm.WhereIn(`chats.owner_id in ?`, args...),
+		qm.From(`bots`),
+		qm.WhereIn(`bots.owner_id in ?`, args...),
+		qmhelper.WhereIsNull(`bots.deleted_at`),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -715,22 +1008,22 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load chats")
+		return errors.Wrap(err, "failed to eager load bots")
 	}
 
-	var resultSlice []*Chat
+	var resultSlice []*Bot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice chats")
+		return errors.Wrap(err, "failed to bind eager loaded slice bots")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on chats")
+		return errors.Wrap(err, "failed to close results in eager load on bots")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for bots")
 	}
 
-	if len(chatAfterSelectHooks) != 0 {
+	if len(botAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -738,10 +1031,10 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 		}
 	}
 	if singular {
-		object.R.OwnerChats = resultSlice
+		object.R.OwnerBots = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &chatR{}
+				foreign.R = &botR{}
 			}
 			foreign.R.Owner = object
 		}
@@ -750,10 +1043,10 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.OwnerID) {
-				local.R.OwnerChats = append(local.R.OwnerChats, foreign)
+			if local.ID == foreign.OwnerID {
+				local.R.OwnerBots = append(local.R.OwnerBots, foreign)
 				if foreign.R == nil {
-					foreign.R = &chatR{}
+					foreign.R = &botR{}
 				}
 				foreign.R.Owner = local
 				break
@@ -764,16 +1057,2302 @@ func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singula
 	return nil
 }
 
-// AddChatUsersG adds the given related objects to the existing relationships
-// of the user, optionally inserting them as new records.
-// Appends related to o.R.ChatUsers.
-// Sets related.R.User appropriately.
-// Uses the global database handle.
-func (o *User) AddChatUsersG(ctx context.Context, insert bool, related ...*ChatUser) error {
-	return o.AddChatUsers(ctx, boil.GetContextDB(), insert, related...)
-}
-
-// AddChatUsers adds the given related objects to the existing relationships
+// LoadAddedByChatBots allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadAddedByChatBots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_bots`),
+		qm.WhereIn(`chat_bots.added_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_bots")
+	}
+
+	var resultSlice []*ChatBot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_bots")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_bots")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_bots")
+	}
+
+	if len(chatBotAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.AddedByChatBots = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatBotR{}
+			}
+			foreign.R.AddedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.AddedBy {
+				local.R.AddedByChatBots = append(local.R.AddedByChatBots, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatBotR{}
+				}
+				foreign.R.AddedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInviteeChatInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInviteeChatInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_invitations`),
+		qm.WhereIn(`chat_invitations.invitee_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_invitations")
+	}
+
+	var resultSlice []*ChatInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_invitations")
+	}
+
+	if len(chatInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InviteeChatInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatInvitationR{}
+			}
+			foreign.R.Invitee = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.InviteeID) {
+				local.R.InviteeChatInvitations = append(local.R.InviteeChatInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatInvitationR{}
+				}
+				foreign.R.Invitee = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadInvitorChatInvitations allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadInvitorChatInvitations(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_invitations`),
+		qm.WhereIn(`chat_invitations.invitor_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_invitations")
+	}
+
+	var resultSlice []*ChatInvitation
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_invitations")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_invitations")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_invitations")
+	}
+
+	if len(chatInvitationAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.InvitorChatInvitations = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatInvitationR{}
+			}
+			foreign.R.Invitor = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.InvitorID {
+				local.R.InvitorChatInvitations = append(local.R.InvitorChatInvitations, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatInvitationR{}
+				}
+				foreign.R.Invitor = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatorChatInviteLinks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatorChatInviteLinks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_invite_links`),
+		qm.WhereIn(`chat_invite_links.creator_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_invite_links")
+	}
+
+	var resultSlice []*ChatInviteLink
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_invite_links")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_invite_links")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_invite_links")
+	}
+
+	if len(chatInviteLinkAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatorChatInviteLinks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatInviteLinkR{}
+			}
+			foreign.R.Creator = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatorID {
+				local.R.CreatorChatInviteLinks = append(local.R.CreatorChatInviteLinks, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatInviteLinkR{}
+				}
+				foreign.R.Creator = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatModerationReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatModerationReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_moderation_reports`),
+		qm.WhereIn(`chat_moderation_reports.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_moderation_reports")
+	}
+
+	var resultSlice []*ChatModerationReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_moderation_reports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_moderation_reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_moderation_reports")
+	}
+
+	if len(chatModerationReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatModerationReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatModerationReportR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatModerationReports = append(local.R.ChatModerationReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatModerationReportR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadResolvedByChatModerationReports allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadResolvedByChatModerationReports(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_moderation_reports`),
+		qm.WhereIn(`chat_moderation_reports.resolved_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_moderation_reports")
+	}
+
+	var resultSlice []*ChatModerationReport
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_moderation_reports")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_moderation_reports")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_moderation_reports")
+	}
+
+	if len(chatModerationReportAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ResolvedByChatModerationReports = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatModerationReportR{}
+			}
+			foreign.R.ResolvedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.ResolvedBy) {
+				local.R.ResolvedByChatModerationReports = append(local.R.ResolvedByChatModerationReports, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatModerationReportR{}
+				}
+				foreign.R.ResolvedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPinnedByChatPins allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPinnedByChatPins(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_pins`),
+		qm.WhereIn(`chat_pins.pinned_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_pins")
+	}
+
+	var resultSlice []*ChatPin
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_pins")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_pins")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_pins")
+	}
+
+	if len(chatPinAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PinnedByChatPins = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatPinR{}
+			}
+			foreign.R.PinnedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PinnedBy {
+				local.R.PinnedByChatPins = append(local.R.PinnedByChatPins, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatPinR{}
+				}
+				foreign.R.PinnedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatReadCursors allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatReadCursors(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_read_cursors`),
+		qm.WhereIn(`chat_read_cursors.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_read_cursors")
+	}
+
+	var resultSlice []*ChatReadCursor
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_read_cursors")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_read_cursors")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_read_cursors")
+	}
+
+	if len(chatReadCursorAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatReadCursors = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatReadCursorR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatReadCursors = append(local.R.ChatReadCursors, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatReadCursorR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadChatUsers allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadChatUsers(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_user`),
+		qm.WhereIn(`chat_user.user_id in ?`, args...),
+		qmhelper.WhereIsNull(`chat_user.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_user")
+	}
+
+	var resultSlice []*ChatUser
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_user")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_user")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_user")
+	}
+
+	if len(chatUserAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ChatUsers = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatUserR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.ChatUsers = append(local.R.ChatUsers, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatUserR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadCreatedByChatWebhooks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadCreatedByChatWebhooks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chat_webhooks`),
+		qm.WhereIn(`chat_webhooks.created_by in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chat_webhooks")
+	}
+
+	var resultSlice []*ChatWebhook
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chat_webhooks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chat_webhooks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chat_webhooks")
+	}
+
+	if len(chatWebhookAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.CreatedByChatWebhooks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatWebhookR{}
+			}
+			foreign.R.CreatedByUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.CreatedBy {
+				local.R.CreatedByChatWebhooks = append(local.R.CreatedByChatWebhooks, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatWebhookR{}
+				}
+				foreign.R.CreatedByUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadOwnerChats allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadOwnerChats(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`chats`),
+		qm.WhereIn(`chats.owner_id in ?`, args...),
+		qmhelper.WhereIsNull(`chats.deleted_at`),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load chats")
+	}
+
+	var resultSlice []*Chat
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice chats")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on chats")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for chats")
+	}
+
+	if len(chatAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.OwnerChats = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &chatR{}
+			}
+			foreign.R.Owner = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.OwnerID) {
+				local.R.OwnerChats = append(local.R.OwnerChats, foreign)
+				if foreign.R == nil {
+					foreign.R = &chatR{}
+				}
+				foreign.R.Owner = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_blocks`),
+		qm.WhereIn(`user_blocks.user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserBlocks = append(local.R.UserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadBlockedUserUserBlocks allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadBlockedUserUserBlocks(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(
+		qm.From(`user_blocks`),
+		qm.WhereIn(`user_blocks.blocked_user_id in ?`, args...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_blocks")
+	}
+
+	var resultSlice []*UserBlock
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_blocks")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_blocks")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_blocks")
+	}
+
+	if len(userBlockAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BlockedUserUserBlocks = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userBlockR{}
+			}
+			foreign.R.BlockedUser = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BlockedUserID {
+				local.R.BlockedUserUserBlocks = append(local.R.BlockedUserUserBlocks, foreign)
+				if foreign.R == nil {
+					foreign.R = &userBlockR{}
+				}
+				foreign.R.BlockedUser = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBotG of the user to the related item.
+// Sets o.R.Bot to related.
+// Adds o to related.R.User.
+// Uses the global database handle.
+func (o *User) SetBotG(ctx context.Context, insert bool, related *Bot) error {
+	return o.SetBot(ctx, boil.GetContextDB(), insert, related)
+}
+
+// SetBot of the user to the related item.
+// Sets o.R.Bot to related.
+// Adds o to related.R.User.
+func (o *User) SetBot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *Bot) error {
+	var err error
+
+	if insert {
+		related.UserID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"bots\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+			strmangle.WhereClause("\"", "\"", 2, botPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.UserID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.UserID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			Bot: related,
+		}
+	} else {
+		o.R.Bot = related
+	}
+
+	if related.R == nil {
+		related.R = &botR{
+			User: o,
+		}
+	} else {
+		related.R.User = o
+	}
+	return nil
+}
+
+// AddOwnerBotsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerBots.
+// Sets related.R.Owner appropriately.
+// Uses the global database handle.
+func (o *User) AddOwnerBotsG(ctx context.Context, insert bool, related ...*Bot) error {
+	return o.AddOwnerBots(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddOwnerBots adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.OwnerBots.
+// Sets related.R.Owner appropriately.
+func (o *User) AddOwnerBots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Bot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.OwnerID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"bots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"owner_id"}),
+				strmangle.WhereClause("\"", "\"", 2, botPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.OwnerID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			OwnerBots: related,
+		}
+	} else {
+		o.R.OwnerBots = append(o.R.OwnerBots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &botR{
+				Owner: o,
+			}
+		} else {
+			rel.R.Owner = o
+		}
+	}
+	return nil
+}
+
+// AddAddedByChatBotsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByChatBots.
+// Sets related.R.AddedByUser appropriately.
+// Uses the global database handle.
+func (o *User) AddAddedByChatBotsG(ctx context.Context, insert bool, related ...*ChatBot) error {
+	return o.AddAddedByChatBots(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddAddedByChatBots adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.AddedByChatBots.
+// Sets related.R.AddedByUser appropriately.
+func (o *User) AddAddedByChatBots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatBot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.AddedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_bots\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"added_by"}),
+				strmangle.WhereClause("\"", "\"", 2, chatBotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.AddedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			AddedByChatBots: related,
+		}
+	} else {
+		o.R.AddedByChatBots = append(o.R.AddedByChatBots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatBotR{
+				AddedByUser: o,
+			}
+		} else {
+			rel.R.AddedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddInviteeChatInvitationsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviteeChatInvitations.
+// Sets related.R.Invitee appropriately.
+// Uses the global database handle.
+func (o *User) AddInviteeChatInvitationsG(ctx context.Context, insert bool, related ...*ChatInvitation) error {
+	return o.AddInviteeChatInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddInviteeChatInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InviteeChatInvitations.
+// Sets related.R.Invitee appropriately.
+func (o *User) AddInviteeChatInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.InviteeID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invitee_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.InviteeID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InviteeChatInvitations: related,
+		}
+	} else {
+		o.R.InviteeChatInvitations = append(o.R.InviteeChatInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatInvitationR{
+				Invitee: o,
+			}
+		} else {
+			rel.R.Invitee = o
+		}
+	}
+	return nil
+}
+
+// SetInviteeChatInvitationsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Invitee's InviteeChatInvitations accordingly.
+// Replaces o.R.InviteeChatInvitations with related.
+// Sets related.R.Invitee's InviteeChatInvitations accordingly.
+// Uses the global database handle.
+func (o *User) SetInviteeChatInvitationsG(ctx context.Context, insert bool, related ...*ChatInvitation) error {
+	return o.SetInviteeChatInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetInviteeChatInvitations removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.Invitee's InviteeChatInvitations accordingly.
+// Replaces o.R.InviteeChatInvitations with related.
+// Sets related.R.Invitee's InviteeChatInvitations accordingly.
+func (o *User) SetInviteeChatInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInvitation) error {
+	query := "update \"chat_invitations\" set \"invitee_id\" = null where \"invitee_id\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.InviteeChatInvitations {
+			queries.SetScanner(&rel.InviteeID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.Invitee = nil
+		}
+		o.R.InviteeChatInvitations = nil
+	}
+
+	return o.AddInviteeChatInvitations(ctx, exec, insert, related...)
+}
+
+// RemoveInviteeChatInvitationsG relationships from objects passed in.
+// Removes related items from R.InviteeChatInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Invitee.
+// Uses the global database handle.
+func (o *User) RemoveInviteeChatInvitationsG(ctx context.Context, related ...*ChatInvitation) error {
+	return o.RemoveInviteeChatInvitations(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveInviteeChatInvitations relationships from objects passed in.
+// Removes related items from R.InviteeChatInvitations (uses pointer comparison, removal does not keep order)
+// Sets related.R.Invitee.
+func (o *User) RemoveInviteeChatInvitations(ctx context.Context, exec boil.ContextExecutor, related ...*ChatInvitation) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.InviteeID, nil)
+		if rel.R != nil {
+			rel.R.Invitee = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("invitee_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.InviteeChatInvitations {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.InviteeChatInvitations)
+			if ln > 1 && i < ln-1 {
+				o.R.InviteeChatInvitations[i] = o.R.InviteeChatInvitations[ln-1]
+			}
+			o.R.InviteeChatInvitations = o.R.InviteeChatInvitations[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddInvitorChatInvitationsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InvitorChatInvitations.
+// Sets related.R.Invitor appropriately.
+// Uses the global database handle.
+func (o *User) AddInvitorChatInvitationsG(ctx context.Context, insert bool, related ...*ChatInvitation) error {
+	return o.AddInvitorChatInvitations(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddInvitorChatInvitations adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.InvitorChatInvitations.
+// Sets related.R.Invitor appropriately.
+func (o *User) AddInvitorChatInvitations(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInvitation) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.InvitorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_invitations\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"invitor_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatInvitationPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.InvitorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			InvitorChatInvitations: related,
+		}
+	} else {
+		o.R.InvitorChatInvitations = append(o.R.InvitorChatInvitations, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatInvitationR{
+				Invitor: o,
+			}
+		} else {
+			rel.R.Invitor = o
+		}
+	}
+	return nil
+}
+
+// AddCreatorChatInviteLinksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorChatInviteLinks.
+// Sets related.R.Creator appropriately.
+// Uses the global database handle.
+func (o *User) AddCreatorChatInviteLinksG(ctx context.Context, insert bool, related ...*ChatInviteLink) error {
+	return o.AddCreatorChatInviteLinks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCreatorChatInviteLinks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatorChatInviteLinks.
+// Sets related.R.Creator appropriately.
+func (o *User) AddCreatorChatInviteLinks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatInviteLink) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatorID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_invite_links\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"creator_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatInviteLinkPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatorID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatorChatInviteLinks: related,
+		}
+	} else {
+		o.R.CreatorChatInviteLinks = append(o.R.CreatorChatInviteLinks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatInviteLinkR{
+				Creator: o,
+			}
+		} else {
+			rel.R.Creator = o
+		}
+	}
+	return nil
+}
+
+// AddChatModerationReportsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatModerationReports.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatModerationReportsG(ctx context.Context, insert bool, related ...*ChatModerationReport) error {
+	return o.AddChatModerationReports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatModerationReports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatModerationReports.
+// Sets related.R.User appropriately.
+func (o *User) AddChatModerationReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatModerationReport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChatModerationReports: related,
+		}
+	} else {
+		o.R.ChatModerationReports = append(o.R.ChatModerationReports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatModerationReportR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddResolvedByChatModerationReportsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ResolvedByChatModerationReports.
+// Sets related.R.ResolvedByUser appropriately.
+// Uses the global database handle.
+func (o *User) AddResolvedByChatModerationReportsG(ctx context.Context, insert bool, related ...*ChatModerationReport) error {
+	return o.AddResolvedByChatModerationReports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddResolvedByChatModerationReports adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ResolvedByChatModerationReports.
+// Sets related.R.ResolvedByUser appropriately.
+func (o *User) AddResolvedByChatModerationReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatModerationReport) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.ResolvedBy, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_moderation_reports\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"resolved_by"}),
+				strmangle.WhereClause("\"", "\"", 2, chatModerationReportPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.ResolvedBy, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ResolvedByChatModerationReports: related,
+		}
+	} else {
+		o.R.ResolvedByChatModerationReports = append(o.R.ResolvedByChatModerationReports, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatModerationReportR{
+				ResolvedByUser: o,
+			}
+		} else {
+			rel.R.ResolvedByUser = o
+		}
+	}
+	return nil
+}
+
+// SetResolvedByChatModerationReportsG removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ResolvedByUser's ResolvedByChatModerationReports accordingly.
+// Replaces o.R.ResolvedByChatModerationReports with related.
+// Sets related.R.ResolvedByUser's ResolvedByChatModerationReports accordingly.
+// Uses the global database handle.
+func (o *User) SetResolvedByChatModerationReportsG(ctx context.Context, insert bool, related ...*ChatModerationReport) error {
+	return o.SetResolvedByChatModerationReports(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// SetResolvedByChatModerationReports removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.ResolvedByUser's ResolvedByChatModerationReports accordingly.
+// Replaces o.R.ResolvedByChatModerationReports with related.
+// Sets related.R.ResolvedByUser's ResolvedByChatModerationReports accordingly.
+func (o *User) SetResolvedByChatModerationReports(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatModerationReport) error {
+	query := "update \"chat_moderation_reports\" set \"resolved_by\" = null where \"resolved_by\" = $1"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.ResolvedByChatModerationReports {
+			queries.SetScanner(&rel.ResolvedBy, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.ResolvedByUser = nil
+		}
+		o.R.ResolvedByChatModerationReports = nil
+	}
+
+	return o.AddResolvedByChatModerationReports(ctx, exec, insert, related...)
+}
+
+// RemoveResolvedByChatModerationReportsG relationships from objects passed in.
+// Removes related items from R.ResolvedByChatModerationReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedByUser.
+// Uses the global database handle.
+func (o *User) RemoveResolvedByChatModerationReportsG(ctx context.Context, related ...*ChatModerationReport) error {
+	return o.RemoveResolvedByChatModerationReports(ctx, boil.GetContextDB(), related...)
+}
+
+// RemoveResolvedByChatModerationReports relationships from objects passed in.
+// Removes related items from R.ResolvedByChatModerationReports (uses pointer comparison, removal does not keep order)
+// Sets related.R.ResolvedByUser.
+func (o *User) RemoveResolvedByChatModerationReports(ctx context.Context, exec boil.ContextExecutor, related ...*ChatModerationReport) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.ResolvedBy, nil)
+		if rel.R != nil {
+			rel.R.ResolvedByUser = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("resolved_by")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.ResolvedByChatModerationReports {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.ResolvedByChatModerationReports)
+			if ln > 1 && i < ln-1 {
+				o.R.ResolvedByChatModerationReports[i] = o.R.ResolvedByChatModerationReports[ln-1]
+			}
+			o.R.ResolvedByChatModerationReports = o.R.ResolvedByChatModerationReports[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPinnedByChatPinsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PinnedByChatPins.
+// Sets related.R.PinnedByUser appropriately.
+// Uses the global database handle.
+func (o *User) AddPinnedByChatPinsG(ctx context.Context, insert bool, related ...*ChatPin) error {
+	return o.AddPinnedByChatPins(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddPinnedByChatPins adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PinnedByChatPins.
+// Sets related.R.PinnedByUser appropriately.
+func (o *User) AddPinnedByChatPins(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatPin) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PinnedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_pins\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"pinned_by"}),
+				strmangle.WhereClause("\"", "\"", 2, chatPinPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PinnedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PinnedByChatPins: related,
+		}
+	} else {
+		o.R.PinnedByChatPins = append(o.R.PinnedByChatPins, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatPinR{
+				PinnedByUser: o,
+			}
+		} else {
+			rel.R.PinnedByUser = o
+		}
+	}
+	return nil
+}
+
+// AddChatReadCursorsG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatReadCursors.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatReadCursorsG(ctx context.Context, insert bool, related ...*ChatReadCursor) error {
+	return o.AddChatReadCursors(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatReadCursors adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatReadCursors.
+// Sets related.R.User appropriately.
+func (o *User) AddChatReadCursors(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatReadCursor) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_read_cursors\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, chatReadCursorPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ChatID, rel.UserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			ChatReadCursors: related,
+		}
+	} else {
+		o.R.ChatReadCursors = append(o.R.ChatReadCursors, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatReadCursorR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddChatUsersG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.ChatUsers.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddChatUsersG(ctx context.Context, insert bool, related ...*ChatUser) error {
+	return o.AddChatUsers(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddChatUsers adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.ChatUsers.
 // Sets related.R.User appropriately.
@@ -826,6 +3405,68 @@ func (o *User) AddChatUsers(ctx context.Context, exec boil.ContextExecutor, inse
 	return nil
 }
 
+// AddCreatedByChatWebhooksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByChatWebhooks.
+// Sets related.R.CreatedByUser appropriately.
+// Uses the global database handle.
+func (o *User) AddCreatedByChatWebhooksG(ctx context.Context, insert bool, related ...*ChatWebhook) error {
+	return o.AddCreatedByChatWebhooks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddCreatedByChatWebhooks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.CreatedByChatWebhooks.
+// Sets related.R.CreatedByUser appropriately.
+func (o *User) AddCreatedByChatWebhooks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*ChatWebhook) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.CreatedBy = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"chat_webhooks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"created_by"}),
+				strmangle.WhereClause("\"", "\"", 2, chatWebhookPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.CreatedBy = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			CreatedByChatWebhooks: related,
+		}
+	} else {
+		o.R.CreatedByChatWebhooks = append(o.R.CreatedByChatWebhooks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &chatWebhookR{
+				CreatedByUser: o,
+			}
+		} else {
+			rel.R.CreatedByUser = o
+		}
+	}
+	return nil
+}
+
 // AddOwnerChatsG adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
 // Appends related to o.R.OwnerChats.
@@ -981,6 +3622,130 @@ func (o *User) RemoveOwnerChats(ctx context.Context, exec boil.ContextExecutor,
 	return nil
 }
 
+// AddUserBlocksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserBlocks.
+// Sets related.R.User appropriately.
+// Uses the global database handle.
+func (o *User) AddUserBlocksG(ctx context.Context, insert bool, related ...*UserBlock) error {
+	return o.AddUserBlocks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddUserBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.UserBlocks.
+// Sets related.R.User appropriately.
+func (o *User) AddUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BlockedUserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			UserBlocks: related,
+		}
+	} else {
+		o.R.UserBlocks = append(o.R.UserBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userBlockR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddBlockedUserUserBlocksG adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockedUserUserBlocks.
+// Sets related.R.BlockedUser appropriately.
+// Uses the global database handle.
+func (o *User) AddBlockedUserUserBlocksG(ctx context.Context, insert bool, related ...*UserBlock) error {
+	return o.AddBlockedUserUserBlocks(ctx, boil.GetContextDB(), insert, related...)
+}
+
+// AddBlockedUserUserBlocks adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.BlockedUserUserBlocks.
+// Sets related.R.BlockedUser appropriately.
+func (o *User) AddBlockedUserUserBlocks(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*UserBlock) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BlockedUserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"user_blocks\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"blocked_user_id"}),
+				strmangle.WhereClause("\"", "\"", 2, userBlockPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.UserID, rel.BlockedUserID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BlockedUserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			BlockedUserUserBlocks: related,
+		}
+	} else {
+		o.R.BlockedUserUserBlocks = append(o.R.BlockedUserUserBlocks, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &userBlockR{
+				BlockedUser: o,
+			}
+		} else {
+			rel.R.BlockedUser = o
+		}
+	}
+	return nil
+}
+
 // Users retrieves all the records using an executor.
 func Users(mods ...qm.QueryMod) userQuery {
 	mods = append(mods, qm.From("\"users\""))
@@ -31,7 +31,7 @@ eval export ${SQL_BOILER_USER}="${POSTGRES_USER}"
 eval export ${SQL_BOILER_PASS}="${POSTGRES_PASSWORD}"
 
 cd ${SCRIPT_DIR}
-${SQL_BOILER_BIN} --wipe --add-global-variants ${SQL_BOILER_DRIVER}
+${SQL_BOILER_BIN} --wipe --add-global-variants --add-soft-deletes ${SQL_BOILER_DRIVER}
 
 
 
@@ -0,0 +1,69 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+)
+
+// LeaderElection elects a single replica by Postgres session-level advisory lock, the lock is held by
+// a dedicated connection so Postgres releases it as soon as the leader (or its connection) is gone
+type LeaderElection struct {
+	db   *sql.DB
+	key  int64
+	conn *sql.Conn
+}
+
+func NewLeaderElection(db *sql.DB, key int64) *LeaderElection {
+	return &LeaderElection{db: db, key: key}
+}
+
+// IsLeader reports whether the replica holds the lock, trying to acquire it when it does not
+func (l *LeaderElection) IsLeader(ctx context.Context) bool {
+	if l.conn != nil {
+		if err := l.conn.PingContext(ctx); err == nil {
+			return true
+		} else {
+			log.Error().Err(err).Msgf("connection holding leader lock %#x is lost", l.key)
+		}
+		l.conn.Close()
+		l.conn = nil
+	}
+	acquired, err := l.acquire(ctx)
+	if err != nil {
+		log.Error().Err(err).Send()
+	}
+	return acquired
+}
+
+func (l *LeaderElection) acquire(ctx context.Context) (bool, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return false, fmt.Errorf("unable to reserve connection for leader lock %#x: %w", l.key, err)
+	}
+	acquired := false
+	if err := conn.QueryRowContext(ctx, "select pg_try_advisory_lock($1)", l.key).Scan(&acquired); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("unable to acquire leader lock %#x: %w", l.key, err)
+	}
+	if !acquired {
+		conn.Close()
+		return false, nil
+	}
+	l.conn = conn
+	return true, nil
+}
+
+// Release gives the lock up (if held) so another replica takes over without waiting
+func (l *LeaderElection) Release(ctx context.Context) {
+	if l.conn == nil {
+		return
+	}
+	if _, err := l.conn.ExecContext(ctx, "select pg_advisory_unlock($1)", l.key); err != nil {
+		log.Error().Err(err).Msgf("unable to release leader lock %#x", l.key)
+	}
+	l.conn.Close()
+	l.conn = nil
+}
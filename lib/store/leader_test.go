@@ -0,0 +1,75 @@
+package store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/quible-io/quible-api/lib/suite"
+	"github.com/stretchr/testify/assert"
+)
+
+type LeaderTestCases struct {
+	suite.TestSuite
+}
+
+func TestLeaderRunner(t *testing.T) {
+	suite.RunSuite(
+		t,
+		&LeaderTestCases{
+			TestSuite: suite.TestSuite{
+				DBStore: suite.NewDBs(),
+			},
+		},
+		true,
+	)
+}
+
+func (tc *LeaderTestCases) TestElection(t *testing.T) {
+	// 1. Two replicas compete for the same lock, another lock is elected independently
+	db := tc.DBStore.RetrieveDB(t.Name())
+	ctx := context.Background()
+	replicaA, replicaB := NewLeaderElection(db, 1001), NewLeaderElection(db, 1001)
+	other := NewLeaderElection(db, 1002)
+	defer replicaA.Release(ctx)
+	defer replicaB.Release(ctx)
+	defer other.Release(ctx)
+	assert.True(t, replicaA.IsLeader(ctx), "the first replica should be elected")
+	assert.False(t, replicaB.IsLeader(ctx), "the lock should not be acquired twice")
+	assert.True(t, replicaA.IsLeader(ctx), "the leader should keep the lock")
+	assert.True(t, other.IsLeader(ctx), "locks of other keys should be independent")
+	// 2. Releasing the lock hands the leadership over, releasing it again (or by non-leader) is a no-op
+	replicaA.Release(ctx)
+	replicaA.Release(ctx)
+	assert.True(t, replicaB.IsLeader(ctx), "the other replica should take over released lock")
+	assert.False(t, replicaA.IsLeader(ctx), "the former leader should not get the lock back")
+}
+
+func (tc *LeaderTestCases) TestFailover(t *testing.T) {
+	// 1. The leader holds the lock
+	db := tc.DBStore.RetrieveDB(t.Name())
+	ctx := context.Background()
+	leader, follower := NewLeaderElection(db, 2001), NewLeaderElection(db, 2001)
+	defer leader.Release(ctx)
+	defer follower.Release(ctx)
+	assert.True(t, leader.IsLeader(ctx))
+	assert.False(t, follower.IsLeader(ctx))
+	// 2. The connection of the leader is gone (e.g. the replica crashed or lost network), Postgres releases the lock
+	var terminated bool
+	if err := db.QueryRowContext(
+		ctx,
+		"select pg_terminate_backend(pid) from pg_locks where locktype = 'advisory' and objid = $1 and granted",
+		2001,
+	).Scan(&terminated); err != nil || !terminated {
+		t.Fatalf("unable to terminate connection holding the lock: %v", err)
+	}
+	// 3. The follower takes over as soon as the backend exits, the former leader detects the lost connection
+	elected := false
+	for deadline := time.Now().Add(10 * time.Second); !elected && time.Now().Before(deadline); {
+		if elected = follower.IsLeader(ctx); !elected {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+	assert.True(t, elected, "the follower should take over the lock of the lost leader")
+	assert.False(t, leader.IsLeader(ctx), "the former leader should step down")
+}